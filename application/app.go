@@ -2,20 +2,38 @@ package application
 
 import (
 	"chat_app_backend/application/application_config"
+	"chat_app_backend/application/controllers/admin"
+	"chat_app_backend/application/controllers/bots"
+	"chat_app_backend/application/controllers/chats"
+	"chat_app_backend/application/controllers/contacts"
+	"chat_app_backend/application/controllers/interest_categories"
 	"chat_app_backend/application/controllers/interests"
+	"chat_app_backend/application/controllers/service_accounts"
 	"chat_app_backend/application/controllers/users"
+	webhooks_controller "chat_app_backend/application/controllers/webhooks"
+	user_jobs "chat_app_backend/application/jobs/users"
+	webhook_jobs "chat_app_backend/application/jobs/webhooks"
 	"chat_app_backend/application/models/jwt_claims"
+	"chat_app_backend/internal/authentication"
+	"chat_app_backend/internal/background"
+	"chat_app_backend/internal/breached_passwords"
 	"chat_app_backend/internal/configuration"
 	"chat_app_backend/internal/env_loader"
 	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/idempotency"
+	"chat_app_backend/internal/images"
 	"chat_app_backend/internal/jwt"
 	logger2 "chat_app_backend/internal/logger"
 	"chat_app_backend/internal/middleware"
 	"chat_app_backend/internal/middleware/configs/rate_limiter"
+	"chat_app_backend/internal/openapi"
+	"chat_app_backend/internal/password"
 	"chat_app_backend/internal/redis"
+	"chat_app_backend/internal/router"
 	"chat_app_backend/internal/s3"
 	"chat_app_backend/internal/service_wrapper"
 	"chat_app_backend/internal/sqlc/db"
+	"chat_app_backend/internal/webhooks"
 	"context"
 	"errors"
 	"log"
@@ -23,10 +41,19 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	apiTitle            = "Chat App Backend"
+	apiVersion          = "1.0.0"
+	openApiDocumentPath = "/openapi.json"
+	swaggerUiPath       = "/docs"
+	swaggerUiAssetsPath = "/docs/assets"
+)
+
 type IApplication interface {
 	Configure()
 	Serve()
@@ -39,9 +66,12 @@ type Application struct {
 	server         *http.Server
 	serviceWrapper service_wrapper.IServiceWrapper
 	configuration  configuration.IConfiguration
+	scheduler      background.IScheduler
 }
 
 func (appl *Application) Close() {
+	appl.scheduler.Stop()
+
 	if err := appl.server.Close(); err != nil {
 		log.Fatalf("Can't close server: %s", err)
 	}
@@ -58,6 +88,7 @@ func (appl *Application) Configure() {
 	appl.configureMiddleware()
 
 	appl.configureRoutes()
+	appl.configureJobs()
 }
 
 func (appl *Application) createServer() {
@@ -78,15 +109,181 @@ func (appl *Application) createServer() {
 }
 
 func (appl *Application) configureRoutes() {
-	users.CreateUserController(
-		appl.engine,
-		appl.serviceWrapper,
-	).ConfigureGroup()
-
-	interests.CreateInterestsController(
-		appl.engine,
-		appl.serviceWrapper,
-	).ConfigureGroup()
+	controllers := []router.IController{
+		users.CreateUserController(
+			appl.engine,
+			appl.serviceWrapper,
+		),
+		interests.CreateInterestsController(
+			appl.engine,
+			appl.serviceWrapper,
+		),
+		interest_categories.CreateInterestCategoriesController(
+			appl.engine,
+			appl.serviceWrapper,
+		),
+		service_accounts.CreateServiceAccountsController(
+			appl.engine,
+			appl.serviceWrapper,
+		),
+		chats.CreateChatsController(
+			appl.engine,
+			appl.serviceWrapper,
+		),
+		bots.CreateBotsController(
+			appl.engine,
+			appl.serviceWrapper,
+		),
+		contacts.CreateContactsController(
+			appl.engine,
+			appl.serviceWrapper,
+		),
+		webhooks_controller.CreateWebhooksController(
+			appl.engine,
+			appl.serviceWrapper,
+		),
+		admin.CreateAdminController(
+			appl.engine,
+			appl.serviceWrapper,
+		),
+	}
+
+	document := openapi.CreateDocument(openapi.Info{Title: apiTitle, Version: apiVersion})
+	for _, controller := range controllers {
+		controller.ConfigureGroup()
+		controller.Describe(document)
+	}
+
+	documentHandler, documentHandlerCreationError := openapi.DocumentHandler(document)
+	if documentHandlerCreationError != nil {
+		appl.serviceWrapper.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(documentHandlerCreationError)).
+			WithFatal().
+			Log()
+		return
+	}
+
+	swaggerUiHandler, swaggerUiHandlerCreationError := openapi.SwaggerUiHandler(openApiDocumentPath, swaggerUiAssetsPath)
+	if swaggerUiHandlerCreationError != nil {
+		appl.serviceWrapper.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(swaggerUiHandlerCreationError)).
+			WithFatal().
+			Log()
+		return
+	}
+
+	swaggerUiAssets, swaggerUiAssetsError := openapi.SwaggerUiAssets()
+	if swaggerUiAssetsError != nil {
+		appl.serviceWrapper.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(swaggerUiAssetsError)).
+			WithFatal().
+			Log()
+		return
+	}
+
+	appl.engine.GET(openApiDocumentPath, documentHandler)
+	appl.engine.GET(swaggerUiPath, swaggerUiHandler)
+	appl.engine.StaticFS(swaggerUiAssetsPath, swaggerUiAssets)
+}
+
+func (appl *Application) configureJobs() {
+	userDataConfigAny, err := appl.configuration.Get(&application_config.UserDataConfig{})
+	if err != nil {
+		appl.serviceWrapper.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(err)).
+			WithFatal().
+			Log()
+		return
+	}
+
+	userDataConfig := userDataConfigAny.(*application_config.UserDataConfig)
+
+	purgeInterval, purgeIntervalParseError := userDataConfig.GetPurgeInterval()
+	if purgeIntervalParseError != nil {
+		appl.serviceWrapper.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(purgeIntervalParseError)).
+			WithFatal().
+			Log()
+		return
+	}
+
+	exportInterval, exportIntervalParseError := userDataConfig.GetExportInterval()
+	if exportIntervalParseError != nil {
+		appl.serviceWrapper.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(exportIntervalParseError)).
+			WithFatal().
+			Log()
+		return
+	}
+
+	webhooksConfigAny, err := appl.configuration.Get(&webhooks.WebhooksConfig{})
+	if err != nil {
+		appl.serviceWrapper.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(err)).
+			WithFatal().
+			Log()
+		return
+	}
+
+	webhooksConfig := webhooksConfigAny.(*webhooks.WebhooksConfig)
+
+	webhookDeliveryInterval, webhookDeliveryIntervalParseError := webhooksConfig.GetDeliveryInterval()
+	if webhookDeliveryIntervalParseError != nil {
+		appl.serviceWrapper.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(webhookDeliveryIntervalParseError)).
+			WithFatal().
+			Log()
+		return
+	}
+
+	webhookRequestTimeout, webhookRequestTimeoutParseError := webhooksConfig.GetRequestTimeout()
+	if webhookRequestTimeoutParseError != nil {
+		appl.serviceWrapper.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(webhookRequestTimeoutParseError)).
+			WithFatal().
+			Log()
+		return
+	}
+
+	webhookSender, webhookSenderCreationError := configuration.BuildFromConfiguration[webhooks.Sender](
+		appl.configuration,
+		webhooks.CreateSender,
+	)
+	if webhookSenderCreationError != nil {
+		appl.serviceWrapper.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(webhookSenderCreationError)).
+			WithFatal().
+			Log()
+		return
+	}
+
+	appl.scheduler = background.CreateScheduler(appl.serviceWrapper.GetLogger()).
+		Schedule(
+			"purge_users",
+			purgeInterval,
+			user_jobs.PurgeUsersJob{
+				Services:  appl.serviceWrapper,
+				BatchSize: userDataConfig.BatchSize,
+			}.Run,
+		).
+		Schedule(
+			"export_user_data",
+			exportInterval,
+			user_jobs.ExportUserDataJob{
+				Services:  appl.serviceWrapper,
+				BatchSize: userDataConfig.BatchSize,
+			}.Run,
+		).
+		Schedule(
+			"deliver_webhooks",
+			webhookDeliveryInterval,
+			webhook_jobs.DeliverWebhooksJob{
+				Services:      appl.serviceWrapper,
+				Sender:        webhookSender,
+				BatchSize:     webhooksConfig.BatchSize,
+				LeaseDuration: webhookRequestTimeout*time.Duration(webhooksConfig.BatchSize) + time.Minute,
+			}.Run,
+		)
 }
 
 func (appl *Application) configureMiddleware() {
@@ -101,13 +298,10 @@ func (appl *Application) configureMiddleware() {
 	}
 
 	appl.engine.Use(
+		middleware.RequestIdMiddleware(),
 		middleware.RequestLoggingMiddleware(appl.serviceWrapper.GetLogger()),
 		middleware.ErrorHandlerMiddleware(appl.serviceWrapper.GetLogger()),
 		middleware.RateLimiterMiddleware(rateLimiterConfig.(*rate_limiter.RateLimiterConfig), appl.serviceWrapper, appl.config),
-		middleware.AuthorizationMiddleware(
-			appl.serviceWrapper.GetJwtHandler(),
-			appl.serviceWrapper.GetDbConnection(),
-		),
 	)
 }
 
@@ -117,6 +311,14 @@ func (appl *Application) loadConfigurations() {
 	redisConfig := &redis.RedisConfig{}
 	rateLimiterConfig := &rate_limiter.RateLimiterConfig{}
 	s3Config := &s3.S3Config{}
+	userDataConfig := &application_config.UserDataConfig{}
+	localizationConfig := &application_config.LocalizationConfig{}
+	interestsConfig := &application_config.InterestsConfig{}
+	hashPasswordConfig := &password.HashPasswordConfig{}
+	breachedPasswordsConfig := &breached_passwords.BreachedPasswordsConfig{}
+	webhooksConfig := &webhooks.WebhooksConfig{}
+	imageProcessingConfig := &images.ImageProcessingConfig{}
+	idempotencyConfig := &idempotency.IdempotencyConfig{}
 	applicationConfig := &application_config.ApplicationConfig{}
 	envLoader := env_loader.CreateLoaderFromEnv()
 
@@ -150,13 +352,61 @@ func (appl *Application) loadConfigurations() {
 		log.Fatal(s3ConfigLoadingError)
 	}
 
+	userDataConfigLoadingError := envLoader.LoadDataIntoStruct(userDataConfig)
+	if userDataConfigLoadingError != nil {
+		log.Fatal(userDataConfigLoadingError)
+	}
+
+	localizationConfigLoadingError := envLoader.LoadDataIntoStruct(localizationConfig)
+	if localizationConfigLoadingError != nil {
+		log.Fatal(localizationConfigLoadingError)
+	}
+
+	interestsConfigLoadingError := envLoader.LoadDataIntoStruct(interestsConfig)
+	if interestsConfigLoadingError != nil {
+		log.Fatal(interestsConfigLoadingError)
+	}
+
+	hashPasswordConfigLoadingError := envLoader.LoadDataIntoStruct(hashPasswordConfig)
+	if hashPasswordConfigLoadingError != nil {
+		log.Fatal(hashPasswordConfigLoadingError)
+	}
+
+	breachedPasswordsConfigLoadingError := envLoader.LoadDataIntoStruct(breachedPasswordsConfig)
+	if breachedPasswordsConfigLoadingError != nil {
+		log.Fatal(breachedPasswordsConfigLoadingError)
+	}
+
+	webhooksConfigLoadingError := envLoader.LoadDataIntoStruct(webhooksConfig)
+	if webhooksConfigLoadingError != nil {
+		log.Fatal(webhooksConfigLoadingError)
+	}
+
+	imageProcessingConfigLoadingError := envLoader.LoadDataIntoStruct(imageProcessingConfig)
+	if imageProcessingConfigLoadingError != nil {
+		log.Fatal(imageProcessingConfigLoadingError)
+	}
+
+	idempotencyConfigLoadingError := envLoader.LoadDataIntoStruct(idempotencyConfig)
+	if idempotencyConfigLoadingError != nil {
+		log.Fatal(idempotencyConfigLoadingError)
+	}
+
 	appl.configuration = configuration.CreateConfiguration().
 		AddConfiguration(jwtConfig).
 		AddConfiguration(dbConfiguration).
 		AddConfiguration(redisConfig).
 		AddConfiguration(rateLimiterConfig).
 		AddConfiguration(applicationConfig).
-		AddConfiguration(s3Config)
+		AddConfiguration(s3Config).
+		AddConfiguration(userDataConfig).
+		AddConfiguration(localizationConfig).
+		AddConfiguration(interestsConfig).
+		AddConfiguration(hashPasswordConfig).
+		AddConfiguration(breachedPasswordsConfig).
+		AddConfiguration(webhooksConfig).
+		AddConfiguration(imageProcessingConfig).
+		AddConfiguration(idempotencyConfig)
 }
 
 func (appl *Application) configureServices() {
@@ -222,16 +472,81 @@ func (appl *Application) configureServices() {
 		return
 	}
 
+	passwordHasher, passwordHasherCreationError := configuration.BuildFromConfiguration[password.Hasher](
+		appl.configuration,
+		password.CreateHasher,
+	)
+
+	if passwordHasherCreationError != nil {
+		logger.
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(passwordHasherCreationError)).
+			WithFatal().
+			Log()
+
+		return
+	}
+
+	breachedPasswordsFilter, breachedPasswordsFilterLoadingError := configuration.BuildFromConfiguration[breached_passwords.Filter](
+		appl.configuration,
+		breached_passwords.CreateFilter,
+	)
+
+	if breachedPasswordsFilterLoadingError != nil {
+		logger.
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(breachedPasswordsFilterLoadingError)).
+			WithFatal().
+			Log()
+
+		return
+	}
+
+	imageProcessor, imageProcessorCreationError := configuration.BuildFromConfiguration[images.Processor](
+		appl.configuration,
+		images.CreateProcessor,
+	)
+
+	if imageProcessorCreationError != nil {
+		logger.
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(imageProcessorCreationError)).
+			WithFatal().
+			Log()
+
+		return
+	}
+
+	idempotencyStore, idempotencyStoreCreationError := configuration.BuildFromConfiguration[idempotency.RedisStore](
+		appl.configuration,
+		idempotency.CreateRedisStore,
+		redisClient,
+	)
+
+	if idempotencyStoreCreationError != nil {
+		logger.
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(idempotencyStoreCreationError)).
+			WithFatal().
+			Log()
+
+		return
+	}
+
 	appl.serviceWrapper = service_wrapper.CreateWrapper(
 		dbConnection,
 		jwtHandler,
 		logger,
 		redisClient,
 		s3Client,
+		appl.configuration,
+		passwordHasher,
+		breachedPasswordsFilter,
+		imageProcessor,
+		authentication.CreateJwtAuthenticator(jwtHandler, dbConnection),
+		idempotencyStore,
 	)
 }
 
 func (appl *Application) Serve() {
+	appl.scheduler.Start()
+
 	go func() {
 		if err := appl.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			log.Fatalf("run error: %s", err)
@@ -0,0 +1,5 @@
+package application_config
+
+type InterestsConfig struct {
+	MaxInterestsPerUser int32 `env:"MAX_INTERESTS_PER_USER"`
+}
@@ -0,0 +1,5 @@
+package application_config
+
+type LocalizationConfig struct {
+	DefaultLocale string `env:"DEFAULT_LOCALE"`
+}
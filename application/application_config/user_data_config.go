@@ -0,0 +1,22 @@
+package application_config
+
+import "time"
+
+type UserDataConfig struct {
+	DeletionGracePeriod string `env:"DELETION_GRACE_PERIOD"`
+	PurgeInterval       string `env:"PURGE_INTERVAL"`
+	ExportInterval      string `env:"EXPORT_INTERVAL"`
+	BatchSize           int32  `env:"BATCH_SIZE"`
+}
+
+func (cfg *UserDataConfig) GetDeletionGracePeriod() (time.Duration, error) {
+	return time.ParseDuration(cfg.DeletionGracePeriod)
+}
+
+func (cfg *UserDataConfig) GetPurgeInterval() (time.Duration, error) {
+	return time.ParseDuration(cfg.PurgeInterval)
+}
+
+func (cfg *UserDataConfig) GetExportInterval() (time.Duration, error) {
+	return time.ParseDuration(cfg.ExportInterval)
+}
@@ -0,0 +1,367 @@
+package admin
+
+import (
+	admin_validators "chat_app_backend/application/controllers/validators/admin"
+	user_validators "chat_app_backend/application/controllers/validators/users"
+	"chat_app_backend/application/handlers/admin"
+	"chat_app_backend/application/models/admin/export_audit_log"
+	"chat_app_backend/application/models/admin/force_logout"
+	"chat_app_backend/application/models/admin/get_audit_log"
+	"chat_app_backend/application/models/admin/get_users"
+	"chat_app_backend/application/models/admin/impersonate"
+	"chat_app_backend/application/models/admin/lift_suspension"
+	"chat_app_backend/application/models/admin/suspend_user"
+	"chat_app_backend/application/models/admin/update_role"
+	"chat_app_backend/internal/access"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/response"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/validator"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	router.Controller
+}
+
+func CreateAdminController(
+	engine *gin.Engine,
+	serviceWrapper service_wrapper.IServiceWrapper,
+) (ac Controller) {
+	ac.Controller = router.CreateController(
+		engine,
+		"/admin",
+		[]router.IRoute{
+			&router.AuthorizedRoute[get_users.GetUsersRequestDto, get_users.GetUsersResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/users",
+					admin.GetUsersHandler{}.Handle,
+					validator.
+						Validator[get_users.GetUsersRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[get_users.GetUsersRequestDto, db_queries.RoleType]{}.
+								RuleFor(
+									func(data *get_users.GetUsersRequestDto) *db_queries.RoleType {
+										return data.Role
+									},
+								).
+								Must(admin_validators.RoleValidator{}).
+								Optional().
+								WithMessage("role is unknown").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[get_users.GetUsersRequestDto, get_users.GetUsersRequestDto]{}.
+								RuleFor(
+									func(data *get_users.GetUsersRequestDto) *get_users.GetUsersRequestDto {
+										return data
+									},
+								).
+								Must(admin_validators.CreatedRangeValidator{}).
+								WithMessage("created_from should be before created_to").
+								Validate,
+						),
+					router.GET,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageUsers)},
+			},
+			&router.AuthorizedRoute[update_role.UpdateRoleRequestDto, update_role.UpdateRoleResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/users/:id/role",
+					admin.UpdateRoleHandler{}.Handle,
+					validator.
+						Validator[update_role.UpdateRoleRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[update_role.UpdateRoleRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *update_role.UpdateRoleRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(
+									user_validators.UserExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("user with this id does not exist").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[update_role.UpdateRoleRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *update_role.UpdateRoleRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(admin_validators.NotSelfValidator{}).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ForbiddenException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("admins can't change their own role").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[update_role.UpdateRoleRequestDto, db_queries.RoleType]{}.
+								RuleFor(
+									func(data *update_role.UpdateRoleRequestDto) *db_queries.RoleType {
+										return &data.Role
+									},
+								).
+								Must(admin_validators.RoleValidator{}).
+								WithMessage("role is unknown").
+								Validate,
+						),
+					router.PUT,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageUsers)},
+			},
+			&router.AuthorizedRoute[suspend_user.SuspendUserRequestDto, suspend_user.SuspendUserResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/users/:id/suspension",
+					admin.SuspendUserHandler{}.Handle,
+					validator.
+						Validator[suspend_user.SuspendUserRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[suspend_user.SuspendUserRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *suspend_user.SuspendUserRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(
+									user_validators.UserExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("user with this id does not exist").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[suspend_user.SuspendUserRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *suspend_user.SuspendUserRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(admin_validators.NotSelfValidator{}).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ForbiddenException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("admins can't suspend themselves").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[suspend_user.SuspendUserRequestDto, time.Time]{}.
+								RuleFor(
+									func(data *suspend_user.SuspendUserRequestDto) *time.Time {
+										return &data.SuspendedUntil
+									},
+								).
+								Must(admin_validators.SuspensionEndValidator{}).
+								WithMessage("suspension should end in the future").
+								Validate,
+						),
+					router.PUT,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageUsers)},
+			},
+			&router.AuthorizedRoute[lift_suspension.LiftSuspensionRequestDto, lift_suspension.LiftSuspensionResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/users/:id/suspension",
+					admin.LiftSuspensionHandler{}.Handle,
+					validator.
+						Validator[lift_suspension.LiftSuspensionRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[lift_suspension.LiftSuspensionRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *lift_suspension.LiftSuspensionRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(
+									user_validators.UserExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("user with this id does not exist").
+								Validate,
+						),
+					router.DELETE,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageUsers)},
+			},
+			&router.AuthorizedRoute[force_logout.ForceLogoutRequestDto, force_logout.ForceLogoutResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/users/:id/logout",
+					admin.ForceLogoutHandler{}.Handle,
+					validator.
+						Validator[force_logout.ForceLogoutRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[force_logout.ForceLogoutRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *force_logout.ForceLogoutRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(
+									user_validators.UserExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("user with this id does not exist").
+								Validate,
+						),
+					router.POST,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageUsers)},
+			},
+			&router.AuthorizedRoute[impersonate.ImpersonateRequestDto, impersonate.ImpersonateResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/users/:id/impersonate",
+					admin.ImpersonateHandler{}.Handle,
+					validator.
+						Validator[impersonate.ImpersonateRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[impersonate.ImpersonateRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *impersonate.ImpersonateRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(
+									user_validators.UserExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("user with this id does not exist").
+								Validate,
+						),
+					router.POST,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageUsers)},
+			},
+			&router.AuthorizedRoute[get_audit_log.GetAuditLogRequestDto, get_audit_log.GetAuditLogResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/audit",
+					admin.GetAuditLogHandler{}.Handle,
+					validator.
+						Validator[get_audit_log.GetAuditLogRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[get_audit_log.GetAuditLogRequestDto, get_audit_log.GetAuditLogRequestDto]{}.
+								RuleFor(
+									func(data *get_audit_log.GetAuditLogRequestDto) *get_audit_log.GetAuditLogRequestDto {
+										return data
+									},
+								).
+								Must(admin_validators.AuditLogRangeValidator{}).
+								WithMessage("created_from should be before created_to").
+								Validate,
+						),
+					router.GET,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ReadAuditLog)},
+			},
+			&router.AuthorizedRoute[export_audit_log.ExportAuditLogRequestDto, response.Stream]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/audit/export",
+					admin.ExportAuditLogHandler{}.Handle,
+					validator.
+						Validator[export_audit_log.ExportAuditLogRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[export_audit_log.ExportAuditLogRequestDto, export_audit_log.ExportAuditLogRequestDto]{}.
+								RuleFor(
+									func(data *export_audit_log.ExportAuditLogRequestDto) *export_audit_log.ExportAuditLogRequestDto {
+										return data
+									},
+								).
+								Must(admin_validators.AuditLogExportRangeValidator{}).
+								WithMessage("created_from should be before created_to").
+								Validate,
+						),
+					router.GET,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ReadAuditLog)},
+			},
+		},
+	)
+
+	return ac
+}
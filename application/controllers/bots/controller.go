@@ -0,0 +1,81 @@
+package bots
+
+import (
+	chats_validators "chat_app_backend/application/controllers/validators/chats"
+	"chat_app_backend/application/handlers/bots"
+	"chat_app_backend/application/models/bots/get_chats"
+	"chat_app_backend/application/models/bots/send_message"
+	"chat_app_backend/internal/api_keys"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	router.Controller
+}
+
+func CreateBotsController(
+	engine *gin.Engine,
+	serviceWrapper service_wrapper.IServiceWrapper,
+) (bc Controller) {
+	bc.Controller = router.CreateController(
+		engine,
+		"/bot",
+		[]router.IRoute{
+			&router.ServiceAccountRoute[get_chats.GetBotChatsRequestDto, get_chats.GetBotChatsResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/chats",
+					bots.GetBotChatsHandler{}.Handle,
+					validator.Validator[get_chats.GetBotChatsRequestDto]{},
+					router.GET,
+				),
+				Scopes: []string{api_keys.ScopeChatsRead},
+			},
+			&router.ServiceAccountRoute[send_message.SendMessageRequestDto, send_message.SendMessageResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/chats/:chat_id/messages",
+					bots.SendMessageHandler{}.Handle,
+					validator.
+						Validator[send_message.SendMessageRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[send_message.SendMessageRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *send_message.SendMessageRequestDto) *extensions.UUID {
+										return &data.ChatID
+									},
+								).
+								Must(
+									chats_validators.BotChatMembershipValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ForbiddenException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("bot is not invited to this chat").
+								Validate,
+						),
+					router.POST,
+				),
+				Scopes: []string{api_keys.ScopeMessagesWrite},
+			},
+		},
+	)
+
+	return bc
+}
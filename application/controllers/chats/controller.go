@@ -0,0 +1,216 @@
+package chats
+
+import (
+	chats_validators "chat_app_backend/application/controllers/validators/chats"
+	contacts_validators "chat_app_backend/application/controllers/validators/contacts"
+	service_accounts_validators "chat_app_backend/application/controllers/validators/service_accounts"
+	user_validators "chat_app_backend/application/controllers/validators/users"
+	"chat_app_backend/application/handlers/chats"
+	"chat_app_backend/application/models/chats/add_bot"
+	"chat_app_backend/application/models/chats/remove_bot"
+	"chat_app_backend/application/models/chats/start_private"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	router.Controller
+}
+
+func CreateChatsController(
+	engine *gin.Engine,
+	serviceWrapper service_wrapper.IServiceWrapper,
+) (cc Controller) {
+	cc.Controller = router.CreateController(
+		engine,
+		"/chats",
+		[]router.IRoute{
+			&router.AuthorizedRoute[add_bot.AddBotRequestDto, add_bot.AddBotResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/:chat_id/bots",
+					chats.AddBotHandler{}.Handle,
+					validator.
+						Validator[add_bot.AddBotRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[add_bot.AddBotRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *add_bot.AddBotRequestDto) *extensions.UUID {
+										return &data.ChatID
+									},
+								).
+								Must(
+									chats_validators.ChatMembershipValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ForbiddenException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("you are not a member of this chat").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[add_bot.AddBotRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *add_bot.AddBotRequestDto) *extensions.UUID {
+										return &data.ServiceAccountID
+									},
+								).
+								Must(
+									service_accounts_validators.ServiceAccountExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("service account with this id does not exist").
+								Validate,
+						),
+					router.POST,
+				),
+			},
+			&router.AuthorizedRoute[remove_bot.RemoveBotRequestDto, remove_bot.RemoveBotResponseDto]{
+				Route: &router.DestructiveRoute[remove_bot.RemoveBotRequestDto, remove_bot.RemoveBotResponseDto]{
+					Route: router.CreateBaseRoute(
+						serviceWrapper,
+						"/:chat_id/bots/:service_account_id",
+						chats.RemoveBotHandler{}.Handle,
+						validator.
+							Validator[remove_bot.RemoveBotRequestDto]{}.
+							AttachValidator(
+								validator.ExternalValidator[remove_bot.RemoveBotRequestDto, extensions.UUID]{}.
+									RuleFor(
+										func(data *remove_bot.RemoveBotRequestDto) *extensions.UUID {
+											return &data.ChatID
+										},
+									).
+									Must(
+										chats_validators.ChatMembershipValidator{
+											Db: serviceWrapper.GetDbConnection(),
+										},
+									).
+									WithExceptionFactory(
+										func(message string) error {
+											return &common_exceptions.ForbiddenException{
+												BaseRestException: exceptions.BaseRestException{
+													ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+													Message:             message,
+												},
+											}
+										},
+									).
+									WithMessage("you are not a member of this chat").
+									Validate,
+							),
+						router.DELETE,
+					),
+				},
+			},
+			&router.AuthorizedRoute[start_private.StartPrivateChatRequestDto, start_private.StartPrivateChatResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/private",
+					chats.StartPrivateChatHandler{}.Handle,
+					validator.
+						Validator[start_private.StartPrivateChatRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[start_private.StartPrivateChatRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *start_private.StartPrivateChatRequestDto) *extensions.UUID {
+										return &data.UserID
+									},
+								).
+								Must(contacts_validators.NotSelfValidator{}).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.InvalidBodyException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("you can't start a private chat with yourself").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[start_private.StartPrivateChatRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *start_private.StartPrivateChatRequestDto) *extensions.UUID {
+										return &data.UserID
+									},
+								).
+								Must(
+									user_validators.ActiveUserExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("user with this id does not exist").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[start_private.StartPrivateChatRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *start_private.StartPrivateChatRequestDto) *extensions.UUID {
+										return &data.UserID
+									},
+								).
+								Must(
+									chats_validators.PrivateChatPermissionValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ForbiddenException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("this user does not accept private chats from you").
+								Validate,
+						),
+					router.POST,
+				),
+			},
+		},
+	)
+
+	return cc
+}
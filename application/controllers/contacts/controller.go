@@ -0,0 +1,326 @@
+package contacts
+
+import (
+	contacts_validators "chat_app_backend/application/controllers/validators/contacts"
+	user_validators "chat_app_backend/application/controllers/validators/users"
+	"chat_app_backend/application/handlers/contacts"
+	"chat_app_backend/application/models/contacts/get"
+	"chat_app_backend/application/models/contacts/get_requests"
+	"chat_app_backend/application/models/contacts/remove"
+	"chat_app_backend/application/models/contacts/resolve_request"
+	"chat_app_backend/application/models/contacts/send_request"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	router.Controller
+}
+
+func CreateContactsController(
+	engine *gin.Engine,
+	serviceWrapper service_wrapper.IServiceWrapper,
+) (cc Controller) {
+	cc.Controller = router.CreateController(
+		engine,
+		"/contacts",
+		[]router.IRoute{
+			&router.AuthorizedRoute[get.GetContactsRequestDto, get.GetContactsResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/",
+					contacts.GetContactsHandler{}.Handle,
+					validator.Validator[get.GetContactsRequestDto]{},
+					router.GET,
+				),
+			},
+			&router.AuthorizedRoute[remove.RemoveContactRequestDto, remove.RemoveContactResponseDto]{
+				Route: &router.DestructiveRoute[remove.RemoveContactRequestDto, remove.RemoveContactResponseDto]{
+					Route: router.CreateBaseRoute(
+						serviceWrapper,
+						"/:user_id",
+						contacts.RemoveContactHandler{}.Handle,
+						validator.
+							Validator[remove.RemoveContactRequestDto]{}.
+							AttachValidator(
+								validator.ExternalValidator[remove.RemoveContactRequestDto, extensions.UUID]{}.
+									RuleFor(
+										func(data *remove.RemoveContactRequestDto) *extensions.UUID {
+											return &data.UserID
+										},
+									).
+									Must(
+										contacts_validators.ContactExistenceValidator{
+											Db: serviceWrapper.GetDbConnection(),
+										},
+									).
+									WithExceptionFactory(
+										func(message string) error {
+											return &common_exceptions.ResourceNotFoundException{
+												BaseRestException: exceptions.BaseRestException{
+													ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+													Message:             message,
+												},
+											}
+										},
+									).
+									WithMessage("this user is not your contact").
+									Validate,
+							),
+						router.DELETE,
+					),
+				},
+			},
+			&router.AuthorizedRoute[send_request.SendContactRequestRequestDto, send_request.SendContactRequestResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/requests",
+					contacts.SendRequestHandler{}.Handle,
+					validator.
+						Validator[send_request.SendContactRequestRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[send_request.SendContactRequestRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *send_request.SendContactRequestRequestDto) *extensions.UUID {
+										return &data.ReceiverID
+									},
+								).
+								Must(contacts_validators.NotSelfValidator{}).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.InvalidBodyException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("you can't send a contact request to yourself").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[send_request.SendContactRequestRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *send_request.SendContactRequestRequestDto) *extensions.UUID {
+										return &data.ReceiverID
+									},
+								).
+								Must(
+									user_validators.ActiveUserExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("user with this id does not exist").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[send_request.SendContactRequestRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *send_request.SendContactRequestRequestDto) *extensions.UUID {
+										return &data.ReceiverID
+									},
+								).
+								Must(
+									contacts_validators.ContactAbsenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.InvalidBodyException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("this user is already your contact").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[send_request.SendContactRequestRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *send_request.SendContactRequestRequestDto) *extensions.UUID {
+										return &data.ReceiverID
+									},
+								).
+								Must(
+									contacts_validators.PendingRequestAbsenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.InvalidBodyException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("there is already a pending contact request between you and this user").
+								Validate,
+						),
+					router.POST,
+				),
+			},
+			&router.AuthorizedRoute[get_requests.GetContactRequestsRequestDto, get_requests.GetContactRequestsResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/requests",
+					contacts.GetRequestsHandler{}.Handle,
+					validator.
+						Validator[get_requests.GetContactRequestsRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[get_requests.GetContactRequestsRequestDto, string]{}.
+								RuleFor(
+									func(data *get_requests.GetContactRequestsRequestDto) *string {
+										return &data.Direction
+									},
+								).
+								Must(contacts_validators.ContactRequestDirectionValidator{}).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.InvalidBodyException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("direction should be either incoming or outgoing").
+								Validate,
+						),
+					router.GET,
+				),
+			},
+			&router.AuthorizedRoute[resolve_request.ResolveContactRequestRequestDto, resolve_request.ResolveContactRequestResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/requests/:id/accept",
+					contacts.AcceptRequestHandler{}.Handle,
+					validator.
+						Validator[resolve_request.ResolveContactRequestRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[resolve_request.ResolveContactRequestRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *resolve_request.ResolveContactRequestRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(
+									contacts_validators.IncomingRequestValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("pending contact request not found").
+								Validate,
+						),
+					router.POST,
+				),
+			},
+			&router.AuthorizedRoute[resolve_request.ResolveContactRequestRequestDto, resolve_request.ResolveContactRequestResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/requests/:id/decline",
+					contacts.DeclineRequestHandler{}.Handle,
+					validator.
+						Validator[resolve_request.ResolveContactRequestRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[resolve_request.ResolveContactRequestRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *resolve_request.ResolveContactRequestRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(
+									contacts_validators.IncomingRequestValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("pending contact request not found").
+								Validate,
+						),
+					router.POST,
+				),
+			},
+			&router.AuthorizedRoute[resolve_request.ResolveContactRequestRequestDto, resolve_request.ResolveContactRequestResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/requests/:id/cancel",
+					contacts.CancelRequestHandler{}.Handle,
+					validator.
+						Validator[resolve_request.ResolveContactRequestRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[resolve_request.ResolveContactRequestRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *resolve_request.ResolveContactRequestRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(
+									contacts_validators.OutgoingRequestValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("pending contact request not found").
+								Validate,
+						),
+					router.POST,
+				),
+			},
+		},
+	)
+
+	return cc
+}
@@ -0,0 +1,183 @@
+package interest_categories
+
+import (
+	interests_validators "chat_app_backend/application/controllers/validators/interests"
+	"chat_app_backend/application/handlers/interest_categories"
+	"chat_app_backend/application/models/interest_categories/create"
+	"chat_app_backend/application/models/interest_categories/delete"
+	"chat_app_backend/application/models/interest_categories/get"
+	"chat_app_backend/application/models/interest_categories/update"
+	"chat_app_backend/internal/access"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	router.Controller
+}
+
+func CreateInterestCategoriesController(
+	engine *gin.Engine,
+	serviceWrapper service_wrapper.IServiceWrapper,
+) (icc Controller) {
+	icc.Controller = router.CreateController(
+		engine,
+		"/interest-categories",
+		[]router.IRoute{
+			&router.AuthorizedRoute[get.GetInterestCategoriesRequestDto, get.GetInterestCategoriesResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/",
+					interest_categories.GetInterestCategoriesHandler{}.Handle,
+					validator.Validator[get.GetInterestCategoriesRequestDto]{},
+					router.GET,
+				),
+			},
+			&router.AuthorizedRoute[create.CreateInterestCategoryRequestDto, create.CreateInterestCategoryResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/",
+					interest_categories.CreateInterestCategoryHandler{}.Handle,
+					validator.
+						Validator[create.CreateInterestCategoryRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[create.CreateInterestCategoryRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *create.CreateInterestCategoryRequestDto) *extensions.UUID {
+										return data.ParentID
+									},
+								).
+								Must(
+									interests_validators.InterestCategoryExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								Optional().
+								WithMessage("parent category does not exist").
+								Validate,
+						),
+					router.POST,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageInterests)},
+			},
+			&router.AuthorizedRoute[update.UpdateInterestCategoryRequestDto, update.UpdateInterestCategoryResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/:id",
+					interest_categories.UpdateInterestCategoryHandler{}.Handle,
+					validator.
+						Validator[update.UpdateInterestCategoryRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[update.UpdateInterestCategoryRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *update.UpdateInterestCategoryRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(
+									interests_validators.InterestCategoryExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("category with provided id does not exist").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[update.UpdateInterestCategoryRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *update.UpdateInterestCategoryRequestDto) *extensions.UUID {
+										return data.ParentID
+									},
+								).
+								Must(
+									interests_validators.InterestCategoryExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								Optional().
+								WithMessage("parent category does not exist").
+								Validate,
+						),
+					router.PUT,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageInterests)},
+			},
+			&router.AuthorizedRoute[delete.DeleteInterestCategoryRequestDto, delete.DeleteInterestCategoryResponseDto]{
+				Route: &router.DestructiveRoute[delete.DeleteInterestCategoryRequestDto, delete.DeleteInterestCategoryResponseDto]{
+					Route: router.CreateBaseRoute(
+						serviceWrapper,
+						"/:id",
+						interest_categories.DeleteInterestCategoryHandler{}.Handle,
+						validator.
+							Validator[delete.DeleteInterestCategoryRequestDto]{}.
+							AttachValidator(
+								validator.ExternalValidator[delete.DeleteInterestCategoryRequestDto, extensions.UUID]{}.
+									RuleFor(
+										func(data *delete.DeleteInterestCategoryRequestDto) *extensions.UUID {
+											return &data.ID
+										},
+									).
+									Must(
+										interests_validators.InterestCategoryExistenceValidator{
+											Db: serviceWrapper.GetDbConnection(),
+										},
+									).
+									WithExceptionFactory(
+										func(message string) error {
+											return &common_exceptions.ResourceNotFoundException{
+												BaseRestException: exceptions.BaseRestException{
+													ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+													Message:             message,
+												},
+											}
+										},
+									).
+									WithMessage("category with provided id does not exist").
+									Validate,
+							),
+						router.DELETE,
+					),
+				},
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageInterests)},
+			},
+		},
+	)
+
+	return icc
+}
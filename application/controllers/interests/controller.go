@@ -4,21 +4,38 @@ import (
 	interests_validators "chat_app_backend/application/controllers/validators/interests"
 	user_validators "chat_app_backend/application/controllers/validators/users"
 	"chat_app_backend/application/handlers/interests"
+	"chat_app_backend/application/models/interests/add_interests"
 	"chat_app_backend/application/models/interests/assign"
 	"chat_app_backend/application/models/interests/create"
 	"chat_app_backend/application/models/interests/delete"
+	"chat_app_backend/application/models/interests/delete_translation"
+	"chat_app_backend/application/models/interests/export_interests"
+	"chat_app_backend/application/models/interests/export_stats"
 	"chat_app_backend/application/models/interests/get"
+	"chat_app_backend/application/models/interests/get_translations"
+	"chat_app_backend/application/models/interests/import_interests"
+	"chat_app_backend/application/models/interests/remove_interests"
+	"chat_app_backend/application/models/interests/stats"
+	"chat_app_backend/application/models/interests/trending"
 	"chat_app_backend/application/models/interests/update"
+	"chat_app_backend/application/models/interests/upsert_translation"
+	"chat_app_backend/internal/access"
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/exceptions/common_exceptions"
 	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/middleware"
+	"chat_app_backend/internal/response"
 	"chat_app_backend/internal/router"
 	"chat_app_backend/internal/service_wrapper"
 	"chat_app_backend/internal/validator"
+	"mime/multipart"
 
 	"github.com/gin-gonic/gin"
 )
 
+// the archive is uploaded compressed, the size of the extracted files is limited by the handler
+const maxImportRequestSize int64 = 64 << 20
+
 type Controller struct {
 	router.Controller
 }
@@ -47,76 +64,186 @@ func CreateInterestsController(
 					interests.CreateInterestHandler{}.Handle,
 					validator.Validator[create.CreateInterestRequestDto]{}.
 						AttachValidator(
-							validator.ExternalValidator[create.CreateInterestRequestDto, interface{}]{}.
+							validator.ExternalValidator[create.CreateInterestRequestDto, multipart.FileHeader]{}.
 								RuleFor(
-									func(data *create.CreateInterestRequestDto) *interface{} {
-										return nil
+									func(data *create.CreateInterestRequestDto) *multipart.FileHeader {
+										return data.Icon
+									},
+								).
+								Must(interests_validators.IconFileTypeValidator{}).
+								WithMessage("invalid file type").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[create.CreateInterestRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *create.CreateInterestRequestDto) *extensions.UUID {
+										return data.CategoryID
+									},
+								).
+								Must(
+									interests_validators.InterestCategoryExistenceValidator{
+										Db: wrapper.GetDbConnection(),
 									},
 								).
-								Must(interests_validators.InterestModificationAccessValidator{}).
 								WithExceptionFactory(
 									func(message string) error {
-										return &common_exceptions.ForbiddenException{
+										return &common_exceptions.ResourceNotFoundException{
 											BaseRestException: exceptions.BaseRestException{
-												ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
 												Message:             message,
 											},
 										}
 									},
 								).
-								WithMessage("interest creation is forbidden").
+								Optional().
+								WithMessage("category with provided id does not exist").
+								Validate,
+						),
+					router.POST,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageInterests)},
+			},
+			&router.AuthorizedRoute[delete.DeleteInterestRequestDto, delete.DeleteInterestResponseDto]{
+				Route: &router.DestructiveRoute[delete.DeleteInterestRequestDto, delete.DeleteInterestResponseDto]{
+					Route: router.CreateBaseRoute(
+						wrapper,
+						"/:id",
+						interests.DeleteInterestHandler{}.Handle,
+						validator.Validator[delete.DeleteInterestRequestDto]{}.
+							AttachValidator(
+								validator.ExternalValidator[delete.DeleteInterestRequestDto, []extensions.UUID]{}.
+									RuleFor(
+										func(data *delete.DeleteInterestRequestDto) *[]extensions.UUID {
+											return &[]extensions.UUID{data.ID}
+										},
+									).
+									Must(
+										interests_validators.InterestsExistenceValidator{
+											Db: wrapper.GetDbConnection(),
+										},
+									).
+									WithExceptionFactory(
+										func(message string) error {
+											return &common_exceptions.ResourceNotFoundException{
+												BaseRestException: exceptions.BaseRestException{
+													ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+													Message:             message,
+												},
+											}
+										},
+									).
+									WithMessage("interest with provided id does not exist").
+									Validate,
+							),
+						router.DELETE,
+					),
+				},
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageInterests)},
+			},
+			&router.AuthorizedRoute[update.UpdateInterestRequestDto, update.UpdateInterestResponseDto]{
+				Route: router.CreateBaseRoute(
+					wrapper,
+					"/:id",
+					interests.UpdateInterestsHandler{}.Handle,
+					validator.Validator[update.UpdateInterestRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[update.UpdateInterestRequestDto, []extensions.UUID]{}.
+								RuleFor(
+									func(data *update.UpdateInterestRequestDto) *[]extensions.UUID {
+										return &[]extensions.UUID{data.ID}
+									},
+								).
+								Must(
+									interests_validators.InterestsExistenceValidator{
+										Db: wrapper.GetDbConnection(),
+									},
+								).
+								WithMessage("interest with provided id does not exist").
 								Validate,
 						).
 						AttachValidator(
-							validator.ExternalValidator[create.CreateInterestRequestDto, string]{}.
+							validator.ExternalValidator[update.UpdateInterestRequestDto, multipart.FileHeader]{}.
 								RuleFor(
-									func(data *create.CreateInterestRequestDto) *string {
-										return &data.Icon.Filename
+									func(data *update.UpdateInterestRequestDto) *multipart.FileHeader {
+										return data.Icon
 									},
 								).
 								Must(interests_validators.IconFileTypeValidator{}).
 								WithMessage("invalid file type").
+								Optional().
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[update.UpdateInterestRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *update.UpdateInterestRequestDto) *extensions.UUID {
+										return data.CategoryID
+									},
+								).
+								Must(
+									interests_validators.InterestCategoryExistenceValidator{
+										Db: wrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								Optional().
+								WithMessage("category with provided id does not exist").
 								Validate,
 						),
-					router.POST,
+					router.PUT,
 				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageInterests)},
 			},
-			&router.AuthorizedRoute[delete.DeleteInterestRequestDto, delete.DeleteInterestResponseDto]{
+			&router.AuthorizedRoute[assign.AssignInterestRequestDto, assign.AssignInterestResponseDto]{
 				Route: router.CreateBaseRoute(
 					wrapper,
-					"/:id",
-					interests.DeleteInterestHandler{}.Handle,
-					validator.Validator[delete.DeleteInterestRequestDto]{}.
+					"/assign",
+					interests.AssignInterestHandler{}.Handle,
+					validator.Validator[assign.AssignInterestRequestDto]{}.
 						AttachValidator(
-							validator.ExternalValidator[delete.DeleteInterestRequestDto, interface{}]{}.
+							validator.ExternalValidator[assign.AssignInterestRequestDto, []extensions.UUID]{}.
 								RuleFor(
-									func(data *delete.DeleteInterestRequestDto) *interface{} {
-										return nil
+									func(data *assign.AssignInterestRequestDto) *[]extensions.UUID {
+										return &data.InterestIds
+									},
+								).
+								Must(
+									interests_validators.InterestsExistenceValidator{
+										Db: wrapper.GetDbConnection(),
 									},
 								).
-								Must(interests_validators.InterestModificationAccessValidator{}).
 								WithExceptionFactory(
 									func(message string) error {
-										return &common_exceptions.ForbiddenException{
+										return &common_exceptions.ResourceNotFoundException{
 											BaseRestException: exceptions.BaseRestException{
-												ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
 												Message:             message,
 											},
 										}
 									},
 								).
-								WithMessage("interest deletion is forbidden").
+								WithMessage("some interests dont exist").
 								Validate,
 						).
 						AttachValidator(
-							validator.ExternalValidator[delete.DeleteInterestRequestDto, []extensions.UUID]{}.
+							validator.ExternalValidator[assign.AssignInterestRequestDto, extensions.UUID]{}.
 								RuleFor(
-									func(data *delete.DeleteInterestRequestDto) *[]extensions.UUID {
-										return &[]extensions.UUID{data.ID}
+									func(data *assign.AssignInterestRequestDto) *extensions.UUID {
+										return &data.UserID
 									},
 								).
 								Must(
-									interests_validators.InterestsExistenceValidator{
+									user_validators.UserExistenceValidator{
 										Db: wrapper.GetDbConnection(),
 									},
 								).
@@ -124,49 +251,91 @@ func CreateInterestsController(
 									func(message string) error {
 										return &common_exceptions.ResourceNotFoundException{
 											BaseRestException: exceptions.BaseRestException{
-												ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
 												Message:             message,
 											},
 										}
 									},
 								).
-								WithMessage("interest with provided id does not exist").
+								WithMessage("user does not exist").
 								Validate,
 						),
-					router.DELETE,
+					router.PUT,
 				),
 			},
-			&router.AuthorizedRoute[update.UpdateInterestRequestDto, update.UpdateInterestResponseDto]{
+			&router.AuthorizedRoute[stats.GetInterestStatsRequestDto, stats.GetInterestStatsResponseDto]{
 				Route: router.CreateBaseRoute(
 					wrapper,
-					"/:id",
-					interests.UpdateInterestsHandler{}.Handle,
-					validator.Validator[update.UpdateInterestRequestDto]{}.
+					"/stats",
+					interests.GetInterestStatsHandler{}.Handle,
+					validator.Validator[stats.GetInterestStatsRequestDto]{},
+					router.GET,
+				),
+			},
+			&router.AuthorizedRoute[trending.GetTrendingInterestsRequestDto, trending.GetTrendingInterestsResponseDto]{
+				Route: router.CreateBaseRoute(
+					wrapper,
+					"/trending",
+					interests.GetTrendingInterestsHandler{}.Handle,
+					validator.Validator[trending.GetTrendingInterestsRequestDto]{},
+					router.GET,
+				),
+			},
+			&router.AuthorizedRoute[export_stats.ExportInterestStatsRequestDto, response.Stream]{
+				Route: router.CreateBaseRoute(
+					wrapper,
+					"/stats/export",
+					interests.ExportInterestStatsHandler{}.Handle,
+					validator.Validator[export_stats.ExportInterestStatsRequestDto]{},
+					router.GET,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageInterests)},
+			},
+			&router.AuthorizedRoute[get_translations.GetInterestTranslationsRequestDto, get_translations.GetInterestTranslationsResponseDto]{
+				Route: router.CreateBaseRoute(
+					wrapper,
+					"/:id/translations",
+					interests.GetInterestTranslationsHandler{}.Handle,
+					validator.Validator[get_translations.GetInterestTranslationsRequestDto]{}.
 						AttachValidator(
-							validator.ExternalValidator[update.UpdateInterestRequestDto, interface{}]{}.
+							validator.ExternalValidator[get_translations.GetInterestTranslationsRequestDto, []extensions.UUID]{}.
 								RuleFor(
-									func(data *update.UpdateInterestRequestDto) *interface{} {
-										return nil
+									func(data *get_translations.GetInterestTranslationsRequestDto) *[]extensions.UUID {
+										return &[]extensions.UUID{data.ID}
+									},
+								).
+								Must(
+									interests_validators.InterestsExistenceValidator{
+										Db: wrapper.GetDbConnection(),
 									},
 								).
-								Must(interests_validators.InterestModificationAccessValidator{}).
 								WithExceptionFactory(
 									func(message string) error {
-										return &common_exceptions.ForbiddenException{
+										return &common_exceptions.ResourceNotFoundException{
 											BaseRestException: exceptions.BaseRestException{
-												ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
 												Message:             message,
 											},
 										}
 									},
 								).
-								WithMessage("interest modification is forbidden").
+								WithMessage("interest does not exist").
 								Validate,
-						).
+						),
+					router.GET,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageInterests)},
+			},
+			&router.AuthorizedRoute[upsert_translation.UpsertInterestTranslationRequestDto, upsert_translation.UpsertInterestTranslationResponseDto]{
+				Route: router.CreateBaseRoute(
+					wrapper,
+					"/:id/translations/:locale",
+					interests.UpsertInterestTranslationHandler{}.Handle,
+					validator.Validator[upsert_translation.UpsertInterestTranslationRequestDto]{}.
 						AttachValidator(
-							validator.ExternalValidator[update.UpdateInterestRequestDto, []extensions.UUID]{}.
+							validator.ExternalValidator[upsert_translation.UpsertInterestTranslationRequestDto, []extensions.UUID]{}.
 								RuleFor(
-									func(data *update.UpdateInterestRequestDto) *[]extensions.UUID {
+									func(data *upsert_translation.UpsertInterestTranslationRequestDto) *[]extensions.UUID {
 										return &[]extensions.UUID{data.ID}
 									},
 								).
@@ -175,34 +344,115 @@ func CreateInterestsController(
 										Db: wrapper.GetDbConnection(),
 									},
 								).
-								WithMessage("interest with provided id does not exist").
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("interest does not exist").
 								Validate,
 						).
 						AttachValidator(
-							validator.ExternalValidator[update.UpdateInterestRequestDto, string]{}.
+							validator.ExternalValidator[upsert_translation.UpsertInterestTranslationRequestDto, string]{}.
 								RuleFor(
-									func(data *update.UpdateInterestRequestDto) *string {
-										return &data.Icon.Filename
+									func(data *upsert_translation.UpsertInterestTranslationRequestDto) *string {
+										return &data.Locale
 									},
 								).
-								Must(interests_validators.IconFileTypeValidator{}).
-								WithMessage("invalid file type").
-								Optional().
+								Must(interests_validators.LocaleValidator{}).
+								WithMessage("invalid locale").
 								Validate,
 						),
 					router.PUT,
 				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageInterests)},
 			},
-			&router.AuthorizedRoute[assign.AssignInterestRequestDto, assign.AssignInterestResponseDto]{
+			&router.AuthorizedRoute[delete_translation.DeleteInterestTranslationRequestDto, delete_translation.DeleteInterestTranslationResponseDto]{
+				Route: &router.DestructiveRoute[delete_translation.DeleteInterestTranslationRequestDto, delete_translation.DeleteInterestTranslationResponseDto]{
+					Route: router.CreateBaseRoute(
+						wrapper,
+						"/:id/translations/:locale",
+						interests.DeleteInterestTranslationHandler{}.Handle,
+						validator.Validator[delete_translation.DeleteInterestTranslationRequestDto]{}.
+							AttachValidator(
+								validator.ExternalValidator[delete_translation.DeleteInterestTranslationRequestDto, []extensions.UUID]{}.
+									RuleFor(
+										func(data *delete_translation.DeleteInterestTranslationRequestDto) *[]extensions.UUID {
+											return &[]extensions.UUID{data.ID}
+										},
+									).
+									Must(
+										interests_validators.InterestsExistenceValidator{
+											Db: wrapper.GetDbConnection(),
+										},
+									).
+									WithExceptionFactory(
+										func(message string) error {
+											return &common_exceptions.ResourceNotFoundException{
+												BaseRestException: exceptions.BaseRestException{
+													ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+													Message:             message,
+												},
+											}
+										},
+									).
+									WithMessage("interest does not exist").
+									Validate,
+							).
+							AttachValidator(
+								validator.ExternalValidator[delete_translation.DeleteInterestTranslationRequestDto, string]{}.
+									RuleFor(
+										func(data *delete_translation.DeleteInterestTranslationRequestDto) *string {
+											return &data.Locale
+										},
+									).
+									Must(interests_validators.LocaleValidator{}).
+									WithMessage("invalid locale").
+									Validate,
+							),
+						router.DELETE,
+					),
+				},
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageInterests)},
+			},
+			&router.AuthorizedRoute[import_interests.ImportInterestsRequestDto, import_interests.ImportInterestsResponseDto]{
+				Route: &router.MiddlewareRoute[import_interests.ImportInterestsRequestDto, import_interests.ImportInterestsResponseDto]{
+					Route: router.CreateBaseRoute(
+						wrapper,
+						"/import",
+						interests.ImportInterestsHandler{}.Handle,
+						validator.Validator[import_interests.ImportInterestsRequestDto]{},
+						router.POST,
+					),
+					Middleware: []gin.HandlerFunc{middleware.BodySizeLimitMiddleware(maxImportRequestSize)},
+				},
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageInterests)},
+			},
+			&router.AuthorizedRoute[export_interests.ExportInterestsRequestDto, response.Stream]{
 				Route: router.CreateBaseRoute(
 					wrapper,
-					"/assign",
-					interests.AssignInterestHandler{}.Handle,
-					validator.Validator[assign.AssignInterestRequestDto]{}.
+					"/export",
+					interests.ExportInterestsHandler{}.Handle,
+					validator.Validator[export_interests.ExportInterestsRequestDto]{},
+					router.GET,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageInterests)},
+			},
+			&router.AuthorizedRoute[add_interests.AddInterestsRequestDto, add_interests.AddInterestsResponseDto]{
+				Route: router.CreateBaseRoute(
+					wrapper,
+					"/assign/add",
+					interests.AddInterestsHandler{}.Handle,
+					validator.Validator[add_interests.AddInterestsRequestDto]{}.
 						AttachValidator(
-							validator.ExternalValidator[assign.AssignInterestRequestDto, []extensions.UUID]{}.
+							validator.ExternalValidator[add_interests.AddInterestsRequestDto, []extensions.UUID]{}.
 								RuleFor(
-									func(data *assign.AssignInterestRequestDto) *[]extensions.UUID {
+									func(data *add_interests.AddInterestsRequestDto) *[]extensions.UUID {
 										return &data.InterestIds
 									},
 								).
@@ -215,7 +465,7 @@ func CreateInterestsController(
 									func(message string) error {
 										return &common_exceptions.ResourceNotFoundException{
 											BaseRestException: exceptions.BaseRestException{
-												ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
 												Message:             message,
 											},
 										}
@@ -225,9 +475,9 @@ func CreateInterestsController(
 								Validate,
 						).
 						AttachValidator(
-							validator.ExternalValidator[assign.AssignInterestRequestDto, extensions.UUID]{}.
+							validator.ExternalValidator[add_interests.AddInterestsRequestDto, extensions.UUID]{}.
 								RuleFor(
-									func(data *assign.AssignInterestRequestDto) *extensions.UUID {
+									func(data *add_interests.AddInterestsRequestDto) *extensions.UUID {
 										return &data.UserID
 									},
 								).
@@ -240,7 +490,7 @@ func CreateInterestsController(
 									func(message string) error {
 										return &common_exceptions.ResourceNotFoundException{
 											BaseRestException: exceptions.BaseRestException{
-												ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
 												Message:             message,
 											},
 										}
@@ -249,7 +499,41 @@ func CreateInterestsController(
 								WithMessage("user does not exist").
 								Validate,
 						),
-					router.PUT,
+					router.PATCH,
+				),
+			},
+			&router.AuthorizedRoute[remove_interests.RemoveInterestsRequestDto, remove_interests.RemoveInterestsResponseDto]{
+				Route: router.CreateBaseRoute(
+					wrapper,
+					"/assign/remove",
+					interests.RemoveInterestsHandler{}.Handle,
+					validator.Validator[remove_interests.RemoveInterestsRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[remove_interests.RemoveInterestsRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *remove_interests.RemoveInterestsRequestDto) *extensions.UUID {
+										return &data.UserID
+									},
+								).
+								Must(
+									user_validators.UserExistenceValidator{
+										Db: wrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("user does not exist").
+								Validate,
+						),
+					router.PATCH,
 				),
 			},
 		},
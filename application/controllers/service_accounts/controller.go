@@ -0,0 +1,210 @@
+package service_accounts
+
+import (
+	service_accounts_validators "chat_app_backend/application/controllers/validators/service_accounts"
+	"chat_app_backend/application/handlers/service_accounts"
+	"chat_app_backend/application/models/service_accounts/create"
+	"chat_app_backend/application/models/service_accounts/create_key"
+	"chat_app_backend/application/models/service_accounts/get"
+	"chat_app_backend/application/models/service_accounts/get_keys"
+	"chat_app_backend/application/models/service_accounts/revoke_key"
+	"chat_app_backend/internal/access"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	router.Controller
+}
+
+func CreateServiceAccountsController(
+	engine *gin.Engine,
+	serviceWrapper service_wrapper.IServiceWrapper,
+) (sc Controller) {
+	sc.Controller = router.CreateController(
+		engine,
+		"/service-accounts",
+		[]router.IRoute{
+			&router.AuthorizedRoute[create.CreateServiceAccountRequestDto, create.CreateServiceAccountResponseDto]{
+				Route: &router.DestructiveRoute[create.CreateServiceAccountRequestDto, create.CreateServiceAccountResponseDto]{
+					Route: router.CreateBaseRoute(
+						serviceWrapper,
+						"/",
+						service_accounts.CreateServiceAccountHandler{}.Handle,
+						validator.
+							Validator[create.CreateServiceAccountRequestDto]{}.
+							AttachValidator(
+								validator.ExternalValidator[create.CreateServiceAccountRequestDto, string]{}.
+									RuleFor(
+										func(data *create.CreateServiceAccountRequestDto) *string {
+											return &data.Name
+										},
+									).
+									Must(
+										service_accounts_validators.ServiceAccountNameUniquenessValidator{
+											Db: serviceWrapper.GetDbConnection(),
+										},
+									).
+									WithExceptionFactory(
+										func(message string) error {
+											return &common_exceptions.InvalidBodyException{
+												BaseRestException: exceptions.BaseRestException{
+													ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+													Message:             message,
+												},
+											}
+										},
+									).
+									WithMessage("service account with this name already exists").
+									Validate,
+							),
+						router.POST,
+					),
+				},
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageServiceAccounts)},
+			},
+			&router.AuthorizedRoute[get.GetServiceAccountsRequestDto, get.GetServiceAccountsResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/",
+					service_accounts.GetServiceAccountsHandler{}.Handle,
+					validator.
+						Validator[get.GetServiceAccountsRequestDto]{},
+					router.GET,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageServiceAccounts)},
+			},
+			&router.AuthorizedRoute[create_key.CreateApiKeyRequestDto, create_key.CreateApiKeyResponseDto]{
+				Route: &router.DestructiveRoute[create_key.CreateApiKeyRequestDto, create_key.CreateApiKeyResponseDto]{
+					Route: router.CreateBaseRoute(
+						serviceWrapper,
+						"/:id/keys",
+						service_accounts.CreateApiKeyHandler{}.Handle,
+						validator.
+							Validator[create_key.CreateApiKeyRequestDto]{}.
+							AttachValidator(
+								validator.ExternalValidator[create_key.CreateApiKeyRequestDto, extensions.UUID]{}.
+									RuleFor(
+										func(data *create_key.CreateApiKeyRequestDto) *extensions.UUID {
+											return &data.ServiceAccountID
+										},
+									).
+									Must(
+										service_accounts_validators.ServiceAccountExistenceValidator{
+											Db: serviceWrapper.GetDbConnection(),
+										},
+									).
+									WithExceptionFactory(
+										func(message string) error {
+											return &common_exceptions.ResourceNotFoundException{
+												BaseRestException: exceptions.BaseRestException{
+													ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+													Message:             message,
+												},
+											}
+										},
+									).
+									WithMessage("service account with this id does not exist").
+									Validate,
+							).
+							AttachValidator(
+								validator.ExternalValidator[create_key.CreateApiKeyRequestDto, []string]{}.
+									RuleFor(
+										func(data *create_key.CreateApiKeyRequestDto) *[]string {
+											return &data.Scopes
+										},
+									).
+									Must(service_accounts_validators.ApiKeyScopesValidator{}).
+									WithMessage("scopes should be non empty and contain only known scopes").
+									Validate,
+							),
+						router.POST,
+					),
+				},
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageServiceAccounts)},
+			},
+			&router.AuthorizedRoute[get_keys.GetApiKeysRequestDto, get_keys.GetApiKeysResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/:id/keys",
+					service_accounts.GetApiKeysHandler{}.Handle,
+					validator.
+						Validator[get_keys.GetApiKeysRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[get_keys.GetApiKeysRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *get_keys.GetApiKeysRequestDto) *extensions.UUID {
+										return &data.ServiceAccountID
+									},
+								).
+								Must(
+									service_accounts_validators.ServiceAccountExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("service account with this id does not exist").
+								Validate,
+						),
+					router.GET,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageServiceAccounts)},
+			},
+			&router.AuthorizedRoute[revoke_key.RevokeApiKeyRequestDto, revoke_key.RevokeApiKeyResponseDto]{
+				Route: &router.DestructiveRoute[revoke_key.RevokeApiKeyRequestDto, revoke_key.RevokeApiKeyResponseDto]{
+					Route: router.CreateBaseRoute(
+						serviceWrapper,
+						"/:id/keys/:key_id",
+						service_accounts.RevokeApiKeyHandler{}.Handle,
+						validator.
+							Validator[revoke_key.RevokeApiKeyRequestDto]{}.
+							AttachValidator(
+								validator.ExternalValidator[revoke_key.RevokeApiKeyRequestDto, extensions.UUID]{}.
+									RuleFor(
+										func(data *revoke_key.RevokeApiKeyRequestDto) *extensions.UUID {
+											return &data.ServiceAccountID
+										},
+									).
+									Must(
+										service_accounts_validators.ServiceAccountExistenceValidator{
+											Db: serviceWrapper.GetDbConnection(),
+										},
+									).
+									WithExceptionFactory(
+										func(message string) error {
+											return &common_exceptions.ResourceNotFoundException{
+												BaseRestException: exceptions.BaseRestException{
+													ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+													Message:             message,
+												},
+											}
+										},
+									).
+									WithMessage("service account with this id does not exist").
+									Validate,
+							),
+						router.DELETE,
+					),
+				},
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageServiceAccounts)},
+			},
+		},
+	)
+
+	return sc
+}
@@ -4,18 +4,26 @@ import (
 	interests_validators "chat_app_backend/application/controllers/validators/interests"
 	"chat_app_backend/application/controllers/validators/users"
 	"chat_app_backend/application/handlers/users"
+	"chat_app_backend/application/models/users/create_export"
 	"chat_app_backend/application/models/users/delete"
+	"chat_app_backend/application/models/users/download_export"
+	"chat_app_backend/application/models/users/get_export"
 	"chat_app_backend/application/models/users/get_user_data"
 	"chat_app_backend/application/models/users/login"
 	"chat_app_backend/application/models/users/refresh_token"
 	"chat_app_backend/application/models/users/register"
+	"chat_app_backend/application/models/users/restore"
 	"chat_app_backend/application/models/users/update"
+	"chat_app_backend/application/models/users/update_privacy"
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/exceptions/common_exceptions"
 	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/response"
 	"chat_app_backend/internal/router"
 	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
 	"chat_app_backend/internal/validator"
+	"mime/multipart"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -30,155 +38,279 @@ func CreateUserController(engine *gin.Engine, serviceWrapper service_wrapper.ISe
 		engine,
 		"/users",
 		[]router.IRoute{
-			router.CreateBaseRoute(
-				serviceWrapper,
-				"/register",
-				users.RegisterHandler{}.Handle,
-				validator.
-					Validator[register.RegisterRequestDto]{}.
-					AttachValidator(
-						validator.CreateValidatorGroup[register.RegisterRequestDto]().
-							AttachValidation(
-								validator.ExternalValidator[register.RegisterRequestDto, string]{}.
+			&router.PublicRoute[register.RegisterRequestDto, register.RegisterResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/register",
+					users.RegisterHandler{}.Handle,
+					validator.
+						Validator[register.RegisterRequestDto]{}.
+						AttachValidator(
+							validator.CreateValidatorGroup[register.RegisterRequestDto]().
+								AttachValidation(
+									validator.ExternalValidator[register.RegisterRequestDto, multipart.FileHeader]{}.
+										RuleFor(
+											func(data *register.RegisterRequestDto) *multipart.FileHeader {
+												return data.Avatar
+											},
+										).
+										Must(user_validators.AvatarFileTypeValidator{}).
+										WithMessage("avatar file type is invalid").
+										Optional().
+										Validate,
+								).
+								AttachValidation(
+									validator.ExternalValidator[register.RegisterRequestDto, string]{}.
+										RuleFor(
+											func(data *register.RegisterRequestDto) *string {
+												return &data.Email
+											},
+										).
+										Must(user_validators.EmailFormatValidator{}).
+										WithMessage("email is of wrong format").
+										Validate,
+								).
+								AttachValidation(
+									validator.ExternalValidator[register.RegisterRequestDto, time.Time]{}.
+										RuleFor(
+											func(data *register.RegisterRequestDto) *time.Time {
+												return &data.Birthday
+											},
+										).
+										Must(user_validators.BirthDateValidator{}).
+										WithMessage("you are not old enough to register").
+										Validate,
+								).
+								AttachValidation(
+									validator.ExternalValidator[register.RegisterRequestDto, string]{}.
+										RuleFor(
+											func(data *register.RegisterRequestDto) *string {
+												return &data.Password
+											},
+										).
+										Must(user_validators.PasswordValidator{}).
+										WithMessage("password should have at least one of each of this characters (special characters, upper and lowercase letters, digits)").
+										Validate,
+								).
+								AttachValidation(
+									validator.ExternalValidator[register.RegisterRequestDto, string]{}.
+										RuleFor(
+											func(data *register.RegisterRequestDto) *string {
+												return &data.Password
+											},
+										).
+										Must(
+											user_validators.BreachedPasswordValidator{
+												Filter: serviceWrapper.GetBreachedPasswordsFilter(),
+											},
+										).
+										WithMessage("this password has appeared in a data breach, please choose another one").
+										Validate,
+								).Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[register.RegisterRequestDto, string]{}.
+								RuleFor(
+									func(data *register.RegisterRequestDto) *string {
+										return &data.FullName
+									},
+								).
+								Must(
+									user_validators.NameUniquenessValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.InvalidBodyException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("that full name is already taken").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[register.RegisterRequestDto, string]{}.
+								RuleFor(
+									func(data *register.RegisterRequestDto) *string {
+										return &data.Email
+									},
+								).
+								Must(
+									user_validators.EmailUniquenessValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.InvalidBodyException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("that email is already used").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[register.RegisterRequestDto, []extensions.UUID]{}.
+								RuleFor(
+									func(data *register.RegisterRequestDto) *[]extensions.UUID {
+										return &data.Interests
+									},
+								).
+								Must(
+									interests_validators.InterestsExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("some interests not found").
+								Validate,
+						),
+					router.POST,
+				),
+			},
+			&router.PublicRoute[login.LoginRequestDto, login.LoginResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/login",
+					users.LoginHandler{}.Handle,
+					validator.
+						Validator[login.LoginRequestDto]{},
+					router.POST,
+				),
+			},
+			&router.AuthorizedRoute[get_user_data.GetUserDataRequestDto, get_user_data.GetUserDataResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/:id",
+					users.GetUserDataHandler{}.Handle,
+					validator.
+						Validator[get_user_data.GetUserDataRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[get_user_data.GetUserDataRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *get_user_data.GetUserDataRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(
+									user_validators.UserExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithMessage("user with this id does not exist").
+								Validate,
+						),
+					router.GET,
+				),
+			},
+			&router.PublicRoute[refresh_token.RefreshTokenRequestDto, refresh_token.RefreshTokenResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/refresh_token",
+					users.RefreshTokenHandler{}.Handle,
+					validator.
+						Validator[refresh_token.RefreshTokenRequestDto]{},
+					router.POST,
+				),
+			},
+			&router.AuthorizedRoute[delete.DeleteUserRequestDto, delete.DeleteUserResponseDto]{
+				Route: &router.DestructiveRoute[delete.DeleteUserRequestDto, delete.DeleteUserResponseDto]{
+					Route: router.CreateBaseRoute(
+						serviceWrapper,
+						"/:id",
+						users.DeleteUserHandler{}.Handle,
+						validator.
+							Validator[delete.DeleteUserRequestDto]{}.
+							AttachValidator(
+								validator.ExternalValidator[delete.DeleteUserRequestDto, extensions.UUID]{}.
 									RuleFor(
-										func(data *register.RegisterRequestDto) *string {
-											return &data.Avatar.Filename
+										func(data *delete.DeleteUserRequestDto) *extensions.UUID {
+											return &data.ID
 										},
 									).
-									Must(user_validators.AvatarFileTypeValidator{}).
-									WithMessage("avatar file type is invalid").
-									Validate,
-							).
-							AttachValidation(
-								validator.ExternalValidator[register.RegisterRequestDto, string]{}.
-									RuleFor(
-										func(data *register.RegisterRequestDto) *string {
-											return &data.Email
+									Must(user_validators.UserModificationAccessValidator{}).
+									WithExceptionFactory(
+										func(message string) error {
+											return &common_exceptions.ForbiddenException{
+												BaseRestException: exceptions.BaseRestException{
+													ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+													Message:             message,
+												},
+											}
 										},
 									).
-									Must(user_validators.EmailFormatValidator{}).
-									WithMessage("email is of wrong format").
+									WithMessage("you dont have access to delete this user").
 									Validate,
 							).
-							AttachValidation(
-								validator.ExternalValidator[register.RegisterRequestDto, time.Time]{}.
+							AttachValidator(
+								validator.ExternalValidator[delete.DeleteUserRequestDto, extensions.UUID]{}.
 									RuleFor(
-										func(data *register.RegisterRequestDto) *time.Time {
-											return &data.Birthday
+										func(data *delete.DeleteUserRequestDto) *extensions.UUID {
+											return &data.ID
 										},
 									).
-									Must(user_validators.BirthDateValidator{}).
-									WithMessage("you are not old enough to register").
-									Validate,
-							).
-							AttachValidation(
-								validator.ExternalValidator[register.RegisterRequestDto, string]{}.
-									RuleFor(
-										func(data *register.RegisterRequestDto) *string {
-											return &data.Password
+									Must(
+										user_validators.UserExistenceValidator{
+											Db: serviceWrapper.GetDbConnection(),
 										},
 									).
-									Must(user_validators.PasswordValidator{}).
-									WithMessage("password should have at least one of each of this characters (special characters, upper and lowercase letters, digits)").
+									WithMessage("user with this id does not exist").
 									Validate,
-							).Validate,
-					).
-					AttachValidator(
-						validator.ExternalValidator[register.RegisterRequestDto, string]{}.
-							RuleFor(
-								func(data *register.RegisterRequestDto) *string {
-									return &data.FullName
-								},
-							).
-							Must(
-								user_validators.NameUniquenessValidator{
-									Db: serviceWrapper.GetDbConnection(),
-								},
-							).
-							WithExceptionFactory(
-								func(message string) error {
-									return &common_exceptions.InvalidBodyException{
-										BaseRestException: exceptions.BaseRestException{
-											ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
-											Message:             message,
-										},
-									}
-								},
-							).
-							WithMessage("that full name is already taken").
-							Validate,
-					).
-					AttachValidator(
-						validator.ExternalValidator[register.RegisterRequestDto, string]{}.
-							RuleFor(
-								func(data *register.RegisterRequestDto) *string {
-									return &data.Email
-								},
-							).
-							Must(
-								user_validators.EmailUniquenessValidator{
-									Db: serviceWrapper.GetDbConnection(),
-								},
-							).
-							WithExceptionFactory(
-								func(message string) error {
-									return &common_exceptions.InvalidBodyException{
-										BaseRestException: exceptions.BaseRestException{
-											ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
-											Message:             message,
-										},
-									}
-								},
-							).
-							WithMessage("that email is already used").
-							Validate,
-					).
-					AttachValidator(
-						validator.ExternalValidator[register.RegisterRequestDto, []extensions.UUID]{}.
-							RuleFor(
-								func(data *register.RegisterRequestDto) *[]extensions.UUID {
-									return &data.Interests
-								},
-							).
-							Must(
-								interests_validators.InterestsExistenceValidator{
-									Db: serviceWrapper.GetDbConnection(),
-								},
-							).
-							WithExceptionFactory(
-								func(message string) error {
-									return &common_exceptions.ResourceNotFoundException{
-										BaseRestException: exceptions.BaseRestException{
-											ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
-											Message:             message,
-										},
-									}
-								},
-							).
-							WithMessage("some interests not found").
-							Validate,
+							),
+						router.DELETE,
 					),
-				router.POST,
-			),
-			router.CreateBaseRoute(
-				serviceWrapper,
-				"/login",
-				users.LoginHandler{}.Handle,
-				validator.
-					Validator[login.LoginRequestDto]{},
-				router.POST,
-			),
-			&router.AuthorizedRoute[get_user_data.GetUserDataRequestDto, get_user_data.GetUserDataResponseDto]{
+				},
+			},
+			&router.AuthorizedRoute[restore.RestoreUserRequestDto, restore.RestoreUserResponseDto]{
 				Route: router.CreateBaseRoute(
 					serviceWrapper,
-					"/:id",
-					users.GetUserDataHandler{}.Handle,
+					"/:id/restore",
+					users.RestoreUserHandler{}.Handle,
 					validator.
-						Validator[get_user_data.GetUserDataRequestDto]{}.
+						Validator[restore.RestoreUserRequestDto]{}.
 						AttachValidator(
-							validator.ExternalValidator[get_user_data.GetUserDataRequestDto, extensions.UUID]{}.
+							validator.ExternalValidator[restore.RestoreUserRequestDto, extensions.UUID]{}.
 								RuleFor(
-									func(data *get_user_data.GetUserDataRequestDto) *extensions.UUID {
+									func(data *restore.RestoreUserRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(user_validators.UserModificationAccessValidator{}).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ForbiddenException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("you dont have access to restore this user").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[restore.RestoreUserRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *restore.RestoreUserRequestDto) *extensions.UUID {
 										return &data.ID
 									},
 								).
@@ -187,32 +319,35 @@ func CreateUserController(engine *gin.Engine, serviceWrapper service_wrapper.ISe
 										Db: serviceWrapper.GetDbConnection(),
 									},
 								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
 								WithMessage("user with this id does not exist").
 								Validate,
 						),
-					router.GET,
+					router.POST,
 				),
+				AllowDeletedUsers: true,
 			},
-			router.CreateBaseRoute(
-				serviceWrapper,
-				"/refresh_token",
-				users.RefreshTokenHandler{}.Handle,
-				validator.
-					Validator[refresh_token.RefreshTokenRequestDto]{},
-				router.POST,
-			),
-			&router.AuthorizedRoute[delete.DeleteUserRequestDto, delete.DeleteUserResponseDto]{
+			&router.AuthorizedRoute[create_export.CreateExportRequestDto, create_export.CreateExportResponseDto]{
 				Route: router.CreateBaseRoute(
 					serviceWrapper,
-					"/:id",
-					users.DeleteUserHandler{}.Handle,
+					"/:id/exports",
+					users.CreateExportHandler{}.Handle,
 					validator.
-						Validator[delete.DeleteUserRequestDto]{}.
+						Validator[create_export.CreateExportRequestDto]{}.
 						AttachValidator(
-							validator.ExternalValidator[delete.DeleteUserRequestDto, extensions.UUID]{}.
+							validator.ExternalValidator[create_export.CreateExportRequestDto, extensions.UUID]{}.
 								RuleFor(
-									func(data *delete.DeleteUserRequestDto) *extensions.UUID {
-										return &data.ID
+									func(data *create_export.CreateExportRequestDto) *extensions.UUID {
+										return &data.UserID
 									},
 								).
 								Must(user_validators.UserModificationAccessValidator{}).
@@ -220,20 +355,20 @@ func CreateUserController(engine *gin.Engine, serviceWrapper service_wrapper.ISe
 									func(message string) error {
 										return &common_exceptions.ForbiddenException{
 											BaseRestException: exceptions.BaseRestException{
-												ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
 												Message:             message,
 											},
 										}
 									},
 								).
-								WithMessage("you dont have access to delete this user").
+								WithMessage("you dont have access to export data of this user").
 								Validate,
 						).
 						AttachValidator(
-							validator.ExternalValidator[delete.DeleteUserRequestDto, extensions.UUID]{}.
+							validator.ExternalValidator[create_export.CreateExportRequestDto, extensions.UUID]{}.
 								RuleFor(
-									func(data *delete.DeleteUserRequestDto) *extensions.UUID {
-										return &data.ID
+									func(data *create_export.CreateExportRequestDto) *extensions.UUID {
+										return &data.UserID
 									},
 								).
 								Must(
@@ -241,24 +376,35 @@ func CreateUserController(engine *gin.Engine, serviceWrapper service_wrapper.ISe
 										Db: serviceWrapper.GetDbConnection(),
 									},
 								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
 								WithMessage("user with this id does not exist").
 								Validate,
 						),
-					router.DELETE,
+					router.POST,
 				),
+				AllowDeletedUsers: true,
 			},
-			&router.AuthorizedRoute[update.UpdateUserRequestDto, update.UpdateUserResponseDto]{
+			&router.AuthorizedRoute[get_export.GetExportRequestDto, get_export.GetExportResponseDto]{
 				Route: router.CreateBaseRoute(
 					serviceWrapper,
-					"/:id",
-					users.UpdateUserHandler{}.Handle,
+					"/:id/exports/:export_id",
+					users.GetExportHandler{}.Handle,
 					validator.
-						Validator[update.UpdateUserRequestDto]{}.
+						Validator[get_export.GetExportRequestDto]{}.
 						AttachValidator(
-							validator.ExternalValidator[update.UpdateUserRequestDto, extensions.UUID]{}.
+							validator.ExternalValidator[get_export.GetExportRequestDto, extensions.UUID]{}.
 								RuleFor(
-									func(data *update.UpdateUserRequestDto) *extensions.UUID {
-										return &data.ID
+									func(data *get_export.GetExportRequestDto) *extensions.UUID {
+										return &data.UserID
 									},
 								).
 								Must(user_validators.UserModificationAccessValidator{}).
@@ -266,103 +412,291 @@ func CreateUserController(engine *gin.Engine, serviceWrapper service_wrapper.ISe
 									func(message string) error {
 										return &common_exceptions.ForbiddenException{
 											BaseRestException: exceptions.BaseRestException{
-												ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
 												Message:             message,
 											},
 										}
 									},
 								).
-								WithMessage("you dont have access to modify this user").
+								WithMessage("you dont have access to export data of this user").
 								Validate,
 						).
 						AttachValidator(
-							validator.CreateValidatorGroup[update.UpdateUserRequestDto]().
-								AttachValidation(
-									validator.ExternalValidator[update.UpdateUserRequestDto, string]{}.
-										RuleFor(
-											func(data *update.UpdateUserRequestDto) *string {
-												return &data.Avatar.Filename
-											},
-										).
-										Must(user_validators.AvatarFileTypeValidator{}).
-										WithMessage("avatar file type is invalid").
-										Optional().
-										Validate,
+							validator.ExternalValidator[get_export.GetExportRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *get_export.GetExportRequestDto) *extensions.UUID {
+										return &data.UserID
+									},
 								).
-								AttachValidation(
-									validator.ExternalValidator[update.UpdateUserRequestDto, string]{}.
-										RuleFor(
-											func(data *update.UpdateUserRequestDto) *string {
-												return data.Email
-											},
-										).
-										Must(user_validators.EmailFormatValidator{}).
-										WithMessage("email is of wrong format").
-										Optional().
-										Validate,
+								Must(
+									user_validators.UserExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
 								).
-								AttachValidation(
-									validator.ExternalValidator[update.UpdateUserRequestDto, time.Time]{}.
-										RuleFor(
-											func(data *update.UpdateUserRequestDto) *time.Time {
-												return data.Birthday
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
 											},
-										).
-										Must(user_validators.BirthDateValidator{}).
-										WithMessage("you are not old enough to register").
-										Optional().
-										Validate,
+										}
+									},
 								).
-								AttachValidation(
-									validator.ExternalValidator[update.UpdateUserRequestDto, string]{}.
-										RuleFor(
-											func(data *update.UpdateUserRequestDto) *string {
-												return data.PasswordString
+								WithMessage("user with this id does not exist").
+								Validate,
+						),
+					router.GET,
+				),
+				AllowDeletedUsers: true,
+			},
+			&router.AuthorizedRoute[download_export.DownloadExportRequestDto, response.Redirect]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/:id/exports/:export_id/download",
+					users.DownloadExportHandler{}.Handle,
+					validator.
+						Validator[download_export.DownloadExportRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[download_export.DownloadExportRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *download_export.DownloadExportRequestDto) *extensions.UUID {
+										return &data.UserID
+									},
+								).
+								Must(user_validators.UserModificationAccessValidator{}).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ForbiddenException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
 											},
-										).
-										Must(user_validators.PasswordValidator{}).
-										WithMessage("password should have at least one of each of this characters (special characters, upper and lowercase letters, digits)").
-										Optional().
-										Validate,
+										}
+									},
 								).
+								WithMessage("you dont have access to export data of this user").
 								Validate,
 						).
 						AttachValidator(
-							validator.ExternalValidator[update.UpdateUserRequestDto, string]{}.
+							validator.ExternalValidator[download_export.DownloadExportRequestDto, extensions.UUID]{}.
 								RuleFor(
-									func(data *update.UpdateUserRequestDto) *string {
-										return data.FullName
+									func(data *download_export.DownloadExportRequestDto) *extensions.UUID {
+										return &data.UserID
 									},
 								).
 								Must(
-									user_validators.NameUniquenessValidator{
+									user_validators.UserExistenceValidator{
 										Db: serviceWrapper.GetDbConnection(),
 									},
 								).
-								Optional().
-								WithMessage("that full name is already taken").
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("user with this id does not exist").
 								Validate,
-						).
+						),
+					router.GET,
+				),
+				AllowDeletedUsers: true,
+			},
+			&router.AuthorizedRoute[update.UpdateUserRequestDto, update.UpdateUserResponseDto]{
+				Route: &router.DestructiveRoute[update.UpdateUserRequestDto, update.UpdateUserResponseDto]{
+					Route: router.CreateBaseRoute(
+						serviceWrapper,
+						"/:id",
+						users.UpdateUserHandler{}.Handle,
+						validator.
+							Validator[update.UpdateUserRequestDto]{}.
+							AttachValidator(
+								validator.ExternalValidator[update.UpdateUserRequestDto, extensions.UUID]{}.
+									RuleFor(
+										func(data *update.UpdateUserRequestDto) *extensions.UUID {
+											return &data.ID
+										},
+									).
+									Must(user_validators.UserModificationAccessValidator{}).
+									WithExceptionFactory(
+										func(message string) error {
+											return &common_exceptions.ForbiddenException{
+												BaseRestException: exceptions.BaseRestException{
+													ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+													Message:             message,
+												},
+											}
+										},
+									).
+									WithMessage("you dont have access to modify this user").
+									Validate,
+							).
+							AttachValidator(
+								validator.CreateValidatorGroup[update.UpdateUserRequestDto]().
+									AttachValidation(
+										validator.ExternalValidator[update.UpdateUserRequestDto, multipart.FileHeader]{}.
+											RuleFor(
+												func(data *update.UpdateUserRequestDto) *multipart.FileHeader {
+													return data.Avatar
+												},
+											).
+											Must(user_validators.AvatarFileTypeValidator{}).
+											WithMessage("avatar file type is invalid").
+											Optional().
+											Validate,
+									).
+									AttachValidation(
+										validator.ExternalValidator[update.UpdateUserRequestDto, string]{}.
+											RuleFor(
+												func(data *update.UpdateUserRequestDto) *string {
+													return data.Email
+												},
+											).
+											Must(user_validators.EmailFormatValidator{}).
+											WithMessage("email is of wrong format").
+											Optional().
+											Validate,
+									).
+									AttachValidation(
+										validator.ExternalValidator[update.UpdateUserRequestDto, time.Time]{}.
+											RuleFor(
+												func(data *update.UpdateUserRequestDto) *time.Time {
+													return data.Birthday
+												},
+											).
+											Must(user_validators.BirthDateValidator{}).
+											WithMessage("you are not old enough to register").
+											Optional().
+											Validate,
+									).
+									AttachValidation(
+										validator.ExternalValidator[update.UpdateUserRequestDto, string]{}.
+											RuleFor(
+												func(data *update.UpdateUserRequestDto) *string {
+													return data.PasswordString
+												},
+											).
+											Must(user_validators.PasswordValidator{}).
+											WithMessage("password should have at least one of each of this characters (special characters, upper and lowercase letters, digits)").
+											Optional().
+											Validate,
+									).
+									AttachValidation(
+										validator.ExternalValidator[update.UpdateUserRequestDto, string]{}.
+											RuleFor(
+												func(data *update.UpdateUserRequestDto) *string {
+													return data.PasswordString
+												},
+											).
+											Must(
+												user_validators.BreachedPasswordValidator{
+													Filter: serviceWrapper.GetBreachedPasswordsFilter(),
+												},
+											).
+											WithMessage("this password has appeared in a data breach, please choose another one").
+											Optional().
+											Validate,
+									).
+									Validate,
+							).
+							AttachValidator(
+								validator.ExternalValidator[update.UpdateUserRequestDto, string]{}.
+									RuleFor(
+										func(data *update.UpdateUserRequestDto) *string {
+											return data.FullName
+										},
+									).
+									Must(
+										user_validators.NameUniquenessValidator{
+											Db: serviceWrapper.GetDbConnection(),
+										},
+									).
+									Optional().
+									WithMessage("that full name is already taken").
+									Validate,
+							).
+							AttachValidator(
+								validator.ExternalValidator[update.UpdateUserRequestDto, string]{}.
+									RuleFor(
+										func(data *update.UpdateUserRequestDto) *string {
+											return data.Email
+										},
+									).
+									Must(
+										user_validators.EmailUniquenessValidator{
+											Db: serviceWrapper.GetDbConnection(),
+										},
+									).
+									Optional().
+									WithMessage("that email is already used").
+									Validate,
+							).
+							AttachValidator(
+								validator.ExternalValidator[update.UpdateUserRequestDto, extensions.UUID]{}.
+									RuleFor(
+										func(data *update.UpdateUserRequestDto) *extensions.UUID {
+											return &data.ID
+										},
+									).
+									Must(
+										user_validators.UserExistenceValidator{
+											Db: serviceWrapper.GetDbConnection(),
+										},
+									).
+									WithExceptionFactory(
+										func(message string) error {
+											return &common_exceptions.ResourceNotFoundException{
+												BaseRestException: exceptions.BaseRestException{
+													ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+													Message:             message,
+												},
+											}
+										},
+									).
+									WithMessage("user with this id does not exist").
+									Validate,
+							),
+						router.PUT,
+					),
+				},
+			},
+			&router.AuthorizedRoute[update_privacy.UpdatePrivacyRequestDto, update_privacy.UpdatePrivacyResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/:id/privacy",
+					users.UpdatePrivacyHandler{}.Handle,
+					validator.
+						Validator[update_privacy.UpdatePrivacyRequestDto]{}.
 						AttachValidator(
-							validator.ExternalValidator[update.UpdateUserRequestDto, string]{}.
+							validator.ExternalValidator[update_privacy.UpdatePrivacyRequestDto, extensions.UUID]{}.
 								RuleFor(
-									func(data *update.UpdateUserRequestDto) *string {
-										return data.Email
+									func(data *update_privacy.UpdatePrivacyRequestDto) *extensions.UUID {
+										return &data.ID
 									},
 								).
-								Must(
-									user_validators.EmailUniquenessValidator{
-										Db: serviceWrapper.GetDbConnection(),
+								Must(user_validators.UserModificationAccessValidator{}).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ForbiddenException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
 									},
 								).
-								Optional().
-								WithMessage("that email is already used").
+								WithMessage("you dont have access to change privacy settings of this user").
 								Validate,
 						).
 						AttachValidator(
-							validator.ExternalValidator[update.UpdateUserRequestDto, extensions.UUID]{}.
+							validator.ExternalValidator[update_privacy.UpdatePrivacyRequestDto, extensions.UUID]{}.
 								RuleFor(
-									func(data *update.UpdateUserRequestDto) *extensions.UUID {
+									func(data *update_privacy.UpdatePrivacyRequestDto) *extensions.UUID {
 										return &data.ID
 									},
 								).
@@ -375,7 +709,7 @@ func CreateUserController(engine *gin.Engine, serviceWrapper service_wrapper.ISe
 									func(message string) error {
 										return &common_exceptions.ResourceNotFoundException{
 											BaseRestException: exceptions.BaseRestException{
-												ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
 												Message:             message,
 											},
 										}
@@ -383,6 +717,72 @@ func CreateUserController(engine *gin.Engine, serviceWrapper service_wrapper.ISe
 								).
 								WithMessage("user with this id does not exist").
 								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[update_privacy.UpdatePrivacyRequestDto, db_queries.VisibilityLevel]{}.
+								RuleFor(
+									func(data *update_privacy.UpdatePrivacyRequestDto) *db_queries.VisibilityLevel {
+										return data.ProfileVisibility
+									},
+								).
+								Must(user_validators.VisibilityLevelValidator{}).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.InvalidBodyException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								Optional().
+								WithMessage("profile visibility should be one of EVERYONE, CONTACTS, NOBODY").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[update_privacy.UpdatePrivacyRequestDto, db_queries.VisibilityLevel]{}.
+								RuleFor(
+									func(data *update_privacy.UpdatePrivacyRequestDto) *db_queries.VisibilityLevel {
+										return data.PresenceVisibility
+									},
+								).
+								Must(user_validators.VisibilityLevelValidator{}).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.InvalidBodyException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								Optional().
+								WithMessage("presence visibility should be one of EVERYONE, CONTACTS, NOBODY").
+								Validate,
+						).
+						AttachValidator(
+							validator.ExternalValidator[update_privacy.UpdatePrivacyRequestDto, db_queries.VisibilityLevel]{}.
+								RuleFor(
+									func(data *update_privacy.UpdatePrivacyRequestDto) *db_queries.VisibilityLevel {
+										return data.PrivateChatPermission
+									},
+								).
+								Must(user_validators.VisibilityLevelValidator{}).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.InvalidBodyException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								Optional().
+								WithMessage("private chat permission should be one of EVERYONE, CONTACTS, NOBODY").
+								Validate,
 						),
 					router.PUT,
 				),
@@ -0,0 +1,20 @@
+package admin_validators
+
+import (
+	"chat_app_backend/application/models/admin/export_audit_log"
+	"chat_app_backend/application/models/admin/get_audit_log"
+	"chat_app_backend/internal/request_env"
+	"context"
+)
+
+type AuditLogRangeValidator struct{}
+
+func (a AuditLogRangeValidator) Validate(request *get_audit_log.GetAuditLogRequestDto, _ context.Context, _ request_env.RequestEnv) bool {
+	return request.CreatedFrom == nil || request.CreatedTo == nil || request.CreatedFrom.Before(*request.CreatedTo)
+}
+
+type AuditLogExportRangeValidator struct{}
+
+func (a AuditLogExportRangeValidator) Validate(request *export_audit_log.ExportAuditLogRequestDto, _ context.Context, _ request_env.RequestEnv) bool {
+	return request.CreatedFrom == nil || request.CreatedTo == nil || request.CreatedFrom.Before(*request.CreatedTo)
+}
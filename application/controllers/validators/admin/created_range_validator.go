@@ -0,0 +1,13 @@
+package admin_validators
+
+import (
+	"chat_app_backend/application/models/admin/get_users"
+	"chat_app_backend/internal/request_env"
+	"context"
+)
+
+type CreatedRangeValidator struct{}
+
+func (c CreatedRangeValidator) Validate(request *get_users.GetUsersRequestDto, _ context.Context, _ request_env.RequestEnv) bool {
+	return request.CreatedFrom == nil || request.CreatedTo == nil || request.CreatedFrom.Before(*request.CreatedTo)
+}
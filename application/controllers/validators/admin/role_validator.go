@@ -0,0 +1,14 @@
+package admin_validators
+
+import (
+	"chat_app_backend/internal/moderation"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+)
+
+type RoleValidator struct{}
+
+func (r RoleValidator) Validate(role *db_queries.RoleType, _ context.Context, _ request_env.RequestEnv) bool {
+	return moderation.IsKnownRole(*role)
+}
@@ -0,0 +1,13 @@
+package admin_validators
+
+import (
+	"chat_app_backend/internal/request_env"
+	"context"
+	"time"
+)
+
+type SuspensionEndValidator struct{}
+
+func (s SuspensionEndValidator) Validate(suspendedUntil *time.Time, _ context.Context, _ request_env.RequestEnv) bool {
+	return suspendedUntil.After(time.Now())
+}
@@ -0,0 +1,29 @@
+package chats_validators
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+)
+
+type BotChatMembershipValidator struct {
+	Db db.IDbConnection
+}
+
+func (b BotChatMembershipValidator) Validate(chatId *extensions.UUID, ctx context.Context, env request_env.RequestEnv) bool {
+	if env.ServiceAccount == nil {
+		return false
+	}
+
+	isMember, err := b.Db.GetQueries().IsServiceAccountInChat(
+		ctx,
+		db_queries.IsServiceAccountInChatParams{
+			ChatID:           *chatId,
+			ServiceAccountID: env.ServiceAccount.Account.ID,
+		},
+	)
+
+	return err == nil && isMember
+}
@@ -0,0 +1,29 @@
+package chats_validators
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+)
+
+type ChatMembershipValidator struct {
+	Db db.IDbConnection
+}
+
+func (c ChatMembershipValidator) Validate(chatId *extensions.UUID, ctx context.Context, env request_env.RequestEnv) bool {
+	if env.User == nil {
+		return false
+	}
+
+	isMember, err := c.Db.GetQueries().IsUserInChat(
+		ctx,
+		db_queries.IsUserInChatParams{
+			UserID: env.User.ID,
+			ChatID: *chatId,
+		},
+	)
+
+	return err == nil && isMember
+}
@@ -0,0 +1,36 @@
+package chats_validators
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/privacy"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+)
+
+// PrivateChatPermissionValidator checks the private chat policy of the user the chat is started with.
+type PrivateChatPermissionValidator struct {
+	Db db.IDbConnection
+}
+
+func (p PrivateChatPermissionValidator) Validate(userId *extensions.UUID, ctx context.Context, env request_env.RequestEnv) bool {
+	if env.User == nil {
+		return false
+	}
+
+	user, userQueryError := p.Db.GetQueries().GetUserById(ctx, *userId)
+	if userQueryError != nil || user.DeletedAt != nil {
+		return false
+	}
+
+	areContacts, contactsQueryError := p.Db.GetQueries().AreContacts(
+		ctx,
+		db_queries.AreContactsParams{
+			UserID:    env.User.ID,
+			ContactID: *userId,
+		},
+	)
+
+	return contactsQueryError == nil && privacy.Permits(user.PrivateChatPermission, areContacts)
+}
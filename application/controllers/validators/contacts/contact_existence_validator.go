@@ -0,0 +1,29 @@
+package contacts_validators
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+)
+
+type ContactExistenceValidator struct {
+	Db db.IDbConnection
+}
+
+func (c ContactExistenceValidator) Validate(userId *extensions.UUID, ctx context.Context, env request_env.RequestEnv) bool {
+	if env.User == nil {
+		return false
+	}
+
+	areContacts, err := c.Db.GetQueries().AreContacts(
+		ctx,
+		db_queries.AreContactsParams{
+			UserID:    env.User.ID,
+			ContactID: *userId,
+		},
+	)
+
+	return err == nil && areContacts
+}
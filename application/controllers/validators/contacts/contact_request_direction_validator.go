@@ -0,0 +1,13 @@
+package contacts_validators
+
+import (
+	"chat_app_backend/application/models/contacts/get_requests"
+	"chat_app_backend/internal/request_env"
+	"context"
+)
+
+type ContactRequestDirectionValidator struct{}
+
+func (c ContactRequestDirectionValidator) Validate(direction *string, _ context.Context, _ request_env.RequestEnv) bool {
+	return *direction == get_requests.DirectionIncoming || *direction == get_requests.DirectionOutgoing
+}
@@ -0,0 +1,26 @@
+package contacts_validators
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+)
+
+// IncomingRequestValidator checks that the request is pending and was sent to the current user.
+type IncomingRequestValidator struct {
+	Db db.IDbConnection
+}
+
+func (i IncomingRequestValidator) Validate(requestId *extensions.UUID, ctx context.Context, env request_env.RequestEnv) bool {
+	if env.User == nil {
+		return false
+	}
+
+	request, err := i.Db.GetQueries().GetContactRequestById(ctx, *requestId)
+
+	return err == nil &&
+		request.Status == db_queries.ContactRequestStatusPENDING &&
+		request.ReceiverID == env.User.ID
+}
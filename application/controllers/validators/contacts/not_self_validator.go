@@ -0,0 +1,13 @@
+package contacts_validators
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"context"
+)
+
+type NotSelfValidator struct{}
+
+func (n NotSelfValidator) Validate(userId *extensions.UUID, _ context.Context, env request_env.RequestEnv) bool {
+	return env.User != nil && env.User.ID != *userId
+}
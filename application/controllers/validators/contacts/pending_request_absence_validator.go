@@ -0,0 +1,30 @@
+package contacts_validators
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+)
+
+// PendingRequestAbsenceValidator checks that there is no pending request between the users in either direction.
+type PendingRequestAbsenceValidator struct {
+	Db db.IDbConnection
+}
+
+func (p PendingRequestAbsenceValidator) Validate(userId *extensions.UUID, ctx context.Context, env request_env.RequestEnv) bool {
+	if env.User == nil {
+		return false
+	}
+
+	exists, err := p.Db.GetQueries().PendingContactRequestExists(
+		ctx,
+		db_queries.PendingContactRequestExistsParams{
+			FirstUserID:  env.User.ID,
+			SecondUserID: *userId,
+		},
+	)
+
+	return err == nil && !exists
+}
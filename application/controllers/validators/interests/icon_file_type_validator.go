@@ -1,23 +1,36 @@
 package interests_validators
 
 import (
+	"chat_app_backend/internal/images"
 	"chat_app_backend/internal/request_env"
 	"chat_app_backend/internal/s3"
 	"context"
+	"mime/multipart"
+	"slices"
 )
 
+var allowedIconTypes = []s3.FileType{s3.Png, s3.Svg}
+
+// IconFileTypeValidator checks the content of the file, the file name is ignored.
 type IconFileTypeValidator struct{}
 
-func (i IconFileTypeValidator) Validate(iconFileName *string, _ context.Context, _ request_env.RequestEnv) bool {
-	_, fileType, fileTypeExtractionError := s3.DeconstructFileName(*iconFileName)
-	if fileTypeExtractionError != nil {
+func (i IconFileTypeValidator) Validate(icon *multipart.FileHeader, _ context.Context, _ request_env.RequestEnv) bool {
+	fileType, detectionError := images.DetectUploadedFileType(icon)
+	if detectionError != nil {
 		return false
 	}
 
-	switch fileType {
-	case s3.Png, s3.Svg:
-		return true
-	default:
+	return slices.Contains(allowedIconTypes, fileType)
+}
+
+// IconDataValidator is IconFileTypeValidator for the icons, which are already read, e.g. from an archive.
+type IconDataValidator struct{}
+
+func (i IconDataValidator) Validate(icon *[]byte, _ context.Context, _ request_env.RequestEnv) bool {
+	fileType, detectionError := images.DetectFileType(*icon)
+	if detectionError != nil {
 		return false
 	}
+
+	return slices.Contains(allowedIconTypes, fileType)
 }
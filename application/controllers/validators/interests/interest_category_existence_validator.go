@@ -0,0 +1,20 @@
+package interests_validators
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db"
+	"context"
+)
+
+type InterestCategoryExistenceValidator struct {
+	Db db.IDbConnection
+}
+
+func (i InterestCategoryExistenceValidator) Validate(id *extensions.UUID, ctx context.Context, _ request_env.RequestEnv) bool {
+	if count, err := i.Db.GetQueries().InterestCategoriesExistenceCheck(ctx, []extensions.UUID{*id}); err != nil || count != 1 {
+		return false
+	}
+
+	return true
+}
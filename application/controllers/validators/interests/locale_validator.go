@@ -0,0 +1,13 @@
+package interests_validators
+
+import (
+	"chat_app_backend/internal/locale"
+	"chat_app_backend/internal/request_env"
+	"context"
+)
+
+type LocaleValidator struct{}
+
+func (l LocaleValidator) Validate(value *string, _ context.Context, _ request_env.RequestEnv) bool {
+	return locale.IsValid(*value)
+}
@@ -0,0 +1,23 @@
+package service_accounts_validators
+
+import (
+	"chat_app_backend/internal/api_keys"
+	"chat_app_backend/internal/request_env"
+	"context"
+)
+
+type ApiKeyScopesValidator struct{}
+
+func (a ApiKeyScopesValidator) Validate(scopes *[]string, _ context.Context, _ request_env.RequestEnv) bool {
+	if len(*scopes) == 0 {
+		return false
+	}
+
+	for _, scope := range *scopes {
+		if !api_keys.IsKnownScope(scope) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,20 @@
+package service_accounts_validators
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db"
+	"context"
+)
+
+type ServiceAccountExistenceValidator struct {
+	Db db.IDbConnection
+}
+
+func (s ServiceAccountExistenceValidator) Validate(id *extensions.UUID, ctx context.Context, _ request_env.RequestEnv) bool {
+	if exists, err := s.Db.GetQueries().ServiceAccountExists(ctx, *id); err != nil || !exists {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,19 @@
+package service_accounts_validators
+
+import (
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db"
+	"context"
+)
+
+type ServiceAccountNameUniquenessValidator struct {
+	Db db.IDbConnection
+}
+
+func (s ServiceAccountNameUniquenessValidator) Validate(name *string, ctx context.Context, _ request_env.RequestEnv) bool {
+	if exists, err := s.Db.GetQueries().ServiceAccountNameExists(ctx, *name); err != nil || exists {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,22 @@
+package user_validators
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db"
+	"context"
+)
+
+// ActiveUserExistenceValidator fails for the users scheduled for deletion as well, so the other users
+// can't reach them during the grace period.
+type ActiveUserExistenceValidator struct {
+	Db db.IDbConnection
+}
+
+func (a ActiveUserExistenceValidator) Validate(id *extensions.UUID, ctx context.Context, _ request_env.RequestEnv) bool {
+	if exists, err := a.Db.GetQueries().ActiveUserExists(ctx, *id); err != nil || !exists {
+		return false
+	}
+
+	return true
+}
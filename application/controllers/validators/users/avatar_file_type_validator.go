@@ -1,16 +1,19 @@
 package user_validators
 
 import (
+	"chat_app_backend/internal/images"
 	"chat_app_backend/internal/request_env"
 	"chat_app_backend/internal/s3"
 	"context"
+	"mime/multipart"
 )
 
+// AvatarFileTypeValidator checks the content of the file, the file name is ignored.
 type AvatarFileTypeValidator struct{}
 
-func (a AvatarFileTypeValidator) Validate(avatarFileName *string, _ context.Context, _ request_env.RequestEnv) bool {
-	_, fileType, fileTypeExtractionError := s3.DeconstructFileName(*avatarFileName)
-	if fileTypeExtractionError != nil {
+func (a AvatarFileTypeValidator) Validate(avatar *multipart.FileHeader, _ context.Context, _ request_env.RequestEnv) bool {
+	fileType, detectionError := images.DetectUploadedFileType(avatar)
+	if detectionError != nil {
 		return false
 	}
 
@@ -0,0 +1,15 @@
+package user_validators
+
+import (
+	"chat_app_backend/internal/breached_passwords"
+	"chat_app_backend/internal/request_env"
+	"context"
+)
+
+type BreachedPasswordValidator struct {
+	Filter breached_passwords.IFilter
+}
+
+func (b BreachedPasswordValidator) Validate(password *string, _ context.Context, _ request_env.RequestEnv) bool {
+	return !b.Filter.ContainsPassword(*password)
+}
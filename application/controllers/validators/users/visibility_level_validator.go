@@ -0,0 +1,14 @@
+package user_validators
+
+import (
+	"chat_app_backend/internal/privacy"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+)
+
+type VisibilityLevelValidator struct{}
+
+func (v VisibilityLevelValidator) Validate(level *db_queries.VisibilityLevel, _ context.Context, _ request_env.RequestEnv) bool {
+	return privacy.IsKnownVisibilityLevel(*level)
+}
@@ -0,0 +1,23 @@
+package webhooks_validators
+
+import (
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/webhooks"
+	"context"
+)
+
+type WebhookEventTypesValidator struct{}
+
+func (w WebhookEventTypesValidator) Validate(eventTypes *[]string, _ context.Context, _ request_env.RequestEnv) bool {
+	if len(*eventTypes) == 0 {
+		return false
+	}
+
+	for _, eventType := range *eventTypes {
+		if !webhooks.IsKnownEventType(eventType) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,20 @@
+package webhooks_validators
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db"
+	"context"
+)
+
+type WebhookExistenceValidator struct {
+	Db db.IDbConnection
+}
+
+func (w WebhookExistenceValidator) Validate(id *extensions.UUID, ctx context.Context, _ request_env.RequestEnv) bool {
+	if exists, err := w.Db.GetQueries().WebhookSubscriptionExists(ctx, *id); err != nil || !exists {
+		return false
+	}
+
+	return true
+}
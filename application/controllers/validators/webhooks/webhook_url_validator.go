@@ -0,0 +1,15 @@
+package webhooks_validators
+
+import (
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/webhooks"
+	"context"
+	"net"
+)
+
+// WebhookUrlValidator rejects the urls of the receivers which aren't public.
+type WebhookUrlValidator struct{}
+
+func (w WebhookUrlValidator) Validate(rawUrl *string, ctx context.Context, _ request_env.RequestEnv) bool {
+	return webhooks.CheckUrl(ctx, net.DefaultResolver, *rawUrl) == nil
+}
@@ -0,0 +1,157 @@
+package webhooks
+
+import (
+	webhooks_validators "chat_app_backend/application/controllers/validators/webhooks"
+	"chat_app_backend/application/handlers/webhooks"
+	"chat_app_backend/application/models/webhooks/create"
+	"chat_app_backend/application/models/webhooks/delete"
+	"chat_app_backend/application/models/webhooks/get"
+	"chat_app_backend/application/models/webhooks/get_deliveries"
+	"chat_app_backend/internal/access"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Controller struct {
+	router.Controller
+}
+
+func CreateWebhooksController(
+	engine *gin.Engine,
+	serviceWrapper service_wrapper.IServiceWrapper,
+) (wc Controller) {
+	wc.Controller = router.CreateController(
+		engine,
+		"/webhooks",
+		[]router.IRoute{
+			&router.AuthorizedRoute[create.CreateWebhookRequestDto, create.CreateWebhookResponseDto]{
+				Route: &router.DestructiveRoute[create.CreateWebhookRequestDto, create.CreateWebhookResponseDto]{
+					Route: router.CreateBaseRoute(
+						serviceWrapper,
+						"/",
+						webhooks.CreateWebhookHandler{}.Handle,
+						validator.
+							Validator[create.CreateWebhookRequestDto]{}.
+							AttachValidator(
+								validator.ExternalValidator[create.CreateWebhookRequestDto, string]{}.
+									RuleFor(
+										func(data *create.CreateWebhookRequestDto) *string {
+											return &data.Url
+										},
+									).
+									Must(webhooks_validators.WebhookUrlValidator{}).
+									WithMessage("url should be an absolute http or https url of a public receiver").
+									Validate,
+							).
+							AttachValidator(
+								validator.ExternalValidator[create.CreateWebhookRequestDto, []string]{}.
+									RuleFor(
+										func(data *create.CreateWebhookRequestDto) *[]string {
+											return &data.EventTypes
+										},
+									).
+									Must(webhooks_validators.WebhookEventTypesValidator{}).
+									WithMessage("event types should be non empty and contain only known event types").
+									Validate,
+							),
+						router.POST,
+					),
+				},
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageWebhooks)},
+			},
+			&router.AuthorizedRoute[get.GetWebhooksRequestDto, get.GetWebhooksResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/",
+					webhooks.GetWebhooksHandler{}.Handle,
+					validator.
+						Validator[get.GetWebhooksRequestDto]{},
+					router.GET,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageWebhooks)},
+			},
+			&router.AuthorizedRoute[delete.DeleteWebhookRequestDto, delete.DeleteWebhookResponseDto]{
+				Route: &router.DestructiveRoute[delete.DeleteWebhookRequestDto, delete.DeleteWebhookResponseDto]{
+					Route: router.CreateBaseRoute(
+						serviceWrapper,
+						"/:id",
+						webhooks.DeleteWebhookHandler{}.Handle,
+						validator.
+							Validator[delete.DeleteWebhookRequestDto]{}.
+							AttachValidator(
+								validator.ExternalValidator[delete.DeleteWebhookRequestDto, extensions.UUID]{}.
+									RuleFor(
+										func(data *delete.DeleteWebhookRequestDto) *extensions.UUID {
+											return &data.ID
+										},
+									).
+									Must(
+										webhooks_validators.WebhookExistenceValidator{
+											Db: serviceWrapper.GetDbConnection(),
+										},
+									).
+									WithExceptionFactory(
+										func(message string) error {
+											return &common_exceptions.ResourceNotFoundException{
+												BaseRestException: exceptions.BaseRestException{
+													ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+													Message:             message,
+												},
+											}
+										},
+									).
+									WithMessage("webhook with this id does not exist").
+									Validate,
+							),
+						router.DELETE,
+					),
+				},
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageWebhooks)},
+			},
+			&router.AuthorizedRoute[get_deliveries.GetDeliveriesRequestDto, get_deliveries.GetDeliveriesResponseDto]{
+				Route: router.CreateBaseRoute(
+					serviceWrapper,
+					"/:id/deliveries",
+					webhooks.GetDeliveriesHandler{}.Handle,
+					validator.
+						Validator[get_deliveries.GetDeliveriesRequestDto]{}.
+						AttachValidator(
+							validator.ExternalValidator[get_deliveries.GetDeliveriesRequestDto, extensions.UUID]{}.
+								RuleFor(
+									func(data *get_deliveries.GetDeliveriesRequestDto) *extensions.UUID {
+										return &data.ID
+									},
+								).
+								Must(
+									webhooks_validators.WebhookExistenceValidator{
+										Db: serviceWrapper.GetDbConnection(),
+									},
+								).
+								WithExceptionFactory(
+									func(message string) error {
+										return &common_exceptions.ResourceNotFoundException{
+											BaseRestException: exceptions.BaseRestException{
+												ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+												Message:             message,
+											},
+										}
+									},
+								).
+								WithMessage("webhook with this id does not exist").
+								Validate,
+						),
+					router.GET,
+				),
+				Requirements: []router.Requirement{router.RequirePermission(access.ManageWebhooks)},
+			},
+		},
+	)
+
+	return wc
+}
@@ -0,0 +1,88 @@
+package admin
+
+import (
+	"chat_app_backend/application/models/admin/export_audit_log"
+	"chat_app_backend/application/models/admin/get_audit_log"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/response"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"encoding/json"
+	"io"
+
+	"github.com/gin-gonic/gin"
+)
+
+const auditLogExportBatchSize = 500
+
+type ExportAuditLogHandler struct{}
+
+// Handle streams the matching entries as json lines, oldest first. The entries are read in batches,
+// so the export of the whole log doesn't have to fit in memory.
+func (e ExportAuditLogHandler) Handle(
+	request *export_audit_log.ExportAuditLogRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*response.Stream, exceptions.ITrackableException) {
+	params := db_queries.GetAuditLogEntriesAfterParams{
+		ActorID:     request.ActorID,
+		Action:      request.Action,
+		TargetType:  request.TargetType,
+		TargetID:    request.TargetID,
+		RequestID:   request.RequestID,
+		CreatedFrom: request.CreatedFrom,
+		CreatedTo:   request.CreatedTo,
+		MaxCount:    auditLogExportBatchSize,
+	}
+
+	// the first batch is read before anything is written, so the usual error response is still possible
+	entries, queryError := services.GetDbConnection().GetQueries().GetAuditLogEntriesAfter(ctx, params)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	return &response.Stream{
+		ContentType: "application/x-ndjson",
+		FileName:    "audit_log.jsonl",
+		WriteBody: func(writer io.Writer) error {
+			encoder := json.NewEncoder(writer)
+
+			for {
+				if writingError := writeAuditLogEntries(encoder, entries); writingError != nil {
+					return writingError
+				}
+
+				if len(entries) < auditLogExportBatchSize {
+					return nil
+				}
+
+				lastEntry := entries[len(entries)-1]
+				params.AfterCreatedAt = &lastEntry.CreatedAt
+				params.AfterID = &lastEntry.ID
+
+				entries, queryError = services.GetDbConnection().GetQueries().GetAuditLogEntriesAfter(ctx, params)
+				if queryError != nil {
+					return queryError
+				}
+			}
+		},
+	}, nil
+}
+
+func writeAuditLogEntries(encoder *json.Encoder, entries []db_queries.AuditLog) exceptions.ITrackableException {
+	for _, entry := range entries {
+		var line get_audit_log.GetAuditLogEntryResponseDto
+		if mappingError := (mapper.Mapper{}).Map(&line, entry); mappingError != nil {
+			return exceptions.WrapErrorWithTrackableException(mappingError)
+		}
+
+		if encodingError := encoder.Encode(line); encodingError != nil {
+			return exceptions.WrapErrorWithTrackableException(encodingError)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"chat_app_backend/application/models/admin/get_audit_log"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetAuditLogHandler struct{}
+
+func (g GetAuditLogHandler) Handle(
+	request *get_audit_log.GetAuditLogRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*get_audit_log.GetAuditLogResponseDto, exceptions.ITrackableException) {
+	entries, queryError := services.GetDbConnection().
+		GetQueries().
+		GetAuditLogEntries(
+			ctx,
+			db_queries.GetAuditLogEntriesParams{
+				ActorID:     request.ActorID,
+				Action:      request.Action,
+				TargetType:  request.TargetType,
+				TargetID:    request.TargetID,
+				RequestID:   request.RequestID,
+				CreatedFrom: request.CreatedFrom,
+				CreatedTo:   request.CreatedTo,
+				SkipCount:   request.Offset,
+				MaxCount:    request.Limit,
+			},
+		)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	var response get_audit_log.GetAuditLogResponseDto
+	mappingError := mapper.Mapper{}.Map(
+		&response,
+		struct {
+			Entries []db_queries.AuditLog
+		}{
+			Entries: entries,
+		},
+	)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,88 @@
+package admin
+
+import (
+	"chat_app_backend/application/models/admin/get_users"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/search"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetUsersHandler struct{}
+
+func (g GetUsersHandler) Handle(
+	request *get_users.GetUsersRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*get_users.GetUsersResponseDto, exceptions.ITrackableException) {
+	nullRole := db_queries.NullRoleType{}
+	if request.Role != nil {
+		nullRole.RoleType = *request.Role
+		nullRole.Valid = true
+	}
+
+	// the search is matched as a substring, so its wildcards have to be matched literally
+	var searchPattern *string
+	if request.Search != nil {
+		escapedSearch := search.EscapeLike(*request.Search)
+		searchPattern = &escapedSearch
+	}
+
+	queries := services.GetDbConnection().GetQueries()
+
+	users, usersQueryError := queries.SearchUsers(
+		ctx,
+		db_queries.SearchUsersParams{
+			Role:          nullRole,
+			EmailVerified: request.EmailVerified,
+			Online:        request.Online,
+			CreatedFrom:   request.CreatedFrom,
+			CreatedTo:     request.CreatedTo,
+			Search:        searchPattern,
+			SortBy:        request.SortBy,
+			Descending:    request.Order == get_users.OrderDesc,
+			SkipCount:     request.Offset,
+			MaxCount:      request.Limit,
+		},
+	)
+	if usersQueryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(usersQueryError)
+	}
+
+	total, countQueryError := queries.CountSearchedUsers(
+		ctx,
+		db_queries.CountSearchedUsersParams{
+			Role:          nullRole,
+			EmailVerified: request.EmailVerified,
+			Online:        request.Online,
+			CreatedFrom:   request.CreatedFrom,
+			CreatedTo:     request.CreatedTo,
+			Search:        searchPattern,
+		},
+	)
+	if countQueryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(countQueryError)
+	}
+
+	var response get_users.GetUsersResponseDto
+	mappingError := mapper.Mapper{}.Map(
+		&response,
+		struct {
+			Users []db_queries.User
+			Total int64
+		}{
+			Users: users,
+			Total: total,
+		},
+	)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
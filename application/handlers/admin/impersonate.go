@@ -0,0 +1,104 @@
+package admin
+
+import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	"chat_app_backend/application/models/admin/impersonate"
+	"chat_app_backend/application/models/jwt_claims"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+)
+
+type ImpersonateHandler struct{}
+
+// Handle issues a short-lived access token of the user, which also carries the admin. Other admins can't
+// be impersonated, so the impersonation never grants more access than the admin already has.
+func (i ImpersonateHandler) Handle(
+	request *impersonate.ImpersonateRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*impersonate.ImpersonateResponseDto, exceptions.ITrackableException) {
+	admin := requestEnvironment.User
+
+	user, userQueryError := services.GetDbConnection().GetQueries().GetUserById(ctx, request.ID)
+
+	switch {
+	case errors.Is(userQueryError, pgx.ErrNoRows):
+		return nil, common_exceptions.ResourceNotFoundException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.WrapErrorWithTrackableException(userQueryError),
+				Message:             "user not found",
+			},
+		}
+	case userQueryError != nil:
+		return nil, exceptions.WrapErrorWithTrackableException(userQueryError)
+	}
+
+	if user.Role == db_queries.RoleTypeADMIN {
+		message := "admins can't be impersonated"
+		return nil, common_exceptions.ForbiddenException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+				Message:             message,
+			},
+		}
+	}
+
+	var claims jwt_claims.UserClaims
+	if claimsMappingError := (mapper.Mapper{}).Map(&claims, user); claimsMappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(claimsMappingError)
+	}
+
+	claims.Impersonator = &jwt_claims.ImpersonatorClaims{
+		ID:             admin.ID,
+		SessionVersion: admin.SessionVersion,
+	}
+
+	accessToken, tokenGenerationError := services.GetJwtHandler().GenerateImpersonationToken(claims)
+	if tokenGenerationError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(tokenGenerationError)
+	}
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			return shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.UserActor(admin.ID),
+				shared_audit.Entry{
+					Action:     audit.ActionImpersonationStarted,
+					TargetType: audit.TargetUser,
+					TargetID:   user.ID.String(),
+				},
+			)
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	var response impersonate.ImpersonateResponseDto
+	mappingError := mapper.Mapper{}.Map(
+		&response,
+		struct {
+			AccessToken string
+			User        db_queries.User
+		}{
+			AccessToken: accessToken.GetToken(),
+			User:        user,
+		},
+	)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
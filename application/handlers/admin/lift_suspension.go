@@ -0,0 +1,66 @@
+package admin
+
+import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	"chat_app_backend/application/models/admin/lift_suspension"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LiftSuspensionHandler struct{}
+
+func (l LiftSuspensionHandler) Handle(
+	request *lift_suspension.LiftSuspensionRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*lift_suspension.LiftSuspensionResponseDto, exceptions.ITrackableException) {
+	var response lift_suspension.LiftSuspensionResponseDto
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			previousUser, userQueryError := queries.GetUserById(ctx, request.ID)
+			if userQueryError != nil {
+				return exceptions.WrapErrorWithTrackableException(userQueryError)
+			}
+
+			user, updateError := queries.LiftUserSuspension(ctx, request.ID)
+			if updateError != nil {
+				return exceptions.WrapErrorWithTrackableException(updateError)
+			}
+
+			recordingError := shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				shared_audit.Entry{
+					Action:     audit.ActionUserSuspensionLifted,
+					TargetType: audit.TargetUser,
+					TargetID:   user.ID.String(),
+					Before:     previousUser,
+					After:      user,
+				},
+			)
+			if recordingError != nil {
+				return recordingError
+			}
+
+			mappingError := mapper.Mapper{}.Map(&response, struct{ User db_queries.User }{User: user})
+			if mappingError != nil {
+				return exceptions.WrapErrorWithTrackableException(mappingError)
+			}
+
+			return nil
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,74 @@
+package admin
+
+import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	"chat_app_backend/application/models/admin/suspend_user"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SuspendUserHandler struct{}
+
+// Handle suspends the user until the given time, a repeated suspension replaces the previous one.
+func (s SuspendUserHandler) Handle(
+	request *suspend_user.SuspendUserRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*suspend_user.SuspendUserResponseDto, exceptions.ITrackableException) {
+	var response suspend_user.SuspendUserResponseDto
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			previousUser, userQueryError := queries.GetUserById(ctx, request.ID)
+			if userQueryError != nil {
+				return exceptions.WrapErrorWithTrackableException(userQueryError)
+			}
+
+			user, updateError := queries.SuspendUser(
+				ctx,
+				db_queries.SuspendUserParams{
+					SuspendedUntil:   &request.SuspendedUntil,
+					SuspensionReason: &request.Reason,
+					ID:               request.ID,
+				},
+			)
+			if updateError != nil {
+				return exceptions.WrapErrorWithTrackableException(updateError)
+			}
+
+			recordingError := shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				shared_audit.Entry{
+					Action:     audit.ActionUserSuspended,
+					TargetType: audit.TargetUser,
+					TargetID:   user.ID.String(),
+					Before:     previousUser,
+					After:      user,
+				},
+			)
+			if recordingError != nil {
+				return recordingError
+			}
+
+			mappingError := mapper.Mapper{}.Map(&response, struct{ User db_queries.User }{User: user})
+			if mappingError != nil {
+				return exceptions.WrapErrorWithTrackableException(mappingError)
+			}
+
+			return nil
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,73 @@
+package admin
+
+import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	"chat_app_backend/application/models/admin/update_role"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UpdateRoleHandler struct{}
+
+// Handle changes the role of the user, the issued tokens contain the role, so they stop being valid.
+func (u UpdateRoleHandler) Handle(
+	request *update_role.UpdateRoleRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*update_role.UpdateRoleResponseDto, exceptions.ITrackableException) {
+	var response update_role.UpdateRoleResponseDto
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			previousUser, userQueryError := queries.GetUserById(ctx, request.ID)
+			if userQueryError != nil {
+				return exceptions.WrapErrorWithTrackableException(userQueryError)
+			}
+
+			user, updateError := queries.SetUserRole(
+				ctx,
+				db_queries.SetUserRoleParams{
+					Role: request.Role,
+					ID:   request.ID,
+				},
+			)
+			if updateError != nil {
+				return exceptions.WrapErrorWithTrackableException(updateError)
+			}
+
+			recordingError := shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				shared_audit.Entry{
+					Action:     audit.ActionUserRoleChanged,
+					TargetType: audit.TargetUser,
+					TargetID:   user.ID.String(),
+					Before:     previousUser,
+					After:      user,
+				},
+			)
+			if recordingError != nil {
+				return recordingError
+			}
+
+			mappingError := mapper.Mapper{}.Map(&response, struct{ User db_queries.User }{User: user})
+			if mappingError != nil {
+				return exceptions.WrapErrorWithTrackableException(mappingError)
+			}
+
+			return nil
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	return &response, nil
+}
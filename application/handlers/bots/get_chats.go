@@ -0,0 +1,43 @@
+package bots
+
+import (
+	"chat_app_backend/application/models/bots/get_chats"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetBotChatsHandler struct{}
+
+func (g GetBotChatsHandler) Handle(
+	_ *get_chats.GetBotChatsRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*get_chats.GetBotChatsResponseDto, exceptions.ITrackableException) {
+	chats, queryError := services.GetDbConnection().
+		GetQueries().
+		GetServiceAccountChats(ctx, requestEnvironment.ServiceAccount.Account.ID)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	var response get_chats.GetBotChatsResponseDto
+	mappingError := mapper.Mapper{}.Map(
+		&response,
+		struct {
+			Chats []db_queries.Chat
+		}{
+			Chats: chats,
+		},
+	)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,89 @@
+package bots
+
+import (
+	shared_webhooks "chat_app_backend/application/handlers/shared/webhooks"
+	"chat_app_backend/application/models/bots/send_message"
+	"chat_app_backend/application/models/webhooks/events"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/webhooks"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SendMessageHandler struct{}
+
+func (s SendMessageHandler) Handle(
+	request *send_message.SendMessageRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*send_message.SendMessageResponseDto, exceptions.ITrackableException) {
+	if request.MessageReferenceID != nil {
+		exists, existenceCheckError := services.GetDbConnection().
+			GetQueries().
+			MessageExistsInChat(
+				ctx,
+				db_queries.MessageExistsInChatParams{
+					ID:     *request.MessageReferenceID,
+					ChatID: request.ChatID,
+				},
+			)
+		if existenceCheckError != nil {
+			return nil, exceptions.WrapErrorWithTrackableException(existenceCheckError)
+		}
+
+		if !exists {
+			message := "referenced message does not exist in this chat"
+			return nil, common_exceptions.InvalidBodyException{
+				BaseRestException: exceptions.BaseRestException{
+					ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+					Message:             message,
+				},
+			}
+		}
+	}
+
+	var message db_queries.Message
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			createdMessage, creationError := queries.CreateServiceAccountMessage(
+				ctx,
+				db_queries.CreateServiceAccountMessageParams{
+					ChatID:                 request.ChatID,
+					SenderServiceAccountID: requestEnvironment.ServiceAccount.Account.ID,
+					RawText:                &request.RawText,
+					MessageReferenceID:     request.MessageReferenceID,
+				},
+			)
+			if creationError != nil {
+				return exceptions.WrapErrorWithTrackableException(creationError)
+			}
+
+			message = createdMessage
+
+			var event events.MessageCreatedEventDto
+			eventMappingError := mapper.Mapper{}.Map(&event, message)
+			if eventMappingError != nil {
+				return exceptions.WrapErrorWithTrackableException(eventMappingError)
+			}
+
+			return shared_webhooks.PublishEvent(ctx, queries, webhooks.EventMessageCreated, event)
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	var response send_message.SendMessageResponseDto
+	mappingError := mapper.Mapper{}.Map(&response, message)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,54 @@
+package chats
+
+import (
+	shared_webhooks "chat_app_backend/application/handlers/shared/webhooks"
+	"chat_app_backend/application/models/chats/add_bot"
+	"chat_app_backend/application/models/webhooks/events"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/webhooks"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AddBotHandler struct{}
+
+func (a AddBotHandler) Handle(
+	request *add_bot.AddBotRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*add_bot.AddBotResponseDto, exceptions.ITrackableException) {
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			additionError := queries.AddServiceAccountToChat(
+				ctx,
+				db_queries.AddServiceAccountToChatParams{
+					ChatID:           request.ChatID,
+					ServiceAccountID: request.ServiceAccountID,
+					InvitedBy:        &requestEnvironment.User.ID,
+				},
+			)
+			if additionError != nil {
+				return exceptions.WrapErrorWithTrackableException(additionError)
+			}
+
+			return shared_webhooks.PublishEvent(
+				ctx,
+				queries,
+				webhooks.EventChatMemberAdded,
+				events.ChatMemberAddedEventDto{
+					ChatID:           request.ChatID,
+					ServiceAccountID: &request.ServiceAccountID,
+					InvitedBy:        &requestEnvironment.User.ID,
+				},
+			)
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	return &add_bot.AddBotResponseDto{}, nil
+}
@@ -0,0 +1,35 @@
+package chats
+
+import (
+	"chat_app_backend/application/models/chats/remove_bot"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RemoveBotHandler struct{}
+
+func (r RemoveBotHandler) Handle(
+	request *remove_bot.RemoveBotRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*remove_bot.RemoveBotResponseDto, exceptions.ITrackableException) {
+	removalError := services.GetDbConnection().
+		GetQueries().
+		RemoveServiceAccountFromChat(
+			ctx,
+			db_queries.RemoveServiceAccountFromChatParams{
+				ChatID:           request.ChatID,
+				ServiceAccountID: request.ServiceAccountID,
+			},
+		)
+	if removalError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(removalError)
+	}
+
+	return &remove_bot.RemoveBotResponseDto{}, nil
+}
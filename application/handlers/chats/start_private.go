@@ -0,0 +1,117 @@
+package chats
+
+import (
+	shared_notifications "chat_app_backend/application/handlers/shared/notifications"
+	shared_webhooks "chat_app_backend/application/handlers/shared/webhooks"
+	"chat_app_backend/application/models/chats/start_private"
+	"chat_app_backend/application/models/webhooks/events"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/webhooks"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+)
+
+type StartPrivateChatHandler struct{}
+
+// Handle returns the existing private chat of the users if there is one, otherwise creates it, the
+// private chat policy of the other user is checked by the route validators.
+func (s StartPrivateChatHandler) Handle(
+	request *start_private.StartPrivateChatRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*start_private.StartPrivateChatResponseDto, exceptions.ITrackableException) {
+	var chat db_queries.Chat
+	created := false
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			existingChat, chatQueryError := queries.GetPrivateChatBetween(
+				ctx,
+				db_queries.GetPrivateChatBetweenParams{
+					FirstUserID:  requestEnvironment.User.ID,
+					SecondUserID: request.UserID,
+				},
+			)
+
+			switch {
+			case chatQueryError == nil:
+				chat = existingChat
+				return nil
+			case !errors.Is(chatQueryError, pgx.ErrNoRows):
+				return exceptions.WrapErrorWithTrackableException(chatQueryError)
+			}
+
+			createdChat, creationError := queries.CreateChat(
+				ctx,
+				db_queries.CreateChatParams{CType: db_queries.ChatTypePRIVATECHAT},
+			)
+			if creationError != nil {
+				return exceptions.WrapErrorWithTrackableException(creationError)
+			}
+
+			chat = createdChat
+			created = true
+
+			for _, userId := range []extensions.UUID{requestEnvironment.User.ID, request.UserID} {
+				additionError := queries.AddUserToChat(
+					ctx,
+					db_queries.AddUserToChatParams{
+						UserID: userId,
+						ChatID: chat.ID,
+					},
+				)
+				if additionError != nil {
+					return exceptions.WrapErrorWithTrackableException(additionError)
+				}
+
+				publishError := shared_webhooks.PublishEvent(
+					ctx,
+					queries,
+					webhooks.EventChatMemberAdded,
+					events.ChatMemberAddedEventDto{
+						ChatID:    chat.ID,
+						UserID:    &userId,
+						InvitedBy: &requestEnvironment.User.ID,
+					},
+				)
+				if publishError != nil {
+					return publishError
+				}
+			}
+
+			return nil
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	if created {
+		shared_notifications.NotifyUsers(
+			ctx,
+			services,
+			webhooks.EventChatMemberAdded,
+			events.ChatMemberAddedEventDto{
+				ChatID:    chat.ID,
+				UserID:    &request.UserID,
+				InvitedBy: &requestEnvironment.User.ID,
+			},
+			request.UserID,
+		)
+	}
+
+	var response start_private.StartPrivateChatResponseDto
+	mappingError := mapper.Mapper{}.Map(&response, chat)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,62 @@
+package contacts
+
+import (
+	"chat_app_backend/application/models/contacts/get"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/privacy"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetContactsHandler struct{}
+
+func (g GetContactsHandler) Handle(
+	request *get.GetContactsRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*get.GetContactsResponseDto, exceptions.ITrackableException) {
+	queries := services.GetDbConnection().GetQueries()
+
+	contacts, contactsQueryError := queries.GetUserContacts(
+		ctx,
+		db_queries.GetUserContactsParams{
+			UserID:    requestEnvironment.User.ID,
+			SkipCount: request.Offset,
+			MaxCount:  request.Limit,
+		},
+	)
+	if contactsQueryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(contactsQueryError)
+	}
+
+	total, countQueryError := queries.CountUserContacts(ctx, requestEnvironment.User.ID)
+	if countQueryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(countQueryError)
+	}
+
+	response := get.GetContactsResponseDto{
+		Contacts: make([]get.GetContactResponseDto, 0, len(contacts)),
+		Total:    total,
+	}
+
+	for _, contact := range contacts {
+		mappedContact := get.GetContactResponseDto{
+			ID:           contact.ID,
+			FullName:     contact.FullName,
+			ContactSince: contact.ContactSince,
+		}
+
+		if privacy.IsVisibleTo(contact.PresenceVisibility, requestEnvironment.User, contact.ID, true) {
+			mappedContact.Online = &contact.Online
+			mappedContact.LastSeen = &contact.LastSeen
+		}
+
+		response.Contacts = append(response.Contacts, mappedContact)
+	}
+
+	return &response, nil
+}
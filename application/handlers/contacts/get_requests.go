@@ -0,0 +1,65 @@
+package contacts
+
+import (
+	"chat_app_backend/application/models/contacts/get_requests"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetRequestsHandler struct{}
+
+func (g GetRequestsHandler) Handle(
+	request *get_requests.GetContactRequestsRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*get_requests.GetContactRequestsResponseDto, exceptions.ITrackableException) {
+	queries := services.GetDbConnection().GetQueries()
+
+	var contactRequests []db_queries.ContactRequest
+	var queryError error
+
+	switch request.Direction {
+	case get_requests.DirectionOutgoing:
+		contactRequests, queryError = queries.GetOutgoingContactRequests(
+			ctx,
+			db_queries.GetOutgoingContactRequestsParams{
+				UserID:    requestEnvironment.User.ID,
+				SkipCount: request.Offset,
+				MaxCount:  request.Limit,
+			},
+		)
+	default:
+		contactRequests, queryError = queries.GetIncomingContactRequests(
+			ctx,
+			db_queries.GetIncomingContactRequestsParams{
+				UserID:    requestEnvironment.User.ID,
+				SkipCount: request.Offset,
+				MaxCount:  request.Limit,
+			},
+		)
+	}
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	var response get_requests.GetContactRequestsResponseDto
+	mappingError := mapper.Mapper{}.Map(
+		&response,
+		struct {
+			Requests []db_queries.ContactRequest
+		}{
+			Requests: contactRequests,
+		},
+	)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,59 @@
+package contacts
+
+import (
+	shared_notifications "chat_app_backend/application/handlers/shared/notifications"
+	shared_webhooks "chat_app_backend/application/handlers/shared/webhooks"
+	"chat_app_backend/application/models/contacts/remove"
+	"chat_app_backend/application/models/webhooks/events"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/webhooks"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RemoveContactHandler struct{}
+
+func (r RemoveContactHandler) Handle(
+	request *remove.RemoveContactRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*remove.RemoveContactResponseDto, exceptions.ITrackableException) {
+	event := events.ContactRemovedEventDto{
+		UserID:    requestEnvironment.User.ID,
+		ContactID: request.UserID,
+	}
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			_, removalError := queries.RemoveContact(
+				ctx,
+				db_queries.RemoveContactParams{
+					UserID:    requestEnvironment.User.ID,
+					ContactID: request.UserID,
+				},
+			)
+			if removalError != nil {
+				return exceptions.WrapErrorWithTrackableException(removalError)
+			}
+
+			return shared_webhooks.PublishEvent(ctx, queries, webhooks.EventContactRemoved, event)
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	shared_notifications.NotifyUsers(
+		ctx,
+		services,
+		webhooks.EventContactRemoved,
+		event,
+		requestEnvironment.User.ID,
+		request.UserID,
+	)
+
+	return &remove.RemoveContactResponseDto{}, nil
+}
@@ -0,0 +1,148 @@
+package contacts
+
+import (
+	shared_notifications "chat_app_backend/application/handlers/shared/notifications"
+	shared_webhooks "chat_app_backend/application/handlers/shared/webhooks"
+	"chat_app_backend/application/models/contacts/resolve_request"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/webhooks"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+)
+
+type AcceptRequestHandler struct{}
+
+func (a AcceptRequestHandler) Handle(
+	request *resolve_request.ResolveContactRequestRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*resolve_request.ResolveContactRequestResponseDto, exceptions.ITrackableException) {
+	return resolveRequest(
+		ctx,
+		services,
+		request.ID,
+		db_queries.ContactRequestStatusACCEPTED,
+		webhooks.EventContactRequestAccepted,
+		func(queries *db_queries.Queries, contactRequest db_queries.ContactRequest) error {
+			return queries.AddContact(
+				ctx,
+				db_queries.AddContactParams{
+					UserID:    contactRequest.SenderID,
+					ContactID: contactRequest.ReceiverID,
+				},
+			)
+		},
+	)
+}
+
+type DeclineRequestHandler struct{}
+
+func (d DeclineRequestHandler) Handle(
+	request *resolve_request.ResolveContactRequestRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*resolve_request.ResolveContactRequestResponseDto, exceptions.ITrackableException) {
+	return resolveRequest(
+		ctx,
+		services,
+		request.ID,
+		db_queries.ContactRequestStatusDECLINED,
+		webhooks.EventContactRequestDeclined,
+		nil,
+	)
+}
+
+type CancelRequestHandler struct{}
+
+func (c CancelRequestHandler) Handle(
+	request *resolve_request.ResolveContactRequestRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*resolve_request.ResolveContactRequestResponseDto, exceptions.ITrackableException) {
+	return resolveRequest(
+		ctx,
+		services,
+		request.ID,
+		db_queries.ContactRequestStatusCANCELLED,
+		webhooks.EventContactRequestCancelled,
+		nil,
+	)
+}
+
+// resolveRequest moves a pending request to the final status, the access to the request is checked by
+// the route validators. The status update only matches pending requests, so concurrent resolutions
+// of the same request can't both succeed.
+func resolveRequest(
+	ctx *gin.Context,
+	services service_wrapper.IServiceWrapper,
+	id extensions.UUID,
+	status db_queries.ContactRequestStatus,
+	eventType string,
+	onResolved func(queries *db_queries.Queries, contactRequest db_queries.ContactRequest) error,
+) (*resolve_request.ResolveContactRequestResponseDto, exceptions.ITrackableException) {
+	var contactRequest db_queries.ContactRequest
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			resolvedRequest, resolutionError := queries.ResolveContactRequest(
+				ctx,
+				db_queries.ResolveContactRequestParams{
+					Status: status,
+					ID:     id,
+				},
+			)
+
+			switch {
+			case errors.Is(resolutionError, pgx.ErrNoRows):
+				return common_exceptions.ResourceNotFoundException{
+					BaseRestException: exceptions.BaseRestException{
+						ITrackableException: exceptions.WrapErrorWithTrackableException(resolutionError),
+						Message:             "pending contact request not found",
+					},
+				}
+			case resolutionError != nil:
+				return exceptions.WrapErrorWithTrackableException(resolutionError)
+			}
+
+			contactRequest = resolvedRequest
+
+			if onResolved != nil {
+				if callbackError := onResolved(queries, contactRequest); callbackError != nil {
+					return exceptions.WrapErrorWithTrackableException(callbackError)
+				}
+			}
+
+			return shared_webhooks.PublishEvent(ctx, queries, eventType, createContactRequestEvent(contactRequest))
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	shared_notifications.NotifyUsers(
+		ctx,
+		services,
+		eventType,
+		createContactRequestEvent(contactRequest),
+		contactRequest.SenderID,
+		contactRequest.ReceiverID,
+	)
+
+	var response resolve_request.ResolveContactRequestResponseDto
+	mappingError := mapper.Mapper{}.Map(&response, contactRequest)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,78 @@
+package contacts
+
+import (
+	shared_notifications "chat_app_backend/application/handlers/shared/notifications"
+	shared_webhooks "chat_app_backend/application/handlers/shared/webhooks"
+	"chat_app_backend/application/models/contacts/send_request"
+	"chat_app_backend/application/models/webhooks/events"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/webhooks"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SendRequestHandler struct{}
+
+func (s SendRequestHandler) Handle(
+	request *send_request.SendContactRequestRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*send_request.SendContactRequestResponseDto, exceptions.ITrackableException) {
+	var contactRequest db_queries.ContactRequest
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			createdRequest, creationError := queries.CreateContactRequest(
+				ctx,
+				db_queries.CreateContactRequestParams{
+					SenderID:   requestEnvironment.User.ID,
+					ReceiverID: request.ReceiverID,
+				},
+			)
+			if creationError != nil {
+				return exceptions.WrapErrorWithTrackableException(creationError)
+			}
+
+			contactRequest = createdRequest
+
+			return shared_webhooks.PublishEvent(
+				ctx,
+				queries,
+				webhooks.EventContactRequestSent,
+				createContactRequestEvent(contactRequest),
+			)
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	shared_notifications.NotifyUsers(
+		ctx,
+		services,
+		webhooks.EventContactRequestSent,
+		createContactRequestEvent(contactRequest),
+		contactRequest.ReceiverID,
+	)
+
+	var response send_request.SendContactRequestResponseDto
+	mappingError := mapper.Mapper{}.Map(&response, contactRequest)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
+
+func createContactRequestEvent(contactRequest db_queries.ContactRequest) events.ContactRequestEventDto {
+	return events.ContactRequestEventDto{
+		ID:         contactRequest.ID,
+		SenderID:   contactRequest.SenderID,
+		ReceiverID: contactRequest.ReceiverID,
+		Status:     string(contactRequest.Status),
+	}
+}
@@ -0,0 +1,62 @@
+package interest_categories
+
+import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	"chat_app_backend/application/models/interest_categories/create"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CreateInterestCategoryHandler struct{}
+
+func (c CreateInterestCategoryHandler) Handle(
+	request *create.CreateInterestCategoryRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*create.CreateInterestCategoryResponseDto, exceptions.ITrackableException) {
+	var params db_queries.CreateInterestCategoryParams
+	if paramsMappingError := (mapper.Mapper{}).Map(&params, *request); paramsMappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(paramsMappingError)
+	}
+
+	var category db_queries.InterestCategory
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			createdCategory, creationError := queries.CreateInterestCategory(ctx, params)
+			if creationError != nil {
+				return exceptions.WrapErrorWithTrackableException(creationError)
+			}
+
+			category = createdCategory
+
+			return shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				shared_audit.Entry{
+					Action:     audit.ActionInterestCategoryCreated,
+					TargetType: audit.TargetInterestCategory,
+					TargetID:   category.ID.String(),
+					After:      category,
+				},
+			)
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	var response create.CreateInterestCategoryResponseDto
+	if responseMappingError := (mapper.Mapper{}).Map(&response, category); responseMappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(responseMappingError)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,69 @@
+package interest_categories
+
+import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	delete2 "chat_app_backend/application/models/interest_categories/delete"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DeleteInterestCategoryHandler struct{}
+
+// Handle removes an empty category, the interests of the category become uncategorized. Categories with
+// subcategories have to be emptied first, so a whole subtree is never removed by accident.
+func (d DeleteInterestCategoryHandler) Handle(
+	request *delete2.DeleteInterestCategoryRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*delete2.DeleteInterestCategoryResponseDto, exceptions.ITrackableException) {
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			category, categoryQueryError := queries.GetInterestCategoryById(ctx, request.ID)
+			if categoryQueryError != nil {
+				return exceptions.WrapErrorWithTrackableException(categoryQueryError)
+			}
+
+			subcategoriesCount, countError := queries.CountInterestSubcategories(ctx, request.ID)
+			if countError != nil {
+				return exceptions.WrapErrorWithTrackableException(countError)
+			}
+
+			if subcategoriesCount > 0 {
+				message := "category with subcategories can't be deleted"
+				return common_exceptions.InvalidBodyException{
+					BaseRestException: exceptions.BaseRestException{
+						ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+						Message:             message,
+					},
+				}
+			}
+
+			if deletionError := queries.DeleteInterestCategory(ctx, request.ID); deletionError != nil {
+				return exceptions.WrapErrorWithTrackableException(deletionError)
+			}
+
+			return shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				shared_audit.Entry{
+					Action:     audit.ActionInterestCategoryDeleted,
+					TargetType: audit.TargetInterestCategory,
+					TargetID:   category.ID.String(),
+					Before:     category,
+				},
+			)
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	return &delete2.DeleteInterestCategoryResponseDto{}, nil
+}
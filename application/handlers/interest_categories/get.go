@@ -0,0 +1,43 @@
+package interest_categories
+
+import (
+	"chat_app_backend/application/models/interest_categories/get"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetInterestCategoriesHandler struct{}
+
+// Handle returns the categories as a flat list ordered by position, the tree with the interests is
+// available through the interests route.
+func (g GetInterestCategoriesHandler) Handle(
+	_ *get.GetInterestCategoriesRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*get.GetInterestCategoriesResponseDto, exceptions.ITrackableException) {
+	categories, queryError := services.GetDbConnection().GetQueries().GetInterestCategories(ctx)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	var response get.GetInterestCategoriesResponseDto
+	mappingError := mapper.Mapper{}.Map(
+		&response,
+		struct {
+			Categories []db_queries.InterestCategory
+		}{
+			Categories: categories,
+		},
+	)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
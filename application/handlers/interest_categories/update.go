@@ -0,0 +1,103 @@
+package interest_categories
+
+import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	"chat_app_backend/application/models/interest_categories/update"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UpdateInterestCategoryHandler struct{}
+
+func (u UpdateInterestCategoryHandler) Handle(
+	request *update.UpdateInterestCategoryRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*update.UpdateInterestCategoryResponseDto, exceptions.ITrackableException) {
+	var params db_queries.UpdateInterestCategoryParams
+	if paramsMappingError := (mapper.Mapper{}).Map(&params, *request); paramsMappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(paramsMappingError)
+	}
+
+	movesCategory := request.ParentID != nil && !request.ClearParent
+
+	var newCategory db_queries.InterestCategory
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			// the moves are serialized, the check below only sees the committed tree, so two concurrent moves
+			// could form a cycle together otherwise
+			if movesCategory {
+				if lockError := queries.LockInterestCategories(ctx); lockError != nil {
+					return exceptions.WrapErrorWithTrackableException(lockError)
+				}
+			}
+
+			category, categoryQueryError := queries.GetInterestCategoryById(ctx, request.ID)
+			if categoryQueryError != nil {
+				return exceptions.WrapErrorWithTrackableException(categoryQueryError)
+			}
+
+			// the new parent can't be inside the moved subtree, otherwise the categories would form a cycle
+			if movesCategory {
+				inSubtree, subtreeQueryError := queries.IsInterestCategoryInSubtree(
+					ctx,
+					db_queries.IsInterestCategoryInSubtreeParams{
+						RootID: request.ID,
+						ID:     *request.ParentID,
+					},
+				)
+				if subtreeQueryError != nil {
+					return exceptions.WrapErrorWithTrackableException(subtreeQueryError)
+				}
+
+				if inSubtree {
+					message := "category can't be moved into itself or its subcategory"
+					return common_exceptions.InvalidBodyException{
+						BaseRestException: exceptions.BaseRestException{
+							ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+							Message:             message,
+						},
+					}
+				}
+			}
+
+			updatedCategory, updateError := queries.UpdateInterestCategory(ctx, params)
+			if updateError != nil {
+				return exceptions.WrapErrorWithTrackableException(updateError)
+			}
+
+			newCategory = updatedCategory
+
+			return shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				shared_audit.Entry{
+					Action:     audit.ActionInterestCategoryUpdated,
+					TargetType: audit.TargetInterestCategory,
+					TargetID:   newCategory.ID.String(),
+					Before:     category,
+					After:      newCategory,
+				},
+			)
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	var response update.UpdateInterestCategoryResponseDto
+	if responseMappingError := (mapper.Mapper{}).Map(&response, newCategory); responseMappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(responseMappingError)
+	}
+
+	return &response, nil
+}
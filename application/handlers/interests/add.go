@@ -0,0 +1,41 @@
+package interests
+
+import (
+	"chat_app_backend/application/models/interests/add_interests"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AddInterestsHandler struct{}
+
+func (a AddInterestsHandler) Handle(
+	request *add_interests.AddInterestsRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*add_interests.AddInterestsResponseDto, exceptions.ITrackableException) {
+	if accessError := checkUserInterestsAccess(requestEnvironment, request.UserID); accessError != nil {
+		return nil, accessError
+	}
+
+	userInterests, changeError := changeUserInterests(
+		ctx,
+		services,
+		request.UserID,
+		func(queries *db_queries.Queries) error {
+			return queries.AddUserInterests(ctx, db_queries.AddUserInterestsParams{
+				UserID:      request.UserID,
+				InterestIds: request.InterestIds,
+			})
+		},
+	)
+	if changeError != nil {
+		return nil, changeError
+	}
+
+	return &add_interests.AddInterestsResponseDto{Interests: userInterests}, nil
+}
@@ -1,9 +1,7 @@
 package interests
 
 import (
-	shared_interests "chat_app_backend/application/handlers/shared/interests"
 	"chat_app_backend/application/models/interests/assign"
-	"chat_app_backend/application/models/interests/get"
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/exceptions/common_exceptions"
 	"chat_app_backend/internal/mapper"
@@ -22,23 +20,13 @@ func (a AssignInterestHandler) Handle(
 	ctx *gin.Context,
 	requestEnvironment *request_env.RequestEnv,
 ) (*assign.AssignInterestResponseDto, exceptions.ITrackableException) {
-	if requestEnvironment.User.Role != db_queries.RoleTypeADMIN && request.UserID != requestEnvironment.User.ID {
-		return nil, &common_exceptions.ForbiddenException{
-			BaseRestException: exceptions.BaseRestException{
-				ITrackableException: exceptions.CreateTrackableExceptionFromStringF("user role assignment forbidden"),
-				Message:             "not enough privileges to update this user",
-			},
-		}
+	if accessError := checkUserInterestsAccess(requestEnvironment, request.UserID); accessError != nil {
+		return nil, accessError
 	}
 
-	params := db_queries.GetManyInterestsByFiltersParams{Ids: request.InterestIds}
-
 	interests, getError := services.GetDbConnection().
 		GetQueries().
-		GetManyInterestsByFilters(
-			ctx,
-			params,
-		)
+		GetInterestsByIds(ctx, request.InterestIds)
 
 	if getError != nil {
 		return nil, exceptions.WrapErrorWithTrackableException(getError)
@@ -53,51 +41,26 @@ func (a AssignInterestHandler) Handle(
 		}
 	}
 
-	assignError := services.GetDbConnection().
-		CreateTransaction(
-			ctx,
-			func(queries *db_queries.Queries) exceptions.ITrackableException {
-				if removeError := queries.RemoveUserInterests(ctx, request.UserID); removeError != nil {
-					return exceptions.WrapErrorWithTrackableException(removeError)
-				}
-
-				var assignParams db_queries.AssignInterestsToUserParams
-
-				paramMappingError := mapper.Mapper{}.Map(&assignParams, *request)
-
-				if paramMappingError != nil {
-					return exceptions.WrapErrorWithTrackableException(paramMappingError)
-				}
-
-				if assignError := queries.AssignInterestsToUser(ctx, assignParams); assignError != nil {
-					return exceptions.WrapErrorWithTrackableException(assignError)
-				}
-
-				return nil
-			},
-		)
-
+	userInterests, assignError := changeUserInterests(
+		ctx,
+		services,
+		request.UserID,
+		func(queries *db_queries.Queries) error {
+			if removeError := queries.RemoveUserInterests(ctx, request.UserID); removeError != nil {
+				return removeError
+			}
+
+			var assignParams db_queries.AssignInterestsToUserParams
+			if paramMappingError := (mapper.Mapper{}).Map(&assignParams, *request); paramMappingError != nil {
+				return paramMappingError
+			}
+
+			return queries.AssignInterestsToUser(ctx, assignParams)
+		},
+	)
 	if assignError != nil {
 		return nil, assignError
 	}
 
-	var response assign.AssignInterestResponseDto
-
-	interestsWithIcons, iconsGetError := shared_interests.GetInterestIcons(interests, services.GetS3Client(), ctx)
-
-	if iconsGetError != nil {
-		return nil, iconsGetError
-	}
-
-	responseMappingError := mapper.Mapper{}.Map(&response, struct {
-		Interests []get.GetInterestResponseDto
-	}{
-		Interests: interestsWithIcons,
-	})
-
-	if responseMappingError != nil {
-		return nil, exceptions.WrapErrorWithTrackableException(responseMappingError)
-	}
-
-	return &response, nil
+	return &assign.AssignInterestResponseDto{Interests: userInterests}, nil
 }
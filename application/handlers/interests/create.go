@@ -1,7 +1,13 @@
 package interests
 
 import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	shared_images "chat_app_backend/application/handlers/shared/images"
+	shared_webhooks "chat_app_backend/application/handlers/shared/webhooks"
 	"chat_app_backend/application/models/interests/create"
+	"chat_app_backend/application/models/webhooks/events"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/etag"
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/exceptions/common_exceptions"
 	"chat_app_backend/internal/mapper"
@@ -9,6 +15,7 @@ import (
 	"chat_app_backend/internal/s3"
 	"chat_app_backend/internal/service_wrapper"
 	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/webhooks"
 
 	"github.com/gin-gonic/gin"
 )
@@ -30,13 +37,16 @@ func (c CreateInterestHandler) Handle(
 		}
 	}
 
-	_, iconFileType, _ := s3.DeconstructFileName(request.Icon.Filename)
-	filename := s3.ConstructFilenameFromFileType(iconFileType)
-
-	iconDownloadLink, fileUploadError := services.GetS3Client().
-		UploadFile(ctx, request.Icon, filename, s3.InterestsIconBucket)
-	if fileUploadError != nil {
-		return nil, exceptions.WrapErrorWithTrackableException(fileUploadError)
+	icon, iconUploadError := shared_images.UploadImage(
+		ctx,
+		services,
+		request.Icon,
+		s3.InterestsIconBucket,
+		s3.Png,
+		s3.Svg,
+	)
+	if iconUploadError != nil {
+		return nil, iconUploadError
 	}
 
 	dbRequest := db_queries.CreateInterestParams{}
@@ -44,9 +54,11 @@ func (c CreateInterestHandler) Handle(
 		&dbRequest,
 		*request,
 		struct {
-			IconFileName string
+			IconFileName       string
+			IconThumbnailSizes []int32
 		}{
-			IconFileName: filename,
+			IconFileName:       icon.FileName,
+			IconThumbnailSizes: icon.ThumbnailSizes,
 		},
 	)
 
@@ -54,21 +66,61 @@ func (c CreateInterestHandler) Handle(
 		return nil, exceptions.WrapErrorWithTrackableException(dbRequestMapperError)
 	}
 
-	interest, creationError := services.GetDbConnection().
-		GetQueries().
-		CreateInterest(ctx, dbRequest)
-	if creationError != nil {
-		return nil, exceptions.WrapErrorWithTrackableException(creationError)
+	var interest db_queries.Interest
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			createdInterest, creationError := queries.CreateInterest(ctx, dbRequest)
+			if creationError != nil {
+				return exceptions.WrapErrorWithTrackableException(creationError)
+			}
+
+			interest = createdInterest
+
+			publishingError := shared_webhooks.PublishEvent(
+				ctx,
+				queries,
+				webhooks.EventInterestCreated,
+				events.InterestEventDto{
+					ID:          interest.ID,
+					Title:       interest.Title,
+					Description: interest.Description,
+				},
+			)
+			if publishingError != nil {
+				return publishingError
+			}
+
+			return shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				shared_audit.Entry{
+					Action:     audit.ActionInterestCreated,
+					TargetType: audit.TargetInterest,
+					TargetID:   interest.ID.String(),
+					After:      interest,
+				},
+			)
+		})
+	if transactionError != nil {
+		return nil, transactionError
 	}
 
+	etag.Set(ctx, interest.UpdatedAt)
+
 	response := create.CreateInterestResponseDto{}
 	responseMappingError := mapper.Mapper{}.Map(
 		&response,
 		interest,
 		struct {
-			IconDownloadLink string
+			IconDownloadLink   string
+			IconThumbnailLinks map[string]string
+			ETag               string
 		}{
-			IconDownloadLink: iconDownloadLink,
+			IconDownloadLink:   icon.DownloadLink,
+			IconThumbnailLinks: icon.ThumbnailLinks,
+			ETag:               etag.FromVersion(interest.UpdatedAt),
 		},
 	)
 
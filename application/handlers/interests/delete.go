@@ -1,12 +1,18 @@
 package interests
 
 import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	shared_webhooks "chat_app_backend/application/handlers/shared/webhooks"
 	delete2 "chat_app_backend/application/models/interests/delete"
+	"chat_app_backend/application/models/webhooks/events"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/etag"
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/exceptions/common_exceptions"
 	"chat_app_backend/internal/request_env"
 	"chat_app_backend/internal/service_wrapper"
 	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/webhooks"
 
 	"github.com/gin-gonic/gin"
 )
@@ -28,12 +34,57 @@ func (d DeleteInterestHandler) Handle(
 		}
 	}
 
-	deletionError := services.GetDbConnection().
-		GetQueries().
-		DeleteInterest(ctx, request.ID)
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			interest, interestQueryError := queries.GetInterestById(ctx, request.ID)
+			if interestQueryError != nil {
+				return exceptions.WrapErrorWithTrackableException(interestQueryError)
+			}
 
-	if deletionError != nil {
-		return nil, exceptions.WrapErrorWithTrackableException(deletionError)
+			deletedCount, deletionError := queries.DeleteInterest(
+				ctx,
+				db_queries.DeleteInterestParams{
+					ID:               request.ID,
+					ExpectedVersions: etag.ExpectedVersions(ctx),
+				},
+			)
+			if deletionError != nil {
+				return exceptions.WrapErrorWithTrackableException(deletionError)
+			}
+
+			if deletedCount == 0 {
+				return common_exceptions.PreconditionFailedException{
+					BaseRestException: exceptions.BaseRestException{
+						ITrackableException: exceptions.CreateTrackableExceptionFromStringF("interest %s was changed", request.ID),
+						Message:             "interest was changed since it was read",
+					},
+				}
+			}
+
+			publishingError := shared_webhooks.PublishEvent(
+				ctx,
+				queries,
+				webhooks.EventInterestDeleted,
+				events.InterestDeletedEventDto{ID: request.ID},
+			)
+			if publishingError != nil {
+				return publishingError
+			}
+
+			return shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				shared_audit.Entry{
+					Action:     audit.ActionInterestDeleted,
+					TargetType: audit.TargetInterest,
+					TargetID:   interest.ID.String(),
+					Before:     interest,
+				},
+			)
+		})
+	if transactionError != nil {
+		return nil, transactionError
 	}
 
 	return &delete2.DeleteInterestResponseDto{}, nil
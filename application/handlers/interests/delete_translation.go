@@ -0,0 +1,66 @@
+package interests
+
+import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	"chat_app_backend/application/models/interests/delete_translation"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/locale"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+)
+
+type DeleteInterestTranslationHandler struct{}
+
+func (d DeleteInterestTranslationHandler) Handle(
+	request *delete_translation.DeleteInterestTranslationRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*delete_translation.DeleteInterestTranslationResponseDto, exceptions.ITrackableException) {
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			deletedTranslation, deletionError := queries.DeleteInterestTranslation(
+				ctx,
+				db_queries.DeleteInterestTranslationParams{
+					InterestID: request.ID,
+					Locale:     locale.Normalize(request.Locale),
+				},
+			)
+
+			switch {
+			case errors.Is(deletionError, pgx.ErrNoRows):
+				return common_exceptions.ResourceNotFoundException{
+					BaseRestException: exceptions.BaseRestException{
+						ITrackableException: exceptions.WrapErrorWithTrackableException(deletionError),
+						Message:             "translation not found",
+					},
+				}
+			case deletionError != nil:
+				return exceptions.WrapErrorWithTrackableException(deletionError)
+			}
+
+			return shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				shared_audit.Entry{
+					Action:     audit.ActionInterestTranslationDeleted,
+					TargetType: audit.TargetInterest,
+					TargetID:   request.ID.String(),
+					Before:     deletedTranslation,
+				},
+			)
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	return &delete_translation.DeleteInterestTranslationResponseDto{}, nil
+}
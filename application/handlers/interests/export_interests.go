@@ -0,0 +1,59 @@
+package interests
+
+import (
+	"chat_app_backend/application/models/interests/export_interests"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/interest_archive"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/response"
+	"chat_app_backend/internal/service_wrapper"
+	"fmt"
+	"io"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExportInterestsHandler struct{}
+
+// Handle writes all the interests as an archive, which the import accepts. The icons are read before
+// anything is written, so a missing icon is still reported with the usual error response.
+func (e ExportInterestsHandler) Handle(
+	request *export_interests.ExportInterestsRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*response.Stream, exceptions.ITrackableException) {
+	interests, queryError := services.GetDbConnection().GetQueries().GetAllInterests(ctx)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	rows := make([]interest_archive.Row, len(interests))
+	icons := make(map[string][]byte, len(interests))
+
+	for idx, interest := range interests {
+		icon, downloadError := downloadInterestIcon(ctx, services, interest.IconFileName)
+		if downloadError != nil {
+			return nil, downloadError
+		}
+
+		iconPath := interest_archive.IconsDirectory + interest.IconFileName
+		icons[iconPath] = icon
+
+		rows[idx] = interest_archive.Row{
+			Title:       interest.Title,
+			Description: interest.Description,
+			Icon:        iconPath,
+			CategoryID:  interest.CategoryID,
+			Position:    interest.Position,
+		}
+	}
+
+	return &response.Stream{
+		ContentType: "application/zip",
+		FileName:    fmt.Sprintf("interests_%s.zip", request.Format),
+		WriteBody: func(writer io.Writer) error {
+			return interest_archive.Write(writer, request.Format, rows, icons)
+		},
+	}, nil
+}
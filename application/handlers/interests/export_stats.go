@@ -0,0 +1,62 @@
+package interests
+
+import (
+	shared_interests "chat_app_backend/application/handlers/shared/interests"
+	"chat_app_backend/application/models/interests/export_stats"
+	"chat_app_backend/application/models/interests/stats"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/response"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExportInterestStatsHandler struct{}
+
+// Handle writes the statistics of all interests as a csv or a json file. There is a row per interest,
+// so unlike the audit log the whole export is read at once.
+func (e ExportInterestStatsHandler) Handle(
+	request *export_stats.ExportInterestStatsRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*response.Stream, exceptions.ITrackableException) {
+	rows, queryError := services.GetDbConnection().GetQueries().GetInterestStats(
+		ctx,
+		db_queries.GetInterestStatsParams{
+			WindowStart: shared_interests.StatsWindowStart(time.Now(), request.WindowDays),
+		},
+	)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	interestStats := make([]stats.InterestStatsResponseDto, len(rows))
+	for idx, row := range rows {
+		interestStats[idx] = shared_interests.CreateInterestStats(row.ID, row.Title, row.UsersCount, row.Added, row.Removed)
+	}
+
+	stream := response.Stream{
+		ContentType: "text/csv",
+		FileName:    fmt.Sprintf("interest_stats.%s", request.Format),
+		WriteBody: func(writer io.Writer) error {
+			return shared_interests.WriteInterestStatsCsv(csv.NewWriter(writer), interestStats)
+		},
+	}
+
+	if request.Format == export_stats.FormatJson {
+		stream.ContentType = "application/json"
+		stream.WriteBody = func(writer io.Writer) error {
+			return json.NewEncoder(writer).Encode(interestStats)
+		}
+	}
+
+	return &stream, nil
+}
@@ -4,13 +4,42 @@ import (
 	interests2 "chat_app_backend/application/handlers/shared/interests"
 	interests "chat_app_backend/application/models/interests/get"
 	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/pagination"
 	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/search"
 	"chat_app_backend/internal/service_wrapper"
 	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
+const defaultInterestsPageSize int32 = 50
+
+// interestsFilter has the parameters of every listing query, each query takes the ones it needs.
+type interestsFilter struct {
+	Ids                  []extensions.UUID
+	Search               *string
+	CategoryID           *extensions.UUID
+	IncludeSubcategories bool
+	AfterID              *extensions.UUID
+	AfterTitle           *string
+	AfterCreatedAt       *time.Time
+	AfterPopularity      *int64
+	AfterPosition        *int32
+	MaxCount             int32
+}
+
+type listedInterest struct {
+	Interest   db_queries.Interest
+	Popularity int64
+}
+
 type GetInterestsHandler struct{}
 
 func (g GetInterestsHandler) Handle(
@@ -19,21 +48,226 @@ func (g GetInterestsHandler) Handle(
 	ctx *gin.Context,
 	_ *request_env.RequestEnv,
 ) (*interests.GetInterestsResponseDto, exceptions.ITrackableException) {
-	rawInterests, dbError := service.GetDbConnection().
-		GetQueries().
-		GetManyInterestsByFilters(ctx, db_queries.GetManyInterestsByFiltersParams{
-			Ids:  request.Ids,
-			Name: request.Name,
-		})
+	// the interests are ordered within their categories, so the categories are listed in that order
+	sortBy := interests.SortByTitle
+	if request.CategoryID != nil || request.Tree {
+		sortBy = interests.SortByPosition
+	}
+	if request.SortBy != nil {
+		sortBy = *request.SortBy
+	}
+
+	descending := request.Order != nil && *request.Order == interests.OrderDesc
+
+	limit := defaultInterestsPageSize
+	if request.Limit != nil {
+		limit = *request.Limit
+	}
+
+	filter := interestsFilter{
+		Ids:                  request.Ids,
+		CategoryID:           request.CategoryID,
+		IncludeSubcategories: request.IncludeSubcategories,
+		// one more interest is read to know whether there is a next page
+		MaxCount: limit + 1,
+	}
+
+	searchText := request.Search
+	if searchText == nil {
+		searchText = request.Name
+	}
+
+	if searchText != nil {
+		filter.Search = search.PrefixQuery(*searchText)
+	}
+
+	if request.Cursor != nil {
+		if cursorError := applyInterestsCursor(&filter, *request.Cursor, sortBy, descending); cursorError != nil {
+			return nil, cursorError
+		}
+	}
+
+	rows, queryError := listInterests(ctx, service.GetDbConnection().GetQueries(), filter, sortBy, descending)
+	if queryError != nil {
+		return nil, queryError
+	}
+
+	pageInfo := interests.PaginationDto{Limit: limit}
+	if int32(len(rows)) > limit {
+		rows = rows[:limit]
 
-	if dbError != nil {
-		return nil, exceptions.WrapErrorWithTrackableException(dbError)
+		nextCursor, cursorError := createInterestsCursor(rows[len(rows)-1], sortBy, descending)
+		if cursorError != nil {
+			return nil, cursorError
+		}
+
+		pageInfo.HasMore = true
+		pageInfo.NextCursor = &nextCursor
 	}
 
-	mappedInterests, err := interests2.GetInterestIcons(rawInterests, service.GetS3Client(), ctx)
+	rawInterests := make([]db_queries.Interest, len(rows))
+	for idx, row := range rows {
+		rawInterests[idx] = row.Interest
+	}
+
+	mappedInterests, err := interests2.GetInterestIcons(rawInterests, service, ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &interests.GetInterestsResponseDto{Interests: mappedInterests}, nil
+	if localizationError := interests2.LocalizeInterests(mappedInterests, request.AllTranslations, service, ctx); localizationError != nil {
+		return nil, localizationError
+	}
+
+	if !request.Tree {
+		return &interests.GetInterestsResponseDto{Interests: mappedInterests, Pagination: pageInfo}, nil
+	}
+
+	categories, categoriesDbError := service.GetDbConnection().GetQueries().GetInterestCategories(ctx)
+	if categoriesDbError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(categoriesDbError)
+	}
+
+	tree, uncategorized := interests2.BuildCategoryTree(categories, mappedInterests)
+
+	return &interests.GetInterestsResponseDto{
+		Interests:  uncategorized,
+		Categories: tree,
+		Pagination: pageInfo,
+	}, nil
+}
+
+func listInterests(
+	ctx context.Context,
+	queries *db_queries.Queries,
+	filter interestsFilter,
+	sortBy string,
+	descending bool,
+) ([]listedInterest, exceptions.ITrackableException) {
+	switch {
+	case sortBy == interests.SortByCreatedAt && descending:
+		return queryInterests(ctx, filter, queries.GetInterestsByCreatedAtDesc)
+	case sortBy == interests.SortByCreatedAt:
+		return queryInterests(ctx, filter, queries.GetInterestsByCreatedAt)
+	case sortBy == interests.SortByPopularity && descending:
+		return queryInterests(ctx, filter, queries.GetInterestsByPopularityDesc)
+	case sortBy == interests.SortByPopularity:
+		return queryInterests(ctx, filter, queries.GetInterestsByPopularity)
+	case sortBy == interests.SortByPosition && descending:
+		return queryInterests(ctx, filter, queries.GetInterestsByPositionDesc)
+	case sortBy == interests.SortByPosition:
+		return queryInterests(ctx, filter, queries.GetInterestsByPosition)
+	case descending:
+		return queryInterests(ctx, filter, queries.GetInterestsByTitleDesc)
+	default:
+		return queryInterests(ctx, filter, queries.GetInterestsByTitle)
+	}
+}
+
+// queryInterests runs the listing query of a sort, the queries differ only in the order and the cursor,
+// so their parameters and rows are mapped from and to the same structs.
+func queryInterests[TParams interface{}, TRow interface{}](
+	ctx context.Context,
+	filter interestsFilter,
+	query func(context.Context, TParams) ([]TRow, error),
+) ([]listedInterest, exceptions.ITrackableException) {
+	var params TParams
+	if paramsMappingError := (mapper.Mapper{}).Map(&params, filter); paramsMappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(paramsMappingError)
+	}
+
+	rows, queryError := query(ctx, params)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	listed := make([]listedInterest, len(rows))
+	for idx, row := range rows {
+		if rowMappingError := (mapper.Mapper{}).Map(&listed[idx], row); rowMappingError != nil {
+			return nil, exceptions.WrapErrorWithTrackableException(rowMappingError)
+		}
+	}
+
+	return listed, nil
+}
+
+func applyInterestsCursor(
+	filter *interestsFilter,
+	encodedCursor string,
+	sortBy string,
+	descending bool,
+) exceptions.ITrackableException {
+	cursor, decodingError := pagination.DecodeCursor(encodedCursor, sortBy, descending)
+	if decodingError != nil {
+		return invalidCursorException(decodingError)
+	}
+
+	filter.AfterID = &cursor.ID
+
+	switch sortBy {
+	case interests.SortByTitle:
+		filter.AfterTitle = &cursor.Value
+	case interests.SortByCreatedAt:
+		createdAt, parsingError := time.Parse(time.RFC3339Nano, cursor.Value)
+		if parsingError != nil {
+			return invalidCursorException(parsingError)
+		}
+		filter.AfterCreatedAt = &createdAt
+	case interests.SortByPopularity:
+		popularity, parsingError := strconv.ParseInt(cursor.Value, 10, 64)
+		if parsingError != nil {
+			return invalidCursorException(parsingError)
+		}
+		filter.AfterPopularity = &popularity
+	case interests.SortByPosition:
+		position, parsingError := strconv.ParseInt(cursor.Value, 10, 32)
+		if parsingError != nil {
+			return invalidCursorException(parsingError)
+		}
+		afterPosition := int32(position)
+		filter.AfterPosition = &afterPosition
+		filter.AfterTitle = &cursor.Secondary
+	}
+
+	return nil
+}
+
+func createInterestsCursor(
+	lastRow listedInterest,
+	sortBy string,
+	descending bool,
+) (string, exceptions.ITrackableException) {
+	cursor := pagination.Cursor{
+		SortBy:     sortBy,
+		Descending: descending,
+		ID:         lastRow.Interest.ID,
+	}
+
+	switch sortBy {
+	case interests.SortByTitle:
+		cursor.Value = lastRow.Interest.Title
+	case interests.SortByCreatedAt:
+		cursor.Value = lastRow.Interest.CreatedAt.Format(time.RFC3339Nano)
+	case interests.SortByPopularity:
+		cursor.Value = strconv.FormatInt(lastRow.Popularity, 10)
+	case interests.SortByPosition:
+		cursor.Value = strconv.FormatInt(int64(lastRow.Interest.Position), 10)
+		cursor.Secondary = lastRow.Interest.Title
+	}
+
+	encodedCursor, encodingError := cursor.Encode()
+	if encodingError != nil {
+		return "", exceptions.WrapErrorWithTrackableException(encodingError)
+	}
+
+	return encodedCursor, nil
+}
+
+func invalidCursorException(err error) exceptions.ITrackableException {
+	return common_exceptions.InvalidBodyException{
+		BaseRestException: exceptions.BaseRestException{
+			ITrackableException: exceptions.WrapErrorWithTrackableException(err),
+			Message:             "cursor is invalid",
+		},
+	}
 }
@@ -0,0 +1,49 @@
+package interests
+
+import (
+	"chat_app_backend/application/application_config"
+	"chat_app_backend/application/models/interests/get_translations"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/locale"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetInterestTranslationsHandler struct{}
+
+func (g GetInterestTranslationsHandler) Handle(
+	request *get_translations.GetInterestTranslationsRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*get_translations.GetInterestTranslationsResponseDto, exceptions.ITrackableException) {
+	localizationConfig, configError := services.GetConfiguration().Get(&application_config.LocalizationConfig{})
+	if configError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(configError)
+	}
+
+	translations, queryError := services.GetDbConnection().
+		GetQueries().
+		GetInterestTranslations(ctx, []extensions.UUID{request.ID})
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	response := get_translations.GetInterestTranslationsResponseDto{
+		InterestID:    request.ID,
+		DefaultLocale: locale.Normalize(localizationConfig.(*application_config.LocalizationConfig).DefaultLocale),
+		Translations:  make([]get_translations.InterestTranslationResponseDto, len(translations)),
+	}
+
+	for idx, translation := range translations {
+		if mappingError := (mapper.Mapper{}).Map(&response.Translations[idx], translation); mappingError != nil {
+			return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+		}
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,479 @@
+package interests
+
+import (
+	"bytes"
+	interests_validators "chat_app_backend/application/controllers/validators/interests"
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	shared_images "chat_app_backend/application/handlers/shared/images"
+	shared_webhooks "chat_app_backend/application/handlers/shared/webhooks"
+	"chat_app_backend/application/models/interests/create"
+	"chat_app_backend/application/models/interests/import_interests"
+	"chat_app_backend/application/models/webhooks/events"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/images"
+	"chat_app_backend/internal/interest_archive"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/s3"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/validator"
+	"chat_app_backend/internal/webhooks"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"mime/multipart"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// the limit is for the extracted files, so a small archive can't be expanded into memory without bounds
+const maxImportedArchiveSize int64 = 256 << 20
+
+// importedInterest is a valid row of the manifest together with the interest it matches by title.
+type importedInterest struct {
+	result   *import_interests.ImportedInterestDto
+	row      interest_archive.Row
+	icon     []byte
+	existing *db_queries.Interest
+	// iconChanged is set when the processed icon differs from the stored one, new interests always get one
+	iconChanged bool
+}
+
+type ImportInterestsHandler struct{}
+
+// Handle upserts the interests of the archive by title. Every row is validated with the rules of the
+// interest creation before anything is changed, and running the same import again changes nothing.
+func (i ImportInterestsHandler) Handle(
+	request *import_interests.ImportInterestsRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*import_interests.ImportInterestsResponseDto, exceptions.ITrackableException) {
+	archive, archiveError := readImportedArchive(request.Archive)
+	if archiveError != nil {
+		return nil, archiveError
+	}
+
+	titles := make([]string, len(archive.Rows))
+	for idx, row := range archive.Rows {
+		titles[idx] = row.Title
+	}
+
+	storedInterests, queryError := services.GetDbConnection().GetQueries().GetInterestsByTitles(ctx, titles)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	// titles aren't unique in the database, the oldest interest is the one updated by the import
+	interestsByTitle := make(map[string]db_queries.Interest, len(storedInterests))
+	for _, interest := range storedInterests {
+		if _, found := interestsByTitle[interest.Title]; !found {
+			interestsByTitle[interest.Title] = interest
+		}
+	}
+
+	response := import_interests.ImportInterestsResponseDto{
+		DryRun: request.DryRun,
+		Rows:   make([]import_interests.ImportedInterestDto, len(archive.Rows)),
+	}
+
+	importedInterests := make([]importedInterest, 0, len(archive.Rows))
+	seenTitles := make(map[string]struct{}, len(archive.Rows))
+	valid := true
+
+	for idx, row := range archive.Rows {
+		result := &response.Rows[idx]
+		result.Row = int32(idx + 1)
+		result.Title = row.Title
+		result.Errors = validateImportedRow(ctx, services, *requestEnvironment, row, archive.Files)
+
+		if _, repeated := seenTitles[row.Title]; repeated {
+			result.Errors = append(result.Errors, "title is repeated in the manifest")
+		}
+		seenTitles[row.Title] = struct{}{}
+
+		if len(result.Errors) != 0 {
+			valid = false
+			continue
+		}
+
+		imported := importedInterest{
+			result:      result,
+			row:         row,
+			icon:        archive.Files[path.Clean(row.Icon)],
+			iconChanged: true,
+		}
+
+		if interest, found := interestsByTitle[row.Title]; found {
+			imported.existing = &interest
+			result.InterestID = &interest.ID
+		}
+
+		iconError, comparisonError := compareImportedInterest(ctx, services, &imported)
+		if comparisonError != nil {
+			return nil, comparisonError
+		}
+
+		if iconError != nil {
+			result.Errors = append(result.Errors, *iconError)
+			valid = false
+			continue
+		}
+
+		switch result.Action {
+		case import_interests.ActionCreated:
+			response.Created++
+		case import_interests.ActionUpdated:
+			response.Updated++
+		default:
+			response.Unchanged++
+		}
+
+		importedInterests = append(importedInterests, imported)
+	}
+
+	if !valid || request.DryRun {
+		return &response, nil
+	}
+
+	if applyingError := applyImportedInterests(ctx, services, requestEnvironment, importedInterests); applyingError != nil {
+		return nil, applyingError
+	}
+
+	response.Applied = true
+	return &response, nil
+}
+
+func readImportedArchive(fileHeader *multipart.FileHeader) (*interest_archive.Archive, exceptions.ITrackableException) {
+	file, openingError := fileHeader.Open()
+	if openingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(openingError)
+	}
+
+	defer func(file multipart.File) {
+		_ = file.Close()
+	}(file)
+
+	data, readingError := io.ReadAll(file)
+	if readingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(readingError)
+	}
+
+	archive, archiveError := interest_archive.Read(bytes.NewReader(data), int64(len(data)), maxImportedArchiveSize)
+	if archiveError == nil && len(archive.Rows) == 0 {
+		archiveError = errors.New("manifest has no interests")
+	}
+
+	if archiveError != nil {
+		return nil, common_exceptions.InvalidBodyException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.WrapErrorWithTrackableException(archiveError),
+				Message:             archiveError.Error(),
+			},
+		}
+	}
+
+	return archive, nil
+}
+
+// validateImportedRow applies the rules of the interest creation route to the row, the icon is read from
+// the archive instead of the form.
+func validateImportedRow(
+	ctx *gin.Context,
+	services service_wrapper.IServiceWrapper,
+	env request_env.RequestEnv,
+	row interest_archive.Row,
+	files map[string][]byte,
+) []string {
+	rowErrors := make([]string, 0)
+
+	icon, iconFound := files[path.Clean(row.Icon)]
+
+	// the placeholder only marks the icon as present for the tag validation, its content is checked below
+	creationRequest := create.CreateInterestRequestDto{
+		Title:       row.Title,
+		Description: row.Description,
+		CategoryID:  row.CategoryID,
+		Position:    row.Position,
+	}
+	if iconFound {
+		creationRequest.Icon = &multipart.FileHeader{Filename: path.Base(row.Icon), Size: int64(len(icon))}
+	}
+
+	if validationError := (validator.Validator[create.CreateInterestRequestDto]{}).Validate(&creationRequest, ctx, env); validationError != nil {
+		// the first line is the common header of the validation errors
+		rowErrors = append(rowErrors, strings.Split(validationError.Error(), "\n")[1:]...)
+	}
+
+	switch {
+	case !iconFound:
+		rowErrors = append(rowErrors, fmt.Sprintf("icon %s is not in the archive", row.Icon))
+	case !(interests_validators.IconDataValidator{}).Validate(&icon, ctx, env):
+		rowErrors = append(rowErrors, "invalid file type")
+	}
+
+	categoryValidator := interests_validators.InterestCategoryExistenceValidator{Db: services.GetDbConnection()}
+	if row.CategoryID != nil && !categoryValidator.Validate(row.CategoryID, ctx, env) {
+		rowErrors = append(rowErrors, "category with provided id does not exist")
+	}
+
+	return rowErrors
+}
+
+// compareImportedInterest sets the action of the row. The icon is compared after the processing, which
+// is deterministic, so an exported icon imported back matches the stored one. The icons, which can't be
+// processed, are reported as an error of the row.
+func compareImportedInterest(
+	ctx *gin.Context,
+	services service_wrapper.IServiceWrapper,
+	imported *importedInterest,
+) (*string, exceptions.ITrackableException) {
+	processed, processingError := services.GetImageProcessor().Process(imported.icon, s3.Png, s3.Svg)
+
+	switch {
+	case errors.Is(processingError, images.ErrUnsupportedFormat):
+		message := processingError.Error()
+		return &message, nil
+	case processingError != nil:
+		return nil, exceptions.WrapErrorWithTrackableException(processingError)
+	}
+
+	if imported.existing == nil {
+		imported.result.Action = import_interests.ActionCreated
+		return nil, nil
+	}
+
+	storedIcon, downloadError := downloadInterestIcon(ctx, services, imported.existing.IconFileName)
+	if downloadError != nil {
+		return nil, downloadError
+	}
+
+	imported.iconChanged = !bytes.Equal(storedIcon, processed.Original.Data)
+
+	sameCategory := (imported.row.CategoryID == nil && imported.existing.CategoryID == nil) ||
+		(imported.row.CategoryID != nil && imported.existing.CategoryID != nil && *imported.row.CategoryID == *imported.existing.CategoryID)
+
+	if !imported.iconChanged &&
+		sameCategory &&
+		imported.row.Description == imported.existing.Description &&
+		imported.row.Position == imported.existing.Position {
+		imported.result.Action = import_interests.ActionUnchanged
+		return nil, nil
+	}
+
+	imported.result.Action = import_interests.ActionUpdated
+	return nil, nil
+}
+
+// applyImportedInterests uploads the changed icons first and then writes all the interests in one
+// transaction, so a failed import leaves at most some orphaned icons, which are removed right away.
+func applyImportedInterests(
+	ctx *gin.Context,
+	services service_wrapper.IServiceWrapper,
+	requestEnvironment *request_env.RequestEnv,
+	importedInterests []importedInterest,
+) exceptions.ITrackableException {
+	uploadedIcons := make(map[int]string)
+	uploadedThumbnailSizes := make(map[int][]int32)
+	replacedIcons := make([]string, 0)
+
+	removeIcons := func(fileNames []string) {
+		for _, fileName := range fileNames {
+			if removeError := shared_images.RemoveImage(ctx, services, fileName, s3.InterestsIconBucket); removeError != nil {
+				services.GetLogger().CreateErrorMessage(removeError).Log()
+			}
+		}
+	}
+
+	for idx, imported := range importedInterests {
+		if imported.result.Action == import_interests.ActionUnchanged || !imported.iconChanged {
+			continue
+		}
+
+		icon, uploadError := shared_images.StoreImage(ctx, services, imported.icon, s3.InterestsIconBucket, s3.Png, s3.Svg)
+		if uploadError != nil {
+			removeIcons(slices.Collect(maps.Values(uploadedIcons)))
+			return uploadError
+		}
+
+		uploadedIcons[idx] = icon.FileName
+		uploadedThumbnailSizes[idx] = icon.ThumbnailSizes
+		if imported.existing != nil {
+			replacedIcons = append(replacedIcons, imported.existing.IconFileName)
+		}
+	}
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			for idx, imported := range importedInterests {
+				var writingError exceptions.ITrackableException
+
+				switch imported.result.Action {
+				case import_interests.ActionCreated:
+					writingError = createImportedInterest(
+						ctx,
+						queries,
+						requestEnvironment,
+						imported,
+						uploadedIcons[idx],
+						uploadedThumbnailSizes[idx],
+					)
+				case import_interests.ActionUpdated:
+					iconFileName, iconChanged := uploadedIcons[idx]
+					if !iconChanged {
+						iconFileName = imported.existing.IconFileName
+					}
+					// the sizes stay unchanged together with the icon
+					writingError = updateImportedInterest(
+						ctx,
+						queries,
+						requestEnvironment,
+						imported,
+						iconFileName,
+						uploadedThumbnailSizes[idx],
+					)
+				}
+
+				if writingError != nil {
+					return writingError
+				}
+			}
+
+			return nil
+		})
+	if transactionError != nil {
+		removeIcons(slices.Collect(maps.Values(uploadedIcons)))
+		return transactionError
+	}
+
+	removeIcons(replacedIcons)
+	return nil
+}
+
+func createImportedInterest(
+	ctx *gin.Context,
+	queries *db_queries.Queries,
+	requestEnvironment *request_env.RequestEnv,
+	imported importedInterest,
+	iconFileName string,
+	iconThumbnailSizes []int32,
+) exceptions.ITrackableException {
+	interest, creationError := queries.CreateInterest(ctx, db_queries.CreateInterestParams{
+		Title:              imported.row.Title,
+		IconFileName:       iconFileName,
+		IconThumbnailSizes: iconThumbnailSizes,
+		Description:        imported.row.Description,
+		CategoryID:         imported.row.CategoryID,
+		Position:           imported.row.Position,
+	})
+	if creationError != nil {
+		return exceptions.WrapErrorWithTrackableException(creationError)
+	}
+
+	imported.result.InterestID = &interest.ID
+
+	publishingError := shared_webhooks.PublishEvent(
+		ctx,
+		queries,
+		webhooks.EventInterestCreated,
+		events.InterestEventDto{
+			ID:          interest.ID,
+			Title:       interest.Title,
+			Description: interest.Description,
+		},
+	)
+	if publishingError != nil {
+		return publishingError
+	}
+
+	return shared_audit.Record(
+		ctx,
+		queries,
+		shared_audit.ActorFromEnvironment(requestEnvironment),
+		shared_audit.Entry{
+			Action:     audit.ActionInterestCreated,
+			TargetType: audit.TargetInterest,
+			TargetID:   interest.ID.String(),
+			After:      interest,
+		},
+	)
+}
+
+func updateImportedInterest(
+	ctx *gin.Context,
+	queries *db_queries.Queries,
+	requestEnvironment *request_env.RequestEnv,
+	imported importedInterest,
+	iconFileName string,
+	iconThumbnailSizes []int32,
+) exceptions.ITrackableException {
+	position := imported.row.Position
+
+	interest, updateError := queries.UpdateInterest(ctx, db_queries.UpdateInterestParams{
+		Description:        &imported.row.Description,
+		IconFileName:       &iconFileName,
+		IconThumbnailSizes: iconThumbnailSizes,
+		ClearCategory:      imported.row.CategoryID == nil,
+		CategoryID:         imported.row.CategoryID,
+		Position:           &position,
+		ID:                 imported.existing.ID,
+	})
+	if updateError != nil {
+		return exceptions.WrapErrorWithTrackableException(updateError)
+	}
+
+	publishingError := shared_webhooks.PublishEvent(
+		ctx,
+		queries,
+		webhooks.EventInterestUpdated,
+		events.InterestEventDto{
+			ID:          interest.ID,
+			Title:       interest.Title,
+			Description: interest.Description,
+		},
+	)
+	if publishingError != nil {
+		return publishingError
+	}
+
+	return shared_audit.Record(
+		ctx,
+		queries,
+		shared_audit.ActorFromEnvironment(requestEnvironment),
+		shared_audit.Entry{
+			Action:     audit.ActionInterestUpdated,
+			TargetType: audit.TargetInterest,
+			TargetID:   interest.ID.String(),
+			Before:     *imported.existing,
+			After:      interest,
+		},
+	)
+}
+
+func downloadInterestIcon(
+	ctx *gin.Context,
+	services service_wrapper.IServiceWrapper,
+	fileName string,
+) ([]byte, exceptions.ITrackableException) {
+	file, downloadError := services.GetS3Client().GetFile(ctx, fileName, s3.InterestsIconBucket)
+	if downloadError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(downloadError)
+	}
+
+	defer func(file io.ReadCloser) {
+		_ = file.Close()
+	}(file)
+
+	data, readingError := io.ReadAll(file)
+	if readingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(readingError)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,41 @@
+package interests
+
+import (
+	"chat_app_backend/application/models/interests/remove_interests"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RemoveInterestsHandler struct{}
+
+func (r RemoveInterestsHandler) Handle(
+	request *remove_interests.RemoveInterestsRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*remove_interests.RemoveInterestsResponseDto, exceptions.ITrackableException) {
+	if accessError := checkUserInterestsAccess(requestEnvironment, request.UserID); accessError != nil {
+		return nil, accessError
+	}
+
+	userInterests, changeError := changeUserInterests(
+		ctx,
+		services,
+		request.UserID,
+		func(queries *db_queries.Queries) error {
+			return queries.RemoveSelectedUserInterests(ctx, db_queries.RemoveSelectedUserInterestsParams{
+				UserID:      request.UserID,
+				InterestIds: request.InterestIds,
+			})
+		},
+	)
+	if changeError != nil {
+		return nil, changeError
+	}
+
+	return &remove_interests.RemoveInterestsResponseDto{Interests: userInterests}, nil
+}
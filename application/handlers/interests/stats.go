@@ -0,0 +1,46 @@
+package interests
+
+import (
+	shared_interests "chat_app_backend/application/handlers/shared/interests"
+	"chat_app_backend/application/models/interests/stats"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetInterestStatsHandler struct{}
+
+func (g GetInterestStatsHandler) Handle(
+	request *stats.GetInterestStatsRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*stats.GetInterestStatsResponseDto, exceptions.ITrackableException) {
+	limit := request.Limit
+
+	rows, queryError := services.GetDbConnection().GetQueries().GetInterestStats(
+		ctx,
+		db_queries.GetInterestStatsParams{
+			WindowStart: shared_interests.StatsWindowStart(time.Now(), request.WindowDays),
+			SkipCount:   request.Offset,
+			MaxCount:    &limit,
+		},
+	)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	response := stats.GetInterestStatsResponseDto{
+		WindowDays: request.WindowDays,
+		Interests:  make([]stats.InterestStatsResponseDto, len(rows)),
+	}
+	for idx, row := range rows {
+		response.Interests[idx] = shared_interests.CreateInterestStats(row.ID, row.Title, row.UsersCount, row.Added, row.Removed)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,44 @@
+package interests
+
+import (
+	shared_interests "chat_app_backend/application/handlers/shared/interests"
+	"chat_app_backend/application/models/interests/stats"
+	"chat_app_backend/application/models/interests/trending"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetTrendingInterestsHandler struct{}
+
+func (g GetTrendingInterestsHandler) Handle(
+	request *trending.GetTrendingInterestsRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*trending.GetTrendingInterestsResponseDto, exceptions.ITrackableException) {
+	rows, queryError := services.GetDbConnection().GetQueries().GetTrendingInterests(
+		ctx,
+		db_queries.GetTrendingInterestsParams{
+			WindowStart: shared_interests.StatsWindowStart(time.Now(), request.WindowDays),
+			MaxCount:    request.Limit,
+		},
+	)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	response := trending.GetTrendingInterestsResponseDto{
+		WindowDays: request.WindowDays,
+		Interests:  make([]stats.InterestStatsResponseDto, len(rows)),
+	}
+	for idx, row := range rows {
+		response.Interests[idx] = shared_interests.CreateInterestStats(row.ID, row.Title, row.UsersCount, row.Added, row.Removed)
+	}
+
+	return &response, nil
+}
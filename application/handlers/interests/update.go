@@ -1,7 +1,14 @@
 package interests
 
 import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	shared_images "chat_app_backend/application/handlers/shared/images"
+	shared_interests "chat_app_backend/application/handlers/shared/interests"
+	shared_webhooks "chat_app_backend/application/handlers/shared/webhooks"
 	"chat_app_backend/application/models/interests/update"
+	"chat_app_backend/application/models/webhooks/events"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/etag"
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/exceptions/common_exceptions"
 	"chat_app_backend/internal/mapper"
@@ -9,8 +16,9 @@ import (
 	"chat_app_backend/internal/s3"
 	"chat_app_backend/internal/service_wrapper"
 	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/webhooks"
 	"errors"
-	"fmt"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5"
@@ -49,34 +57,29 @@ func (u UpdateInterestsHandler) Handle(
 		return nil, exceptions.WrapErrorWithTrackableException(getInterestDbError)
 	}
 
-	var downloadLink string
-	newFileName := interest.IconFileName
+	var icon *shared_images.StoredImage
 
 	if request.Icon != nil {
-		fileUUID, oldFileType, _ := s3.DeconstructFileName(interest.IconFileName)
-		_, newFileType, _ := s3.DeconstructFileName(request.Icon.Filename)
-
-		if oldFileType != newFileType {
-			newFileName = fmt.Sprintf("%s.%s", fileUUID, newFileType)
+		uploadedIcon, iconUploadError := shared_images.UploadImage(
+			ctx,
+			services,
+			request.Icon,
+			s3.InterestsIconBucket,
+			s3.Png,
+			s3.Svg,
+		)
+		if iconUploadError != nil {
+			return nil, iconUploadError
 		}
 
-		newDownloadLink, uploadError := services.GetS3Client().
-			ModifyFileContents(ctx, request.Icon, interest.IconFileName, newFileName, s3.InterestsIconBucket)
-
-		if uploadError != nil {
-			return nil, exceptions.WrapErrorWithTrackableException(uploadError)
-		}
-
-		downloadLink = newDownloadLink
+		icon = uploadedIcon
 	} else {
-		storedDownloadLink, uploadError := services.GetS3Client().
-			GetDownloadUrl(ctx, interest.IconFileName, s3.InterestsIconBucket)
-
-		if uploadError != nil {
-			return nil, exceptions.WrapErrorWithTrackableException(uploadError)
+		storedIcon, iconGetError := shared_interests.GetInterestIcon(ctx, services, interest)
+		if iconGetError != nil {
+			return nil, iconGetError
 		}
 
-		downloadLink = storedDownloadLink
+		icon = storedIcon
 	}
 
 	var updateParams db_queries.UpdateInterestParams
@@ -85,9 +88,15 @@ func (u UpdateInterestsHandler) Handle(
 		&updateParams,
 		*request,
 		struct {
-			IconFileName *string
+			IconFileName       *string
+			IconThumbnailSizes []int32
+			ExpectedVersions   []time.Time
 		}{
-			IconFileName: &newFileName,
+			IconFileName: &icon.FileName,
+			// the sizes found in the storage are kept for the icons stored before the sizes were recorded
+			IconThumbnailSizes: icon.ThumbnailSizes,
+			// the version is checked by the update itself, so the concurrent updates can't overwrite each other
+			ExpectedVersions: etag.ExpectedVersions(ctx),
 		},
 	)
 
@@ -95,23 +104,79 @@ func (u UpdateInterestsHandler) Handle(
 		return nil, exceptions.WrapErrorWithTrackableException(updateParamsMappingError)
 	}
 
-	newInterest, descriptionUpdateError := services.GetDbConnection().
-		GetQueries().
-		UpdateInterest(ctx, updateParams)
+	var newInterest db_queries.Interest
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			updatedInterest, descriptionUpdateError := queries.UpdateInterest(ctx, updateParams)
+			switch {
+			case errors.Is(descriptionUpdateError, pgx.ErrNoRows):
+				return common_exceptions.PreconditionFailedException{
+					BaseRestException: exceptions.BaseRestException{
+						ITrackableException: exceptions.WrapErrorWithTrackableException(descriptionUpdateError),
+						Message:             "interest was changed since it was read",
+					},
+				}
+			case descriptionUpdateError != nil:
+				return exceptions.WrapErrorWithTrackableException(descriptionUpdateError)
+			}
+
+			newInterest = updatedInterest
+
+			publishingError := shared_webhooks.PublishEvent(
+				ctx,
+				queries,
+				webhooks.EventInterestUpdated,
+				events.InterestEventDto{
+					ID:          newInterest.ID,
+					Title:       newInterest.Title,
+					Description: newInterest.Description,
+				},
+			)
+			if publishingError != nil {
+				return publishingError
+			}
+
+			return shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				shared_audit.Entry{
+					Action:     audit.ActionInterestUpdated,
+					TargetType: audit.TargetInterest,
+					TargetID:   newInterest.ID.String(),
+					Before:     interest,
+					After:      newInterest,
+				},
+			)
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
 
-	if descriptionUpdateError != nil {
-		return nil, exceptions.WrapErrorWithTrackableException(descriptionUpdateError)
+	if request.Icon != nil {
+		if removeError := shared_images.RemoveImage(ctx, services, interest.IconFileName, s3.InterestsIconBucket); removeError != nil {
+			services.GetLogger().
+				CreateErrorMessage(removeError).
+				Log()
+		}
 	}
 
+	etag.Set(ctx, newInterest.UpdatedAt)
+
 	var result update.UpdateInterestResponseDto
 
 	resultMappingError := mapper.Mapper{}.Map(
 		&result,
 		newInterest,
 		struct {
-			IconDownloadLink string
+			IconDownloadLink   string
+			IconThumbnailLinks map[string]string
+			ETag               string
 		}{
-			IconDownloadLink: downloadLink,
+			IconDownloadLink:   icon.DownloadLink,
+			IconThumbnailLinks: icon.ThumbnailLinks,
+			ETag:               etag.FromVersion(newInterest.UpdatedAt),
 		},
 	)
 
@@ -0,0 +1,89 @@
+package interests
+
+import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	"chat_app_backend/application/models/interests/upsert_translation"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/locale"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+)
+
+type UpsertInterestTranslationHandler struct{}
+
+func (u UpsertInterestTranslationHandler) Handle(
+	request *upsert_translation.UpsertInterestTranslationRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*upsert_translation.UpsertInterestTranslationResponseDto, exceptions.ITrackableException) {
+	translationLocale := locale.Normalize(request.Locale)
+
+	var translation db_queries.InterestTranslation
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			// the translation is created when there is no previous one, so there is nothing before it
+			var previousTranslation interface{}
+
+			storedTranslation, translationQueryError := queries.GetInterestTranslation(
+				ctx,
+				db_queries.GetInterestTranslationParams{
+					InterestID: request.ID,
+					Locale:     translationLocale,
+				},
+			)
+			switch {
+			case errors.Is(translationQueryError, pgx.ErrNoRows):
+			case translationQueryError != nil:
+				return exceptions.WrapErrorWithTrackableException(translationQueryError)
+			default:
+				previousTranslation = storedTranslation
+			}
+
+			upsertedTranslation, upsertError := queries.UpsertInterestTranslation(
+				ctx,
+				db_queries.UpsertInterestTranslationParams{
+					InterestID:  request.ID,
+					Locale:      translationLocale,
+					Title:       request.Title,
+					Description: request.Description,
+				},
+			)
+			if upsertError != nil {
+				return exceptions.WrapErrorWithTrackableException(upsertError)
+			}
+
+			translation = upsertedTranslation
+
+			return shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				shared_audit.Entry{
+					Action:     audit.ActionInterestTranslationSet,
+					TargetType: audit.TargetInterest,
+					TargetID:   request.ID.String(),
+					Before:     previousTranslation,
+					After:      translation,
+				},
+			)
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	var response upsert_translation.UpsertInterestTranslationResponseDto
+	if mappingError := (mapper.Mapper{}).Map(&response, translation); mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
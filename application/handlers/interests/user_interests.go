@@ -0,0 +1,103 @@
+package interests
+
+import (
+	shared_interests "chat_app_backend/application/handlers/shared/interests"
+	shared_webhooks "chat_app_backend/application/handlers/shared/webhooks"
+	"chat_app_backend/application/models/interests/get"
+	"chat_app_backend/application/models/webhooks/events"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/webhooks"
+
+	"github.com/gin-gonic/gin"
+)
+
+func checkUserInterestsAccess(requestEnvironment *request_env.RequestEnv, userId extensions.UUID) exceptions.ITrackableException {
+	if requestEnvironment.User.Role == db_queries.RoleTypeADMIN || userId == requestEnvironment.User.ID {
+		return nil
+	}
+
+	return &common_exceptions.ForbiddenException{
+		BaseRestException: exceptions.BaseRestException{
+			ITrackableException: exceptions.CreateTrackableExceptionFromStringF("user interests update forbidden"),
+			Message:             "not enough privileges to update this user",
+		},
+	}
+}
+
+// changeUserInterests applies the change under the lock of the user, so concurrent changes of the same
+// user can't exceed the limit of the interests together, and returns the interests the user has after it.
+// The limit is checked only when the change grows the interests, so the users above it can remove them.
+func changeUserInterests(
+	ctx *gin.Context,
+	services service_wrapper.IServiceWrapper,
+	userId extensions.UUID,
+	change func(queries *db_queries.Queries) error,
+) ([]get.GetInterestResponseDto, exceptions.ITrackableException) {
+	var userInterests []db_queries.Interest
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			if lockError := queries.LockUserInterests(ctx, userId); lockError != nil {
+				return exceptions.WrapErrorWithTrackableException(lockError)
+			}
+
+			countBefore, countError := queries.CountUserInterests(ctx, userId)
+			if countError != nil {
+				return exceptions.WrapErrorWithTrackableException(countError)
+			}
+
+			if changeError := change(queries); changeError != nil {
+				return exceptions.WrapErrorWithTrackableException(changeError)
+			}
+
+			countAfter, countError := queries.CountUserInterests(ctx, userId)
+			if countError != nil {
+				return exceptions.WrapErrorWithTrackableException(countError)
+			}
+
+			if limitError := shared_interests.CheckInterestsChange(services, countBefore, countAfter); limitError != nil {
+				return limitError
+			}
+
+			storedInterests, queryError := queries.GetUserInterests(ctx, userId)
+			if queryError != nil {
+				return exceptions.WrapErrorWithTrackableException(queryError)
+			}
+
+			userInterests = storedInterests
+
+			interestIds := make([]extensions.UUID, len(userInterests))
+			for idx, interest := range userInterests {
+				interestIds[idx] = interest.ID
+			}
+
+			return shared_webhooks.PublishEvent(
+				ctx,
+				queries,
+				webhooks.EventUserInterestsUpdated,
+				events.UserInterestsUpdatedEventDto{
+					UserID:      userId,
+					InterestIds: interestIds,
+				},
+			)
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	interestsWithIcons, iconsGetError := shared_interests.GetInterestIcons(userInterests, services, ctx)
+	if iconsGetError != nil {
+		return nil, iconsGetError
+	}
+
+	if localizationError := shared_interests.LocalizeInterests(interestsWithIcons, false, services, ctx); localizationError != nil {
+		return nil, localizationError
+	}
+
+	return interestsWithIcons, nil
+}
@@ -0,0 +1,43 @@
+package service_accounts
+
+import (
+	"chat_app_backend/application/models/service_accounts/create"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CreateServiceAccountHandler struct{}
+
+func (c CreateServiceAccountHandler) Handle(
+	request *create.CreateServiceAccountRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*create.CreateServiceAccountResponseDto, exceptions.ITrackableException) {
+	serviceAccount, creationError := services.GetDbConnection().
+		GetQueries().
+		CreateServiceAccount(
+			ctx,
+			db_queries.CreateServiceAccountParams{
+				Name:        request.Name,
+				Description: request.Description,
+				CreatedBy:   &requestEnvironment.User.ID,
+			},
+		)
+	if creationError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(creationError)
+	}
+
+	var response create.CreateServiceAccountResponseDto
+	mappingError := mapper.Mapper{}.Map(&response, serviceAccount)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,58 @@
+package service_accounts
+
+import (
+	"chat_app_backend/application/models/service_accounts/create_key"
+	"chat_app_backend/internal/api_keys"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CreateApiKeyHandler struct{}
+
+func (c CreateApiKeyHandler) Handle(
+	request *create_key.CreateApiKeyRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*create_key.CreateApiKeyResponseDto, exceptions.ITrackableException) {
+	generatedKey, generationError := api_keys.GenerateKey()
+	if generationError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(generationError)
+	}
+
+	apiKey, creationError := services.GetDbConnection().
+		GetQueries().
+		CreateApiKey(
+			ctx,
+			db_queries.CreateApiKeyParams{
+				ServiceAccountID: request.ServiceAccountID,
+				Prefix:           generatedKey.Prefix,
+				KeyHash:          generatedKey.Hash,
+				Scopes:           request.Scopes,
+			},
+		)
+	if creationError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(creationError)
+	}
+
+	var response create_key.CreateApiKeyResponseDto
+	mappingError := mapper.Mapper{}.Map(
+		&response,
+		apiKey,
+		struct {
+			Key string
+		}{
+			Key: generatedKey.Key,
+		},
+	)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,41 @@
+package service_accounts
+
+import (
+	"chat_app_backend/application/models/service_accounts/get"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetServiceAccountsHandler struct{}
+
+func (g GetServiceAccountsHandler) Handle(
+	_ *get.GetServiceAccountsRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*get.GetServiceAccountsResponseDto, exceptions.ITrackableException) {
+	serviceAccounts, queryError := services.GetDbConnection().GetQueries().GetServiceAccounts(ctx)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	var response get.GetServiceAccountsResponseDto
+	mappingError := mapper.Mapper{}.Map(
+		&response,
+		struct {
+			ServiceAccounts []db_queries.ServiceAccount
+		}{
+			ServiceAccounts: serviceAccounts,
+		},
+	)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
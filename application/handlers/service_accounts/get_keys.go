@@ -0,0 +1,43 @@
+package service_accounts
+
+import (
+	"chat_app_backend/application/models/service_accounts/get_keys"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetApiKeysHandler struct{}
+
+func (g GetApiKeysHandler) Handle(
+	request *get_keys.GetApiKeysRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*get_keys.GetApiKeysResponseDto, exceptions.ITrackableException) {
+	apiKeys, queryError := services.GetDbConnection().
+		GetQueries().
+		GetServiceAccountApiKeys(ctx, request.ServiceAccountID)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	var response get_keys.GetApiKeysResponseDto
+	mappingError := mapper.Mapper{}.Map(
+		&response,
+		struct {
+			ApiKeys []db_queries.ApiKey
+		}{
+			ApiKeys: apiKeys,
+		},
+	)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,54 @@
+package service_accounts
+
+import (
+	"chat_app_backend/application/models/service_accounts/revoke_key"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+)
+
+type RevokeApiKeyHandler struct{}
+
+func (r RevokeApiKeyHandler) Handle(
+	request *revoke_key.RevokeApiKeyRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*revoke_key.RevokeApiKeyResponseDto, exceptions.ITrackableException) {
+	apiKey, revocationError := services.GetDbConnection().
+		GetQueries().
+		RevokeApiKey(
+			ctx,
+			db_queries.RevokeApiKeyParams{
+				ID:               request.ApiKeyID,
+				ServiceAccountID: request.ServiceAccountID,
+			},
+		)
+
+	switch {
+	case errors.Is(revocationError, pgx.ErrNoRows):
+		return nil, common_exceptions.ResourceNotFoundException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.WrapErrorWithTrackableException(revocationError),
+				Message:             "api key with provided id does not exist",
+			},
+		}
+	case revocationError != nil:
+		return nil, exceptions.WrapErrorWithTrackableException(revocationError)
+	}
+
+	var response revoke_key.RevokeApiKeyResponseDto
+	mappingError := mapper.Mapper{}.Map(&response, apiKey)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,94 @@
+package shared_audit
+
+import (
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Actor struct {
+	Type db_queries.AuditActorType
+	ID   *extensions.UUID
+}
+
+var SystemActor = Actor{Type: db_queries.AuditActorTypeSYSTEM}
+
+var AnonymousActor = Actor{Type: db_queries.AuditActorTypeANONYMOUS}
+
+func UserActor(id extensions.UUID) Actor {
+	return Actor{Type: db_queries.AuditActorTypeUSER, ID: &id}
+}
+
+// ActorFromEnvironment returns the authenticated principal of the request, changes made during
+// impersonation are attributed to the admin.
+func ActorFromEnvironment(requestEnvironment *request_env.RequestEnv) Actor {
+	switch {
+	case requestEnvironment.IsImpersonated():
+		return UserActor(requestEnvironment.Impersonator.ID)
+	case requestEnvironment.User != nil:
+		return UserActor(requestEnvironment.User.ID)
+	case requestEnvironment.ServiceAccount != nil:
+		return Actor{Type: db_queries.AuditActorTypeSERVICEACCOUNT, ID: &requestEnvironment.ServiceAccount.Account.ID}
+	default:
+		return AnonymousActor
+	}
+}
+
+type Entry struct {
+	Action     string
+	TargetType string
+	TargetID   string
+	Before     interface{}
+	After      interface{}
+}
+
+// Record appends the entry to the audit log. Pass the queries of the transaction that makes the change,
+// so the entry is stored only if the change is committed. The anonymized ip and the request id are taken
+// from the request, jobs pass a plain context and get neither.
+func Record(
+	ctx context.Context,
+	queries *db_queries.Queries,
+	actor Actor,
+	entry Entry,
+) exceptions.ITrackableException {
+	changes, diffError := audit.Diff(entry.Before, entry.After)
+	if diffError != nil {
+		return exceptions.WrapErrorWithTrackableException(diffError)
+	}
+
+	serializedChanges, serializationError := json.Marshal(changes)
+	if serializationError != nil {
+		return exceptions.WrapErrorWithTrackableException(serializationError)
+	}
+
+	params := db_queries.CreateAuditLogEntryParams{
+		ActorType:  actor.Type,
+		ActorID:    actor.ID,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		Changes:    serializedChanges,
+	}
+
+	if ginCtx, ok := ctx.(*gin.Context); ok {
+		ip := audit.AnonymizeIp(ginCtx.ClientIP())
+		params.Ip = &ip
+	}
+
+	if env := request_env.FromContext(ctx); env != nil && env.RequestId != "" {
+		requestId := env.RequestId
+		params.RequestID = &requestId
+	}
+
+	if creationError := queries.CreateAuditLogEntry(ctx, params); creationError != nil {
+		return exceptions.WrapErrorWithTrackableException(creationError)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package shared_images
+
+import (
+	"bytes"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/images"
+	"chat_app_backend/internal/s3"
+	"chat_app_backend/internal/service_wrapper"
+	"context"
+	"image/png"
+	"strings"
+)
+
+const generatedAvatarSize = 256
+
+// GenerateAvatar stores an identicon derived from the full name of the user, it is used when the user
+// didn't upload an avatar. The name is compared ignoring the case and the surrounding spaces, so the users
+// with the same name get the same avatar, and the avatar changes only when the name does.
+func GenerateAvatar(
+	ctx context.Context,
+	services service_wrapper.IServiceWrapper,
+	fullName string,
+) (*StoredImage, exceptions.ITrackableException) {
+	identicon := images.GenerateIdenticon(strings.ToLower(strings.TrimSpace(fullName)), generatedAvatarSize)
+
+	var buffer bytes.Buffer
+	if encodingError := png.Encode(&buffer, identicon); encodingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(encodingError)
+	}
+
+	return StoreImage(ctx, services, buffer.Bytes(), s3.AvatarsBucket, s3.Png)
+}
@@ -0,0 +1,236 @@
+package shared_images
+
+import (
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/images"
+	"chat_app_backend/internal/s3"
+	"chat_app_backend/internal/service_wrapper"
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"slices"
+	"strconv"
+)
+
+// StoredImage holds the download links of the image. ThumbnailSizes are the sizes stored as separate
+// files, the other sizes are served by the original.
+type StoredImage struct {
+	FileName       string
+	DownloadLink   string
+	ThumbnailLinks map[string]string
+	ThumbnailSizes []int32
+}
+
+// UploadImage processes the uploaded file and stores the original together with its thumbnails under a new name.
+func UploadImage(
+	ctx context.Context,
+	services service_wrapper.IServiceWrapper,
+	fileHeader *multipart.FileHeader,
+	bucketName s3.Buckets,
+	allowedTypes ...s3.FileType,
+) (*StoredImage, exceptions.ITrackableException) {
+	file, fileOpeningError := fileHeader.Open()
+	if fileOpeningError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(fileOpeningError)
+	}
+
+	defer func(file multipart.File) {
+		_ = file.Close()
+	}(file)
+
+	data, readingError := io.ReadAll(file)
+	if readingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(readingError)
+	}
+
+	return StoreImage(ctx, services, data, bucketName, allowedTypes...)
+}
+
+// StoreImage processes the image and stores the original together with its thumbnails under a new name.
+func StoreImage(
+	ctx context.Context,
+	services service_wrapper.IServiceWrapper,
+	data []byte,
+	bucketName s3.Buckets,
+	allowedTypes ...s3.FileType,
+) (*StoredImage, exceptions.ITrackableException) {
+	processed, processingError := services.GetImageProcessor().Process(data, allowedTypes...)
+
+	switch {
+	case errors.Is(processingError, images.ErrUnsupportedFormat):
+		return nil, common_exceptions.InvalidBodyException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.WrapErrorWithTrackableException(processingError),
+				Message:             processingError.Error(),
+			},
+		}
+	case processingError != nil:
+		return nil, exceptions.WrapErrorWithTrackableException(processingError)
+	}
+
+	storedImage := &StoredImage{
+		FileName:       s3.ConstructFilenameFromFileType(processed.Original.FileType),
+		ThumbnailLinks: make(map[string]string),
+		ThumbnailSizes: make([]int32, 0, len(processed.Thumbnails)),
+	}
+
+	downloadLink, uploadError := services.GetS3Client().UploadBytes(
+		ctx,
+		processed.Original.Data,
+		processed.Original.ContentType,
+		storedImage.FileName,
+		bucketName,
+	)
+	if uploadError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(uploadError)
+	}
+
+	storedImage.DownloadLink = downloadLink
+
+	for _, thumbnail := range processed.Thumbnails {
+		thumbnailLink, thumbnailUploadError := services.GetS3Client().UploadBytes(
+			ctx,
+			thumbnail.Data,
+			thumbnail.ContentType,
+			images.ThumbnailFileName(storedImage.FileName, thumbnail.Size),
+			bucketName,
+		)
+		if thumbnailUploadError != nil {
+			return nil, exceptions.WrapErrorWithTrackableException(thumbnailUploadError)
+		}
+
+		storedImage.ThumbnailLinks[strconv.Itoa(thumbnail.Size)] = thumbnailLink
+		storedImage.ThumbnailSizes = append(storedImage.ThumbnailSizes, int32(thumbnail.Size))
+	}
+
+	// svg images have no thumbnails unless rasterization is enabled, missing sizes are served by the original
+	for _, size := range services.GetImageProcessor().GetThumbnailSizes() {
+		if _, ok := storedImage.ThumbnailLinks[strconv.Itoa(size)]; !ok {
+			storedImage.ThumbnailLinks[strconv.Itoa(size)] = downloadLink
+		}
+	}
+
+	return storedImage, nil
+}
+
+// GetImage builds the download links of the stored image. Images uploaded before the thumbnails were
+// introduced, as well as svg images stored without rasterization, fall back to the original for every size.
+func GetImage(
+	ctx context.Context,
+	services service_wrapper.IServiceWrapper,
+	filename string,
+	bucketName s3.Buckets,
+) (*StoredImage, exceptions.ITrackableException) {
+	downloadLink, downloadLinkGenerationError := services.GetS3Client().GetDownloadUrl(ctx, filename, bucketName)
+	if downloadLinkGenerationError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(downloadLinkGenerationError)
+	}
+
+	storedImage := &StoredImage{
+		FileName:       filename,
+		DownloadLink:   downloadLink,
+		ThumbnailLinks: make(map[string]string),
+		ThumbnailSizes: make([]int32, 0),
+	}
+
+	for _, size := range services.GetImageProcessor().GetThumbnailSizes() {
+		thumbnailFileName := images.ThumbnailFileName(filename, size)
+
+		exists, existenceCheckError := services.GetS3Client().FileExists(ctx, thumbnailFileName, bucketName)
+		if existenceCheckError != nil {
+			return nil, exceptions.WrapErrorWithTrackableException(existenceCheckError)
+		}
+
+		if !exists {
+			storedImage.ThumbnailLinks[strconv.Itoa(size)] = downloadLink
+			continue
+		}
+
+		thumbnailLink, thumbnailLinkGenerationError := services.GetS3Client().
+			GetDownloadUrl(ctx, thumbnailFileName, bucketName)
+		if thumbnailLinkGenerationError != nil {
+			return nil, exceptions.WrapErrorWithTrackableException(thumbnailLinkGenerationError)
+		}
+
+		storedImage.ThumbnailLinks[strconv.Itoa(size)] = thumbnailLink
+		storedImage.ThumbnailSizes = append(storedImage.ThumbnailSizes, int32(size))
+	}
+
+	return storedImage, nil
+}
+
+// GetImageWithThumbnails builds the download links of the stored image like GetImage, but takes the
+// stored sizes of the thumbnails from the caller instead of looking them up in the storage.
+func GetImageWithThumbnails(
+	ctx context.Context,
+	services service_wrapper.IServiceWrapper,
+	filename string,
+	thumbnailSizes []int32,
+	bucketName s3.Buckets,
+) (*StoredImage, exceptions.ITrackableException) {
+	downloadLink, downloadLinkGenerationError := services.GetS3Client().GetDownloadUrl(ctx, filename, bucketName)
+	if downloadLinkGenerationError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(downloadLinkGenerationError)
+	}
+
+	storedImage := &StoredImage{
+		FileName:       filename,
+		DownloadLink:   downloadLink,
+		ThumbnailLinks: make(map[string]string),
+		ThumbnailSizes: thumbnailSizes,
+	}
+
+	for _, size := range services.GetImageProcessor().GetThumbnailSizes() {
+		if !slices.Contains(thumbnailSizes, int32(size)) {
+			storedImage.ThumbnailLinks[strconv.Itoa(size)] = downloadLink
+			continue
+		}
+
+		thumbnailLink, thumbnailLinkGenerationError := services.GetS3Client().
+			GetDownloadUrl(ctx, images.ThumbnailFileName(filename, size), bucketName)
+		if thumbnailLinkGenerationError != nil {
+			return nil, exceptions.WrapErrorWithTrackableException(thumbnailLinkGenerationError)
+		}
+
+		storedImage.ThumbnailLinks[strconv.Itoa(size)] = thumbnailLink
+	}
+
+	return storedImage, nil
+}
+
+// GetStoredFileNames lists the original and all the thumbnails that can exist for the image.
+func GetStoredFileNames(services service_wrapper.IServiceWrapper, filename string) []string {
+	fileNames := []string{filename}
+	for _, size := range services.GetImageProcessor().GetThumbnailSizes() {
+		fileNames = append(fileNames, images.ThumbnailFileName(filename, size))
+	}
+
+	return fileNames
+}
+
+// RemoveImage removes the original and its thumbnails, files that don't exist are skipped.
+func RemoveImage(
+	ctx context.Context,
+	services service_wrapper.IServiceWrapper,
+	filename string,
+	bucketName s3.Buckets,
+) exceptions.ITrackableException {
+	for _, storedFileName := range GetStoredFileNames(services, filename) {
+		exists, existenceCheckError := services.GetS3Client().FileExists(ctx, storedFileName, bucketName)
+		if existenceCheckError != nil {
+			return exceptions.WrapErrorWithTrackableException(existenceCheckError)
+		}
+
+		if !exists {
+			continue
+		}
+
+		if removeError := services.GetS3Client().DeleteFile(ctx, storedFileName, bucketName); removeError != nil {
+			return exceptions.WrapErrorWithTrackableException(removeError)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package shared_interests
+
+import (
+	"chat_app_backend/application/models/interests/get"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+)
+
+// BuildCategoryTree places the interests in their categories and returns the root categories with the
+// interests which have no category. The categories and the interests keep the order they are passed in.
+func BuildCategoryTree(
+	categories []db_queries.InterestCategory,
+	interests []get.GetInterestResponseDto,
+) ([]get.InterestCategoryNodeDto, []get.GetInterestResponseDto) {
+	categoryInterests := make(map[extensions.UUID][]get.GetInterestResponseDto)
+	uncategorized := make([]get.GetInterestResponseDto, 0)
+
+	for _, interest := range interests {
+		if interest.CategoryID == nil {
+			uncategorized = append(uncategorized, interest)
+			continue
+		}
+
+		categoryInterests[*interest.CategoryID] = append(categoryInterests[*interest.CategoryID], interest)
+	}
+
+	subcategories := make(map[extensions.UUID][]db_queries.InterestCategory)
+	roots := make([]db_queries.InterestCategory, 0)
+
+	for _, category := range categories {
+		if category.ParentID == nil {
+			roots = append(roots, category)
+			continue
+		}
+
+		subcategories[*category.ParentID] = append(subcategories[*category.ParentID], category)
+	}
+
+	var buildNodes func(level []db_queries.InterestCategory) []get.InterestCategoryNodeDto
+	buildNodes = func(level []db_queries.InterestCategory) []get.InterestCategoryNodeDto {
+		nodes := make([]get.InterestCategoryNodeDto, len(level))
+
+		for idx, category := range level {
+			nodeInterests := categoryInterests[category.ID]
+			if nodeInterests == nil {
+				nodeInterests = make([]get.GetInterestResponseDto, 0)
+			}
+
+			nodes[idx] = get.InterestCategoryNodeDto{
+				ID:            category.ID,
+				ParentID:      category.ParentID,
+				Title:         category.Title,
+				Position:      category.Position,
+				Interests:     nodeInterests,
+				Subcategories: buildNodes(subcategories[category.ID]),
+			}
+		}
+
+		return nodes
+	}
+
+	return buildNodes(roots), uncategorized
+}
@@ -1,30 +1,39 @@
 package shared_interests
 
 import (
+	shared_images "chat_app_backend/application/handlers/shared/images"
 	"chat_app_backend/application/models/interests/get"
+	"chat_app_backend/internal/etag"
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/mapper"
 	"chat_app_backend/internal/s3"
+	"chat_app_backend/internal/service_wrapper"
 	"chat_app_backend/internal/sqlc/db_queries"
 	"context"
 )
 
-func GetInterestIcons(rawInterests []db_queries.Interest, client s3.IClient, ctx context.Context) ([]get.GetInterestResponseDto, exceptions.ITrackableException) {
+// GetInterestIcons maps the interests with the download links of their icons. The links are only signed,
+// the storage is checked for the icons which don't know the sizes of their thumbnails.
+func GetInterestIcons(rawInterests []db_queries.Interest, services service_wrapper.IServiceWrapper, ctx context.Context) ([]get.GetInterestResponseDto, exceptions.ITrackableException) {
 	mappedInterests := make([]get.GetInterestResponseDto, len(rawInterests))
 
 	for idx, rawInterest := range rawInterests {
-		link, s3Error := client.GetDownloadUrl(ctx, rawInterest.IconFileName, s3.InterestsIconBucket)
-		if s3Error != nil {
-			return nil, exceptions.WrapErrorWithTrackableException(s3Error)
+		icon, iconGetError := GetInterestIcon(ctx, services, rawInterest)
+		if iconGetError != nil {
+			return nil, iconGetError
 		}
 
 		mappingErr := mapper.Mapper{}.Map(
 			&mappedInterests[idx],
 			rawInterest,
 			struct {
-				IconDownloadLink string
+				IconDownloadLink   string
+				IconThumbnailLinks map[string]string
+				ETag               string
 			}{
-				IconDownloadLink: link,
+				IconDownloadLink:   icon.DownloadLink,
+				IconThumbnailLinks: icon.ThumbnailLinks,
+				ETag:               etag.FromVersion(rawInterest.UpdatedAt),
 			},
 		)
 
@@ -35,3 +44,22 @@ func GetInterestIcons(rawInterests []db_queries.Interest, client s3.IClient, ctx
 
 	return mappedInterests, nil
 }
+
+// GetInterestIcon builds the download links of the icon of the interest.
+func GetInterestIcon(
+	ctx context.Context,
+	services service_wrapper.IServiceWrapper,
+	interest db_queries.Interest,
+) (*shared_images.StoredImage, exceptions.ITrackableException) {
+	if interest.IconThumbnailSizes == nil {
+		return shared_images.GetImage(ctx, services, interest.IconFileName, s3.InterestsIconBucket)
+	}
+
+	return shared_images.GetImageWithThumbnails(
+		ctx,
+		services,
+		interest.IconFileName,
+		interest.IconThumbnailSizes,
+		s3.InterestsIconBucket,
+	)
+}
@@ -0,0 +1,48 @@
+package shared_interests
+
+import (
+	"chat_app_backend/application/application_config"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/service_wrapper"
+	"fmt"
+)
+
+// CheckInterestsLimit fails when a user would have more interests than the configuration allows.
+func CheckInterestsLimit(services service_wrapper.IServiceWrapper, interestsCount int64) exceptions.ITrackableException {
+	return CheckInterestsChange(services, 0, interestsCount)
+}
+
+// CheckInterestsChange fails when the change grows the interests of a user beyond the limit.
+func CheckInterestsChange(
+	services service_wrapper.IServiceWrapper,
+	countBefore int64,
+	countAfter int64,
+) exceptions.ITrackableException {
+	interestsConfig, configError := services.GetConfiguration().Get(&application_config.InterestsConfig{})
+	if configError != nil {
+		return exceptions.WrapErrorWithTrackableException(configError)
+	}
+
+	return LimitInterestsChange(
+		interestsConfig.(*application_config.InterestsConfig).MaxInterestsPerUser,
+		countBefore,
+		countAfter,
+	)
+}
+
+// LimitInterestsChange lets through the changes which don't grow the interests, so the users above the
+// limit, e.g. after it was lowered, can still remove theirs.
+func LimitInterestsChange(maxInterests int32, countBefore int64, countAfter int64) exceptions.ITrackableException {
+	if countAfter <= int64(maxInterests) || countAfter <= countBefore {
+		return nil
+	}
+
+	message := fmt.Sprintf("user can't have more than %d interests", maxInterests)
+	return common_exceptions.InvalidBodyException{
+		BaseRestException: exceptions.BaseRestException{
+			ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+			Message:             message,
+		},
+	}
+}
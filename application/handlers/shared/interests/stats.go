@@ -0,0 +1,84 @@
+package shared_interests
+
+import (
+	"chat_app_backend/application/models/interests/stats"
+	"chat_app_backend/internal/extensions"
+	"encoding/csv"
+	"strconv"
+	"time"
+)
+
+var interestStatsCsvHeader = []string{
+	"interest_id",
+	"title",
+	"users_count",
+	"added",
+	"removed",
+	"growth",
+	"growth_rate",
+}
+
+// StatsWindowStart returns the first utc day of the window, the current day is the last one.
+func StatsWindowStart(now time.Time, windowDays int32) time.Time {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return today.AddDate(0, 0, -int(windowDays-1))
+}
+
+// CreateInterestStats derives the growth of the interest in the window from its counters.
+func CreateInterestStats(
+	id extensions.UUID,
+	title string,
+	usersCount int64,
+	added int64,
+	removed int64,
+) stats.InterestStatsResponseDto {
+	growth := added - removed
+
+	var growthRate *float64
+	if startCount := usersCount - growth; startCount > 0 {
+		growthRate = new(float64)
+		*growthRate = float64(growth) / float64(startCount)
+	}
+
+	return stats.InterestStatsResponseDto{
+		InterestID: id,
+		Title:      title,
+		UsersCount: usersCount,
+		Added:      added,
+		Removed:    removed,
+		Growth:     growth,
+		GrowthRate: growthRate,
+	}
+}
+
+// WriteInterestStatsCsv writes a row per interest after the header, the growth rate is empty when there
+// is none.
+func WriteInterestStatsCsv(writer *csv.Writer, interestStats []stats.InterestStatsResponseDto) error {
+	if writingError := writer.Write(interestStatsCsvHeader); writingError != nil {
+		return writingError
+	}
+
+	for _, interest := range interestStats {
+		growthRate := ""
+		if interest.GrowthRate != nil {
+			growthRate = strconv.FormatFloat(*interest.GrowthRate, 'f', 4, 64)
+		}
+
+		writingError := writer.Write([]string{
+			interest.InterestID.String(),
+			interest.Title,
+			strconv.FormatInt(interest.UsersCount, 10),
+			strconv.FormatInt(interest.Added, 10),
+			strconv.FormatInt(interest.Removed, 10),
+			strconv.FormatInt(interest.Growth, 10),
+			growthRate,
+		})
+		if writingError != nil {
+			return writingError
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
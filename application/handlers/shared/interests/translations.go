@@ -0,0 +1,106 @@
+package shared_interests
+
+import (
+	"chat_app_backend/application/application_config"
+	"chat_app_backend/application/models/interests/get"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/locale"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LocalizeInterests sets the title and the description of the interests to the locale preferred in the
+// Accept-Language header, the text of the interest itself is used for the default locale and for the
+// locales without a translation. All the translations are added when allTranslations is set.
+func LocalizeInterests(
+	interests []get.GetInterestResponseDto,
+	allTranslations bool,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+) exceptions.ITrackableException {
+	localizationConfig, configError := services.GetConfiguration().Get(&application_config.LocalizationConfig{})
+	if configError != nil {
+		return exceptions.WrapErrorWithTrackableException(configError)
+	}
+
+	defaultLocale := locale.Normalize(localizationConfig.(*application_config.LocalizationConfig).DefaultLocale)
+	preferredLocales := request_env.FromContext(ctx).Locales
+
+	if len(interests) == 0 {
+		return nil
+	}
+
+	interestIds := make([]extensions.UUID, len(interests))
+	for idx, interest := range interests {
+		interestIds[idx] = interest.ID
+	}
+
+	translations, queryError := services.GetDbConnection().GetQueries().GetInterestTranslations(ctx, interestIds)
+	if queryError != nil {
+		return exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	// the translations are ordered by the locale, so the order is kept in the response
+	translationsByInterest := make(map[extensions.UUID][]db_queries.InterestTranslation)
+	for _, translation := range translations {
+		translationsByInterest[translation.InterestID] = append(translationsByInterest[translation.InterestID], translation)
+	}
+
+	for idx := range interests {
+		localizeInterest(&interests[idx], translationsByInterest[interests[idx].ID], preferredLocales, defaultLocale, allTranslations)
+	}
+
+	return nil
+}
+
+// localizeInterest treats a translation to the default locale, which could be added before the default
+// locale was changed, as an override of the text of the interest itself.
+func localizeInterest(
+	interest *get.GetInterestResponseDto,
+	translations []db_queries.InterestTranslation,
+	preferredLocales []string,
+	defaultLocale string,
+	allTranslations bool,
+) {
+	localizedTexts := make([]get.InterestTranslationDto, 0, len(translations)+1)
+	availableLocales := make([]string, 0, len(translations)+1)
+
+	defaultTranslated := false
+	for _, translation := range translations {
+		defaultTranslated = defaultTranslated || translation.Locale == defaultLocale
+	}
+
+	if !defaultTranslated {
+		localizedTexts = append(localizedTexts, get.InterestTranslationDto{
+			Locale:      defaultLocale,
+			Title:       interest.Title,
+			Description: interest.Description,
+		})
+		availableLocales = append(availableLocales, defaultLocale)
+	}
+
+	for _, translation := range translations {
+		localizedTexts = append(localizedTexts, get.InterestTranslationDto{
+			Locale:      translation.Locale,
+			Title:       translation.Title,
+			Description: translation.Description,
+		})
+		availableLocales = append(availableLocales, translation.Locale)
+	}
+
+	interest.Locale = locale.Resolve(preferredLocales, availableLocales, defaultLocale)
+	for _, localizedText := range localizedTexts {
+		if localizedText.Locale == interest.Locale {
+			interest.Title = localizedText.Title
+			interest.Description = localizedText.Description
+		}
+	}
+
+	if allTranslations {
+		interest.Translations = localizedTexts
+	}
+}
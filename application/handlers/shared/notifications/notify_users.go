@@ -0,0 +1,49 @@
+package shared_notifications
+
+import (
+	"chat_app_backend/application/models/webhooks/events"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/service_wrapper"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// UserChannel is the redis pub/sub channel realtime clients of the user are subscribed to.
+func UserChannel(userId extensions.UUID) string {
+	return fmt.Sprintf("notifications:users:%s", userId.UUID.String())
+}
+
+// NotifyUsers publishes the event to the channels of the users. Call it after the change is committed,
+// notifications are best effort, so failures are logged instead of being returned.
+func NotifyUsers(
+	ctx context.Context,
+	services service_wrapper.IServiceWrapper,
+	eventType string,
+	data interface{},
+	userIds ...extensions.UUID,
+) {
+	payload, marshalingError := json.Marshal(
+		events.EventDto{
+			Type:       eventType,
+			OccurredAt: time.Now().UTC(),
+			Data:       data,
+		},
+	)
+	if marshalingError != nil {
+		services.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(marshalingError)).
+			Log()
+		return
+	}
+
+	for _, userId := range userIds {
+		if publishError := services.GetRedisClient().Publish(ctx, UserChannel(userId), payload).Err(); publishError != nil {
+			services.GetLogger().
+				CreateErrorMessage(exceptions.WrapErrorWithTrackableException(publishError)).
+				Log()
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package shared_users
+
+import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ScheduleDeletion returns when the user is purged, the grace period starts now.
+func ScheduleDeletion(user *db_queries.User, gracePeriod time.Duration, now time.Time) (time.Time, exceptions.ITrackableException) {
+	if user.DeletedAt != nil {
+		return time.Time{}, invalidDeletionState("user is already scheduled for deletion")
+	}
+
+	return now.Add(gracePeriod), nil
+}
+
+// CheckRestorable fails for the users which aren't scheduled for deletion and for the ones whose grace
+// period is over, as they can be purged at any moment.
+func CheckRestorable(user *db_queries.User, now time.Time) exceptions.ITrackableException {
+	if user.DeletedAt == nil {
+		return invalidDeletionState("user is not scheduled for deletion")
+	}
+
+	if user.PurgeAfter != nil && !now.Before(*user.PurgeAfter) {
+		return invalidDeletionState("the grace period of the deletion is over")
+	}
+
+	return nil
+}
+
+// RestoreUser cancels the deletion of the user and records it, pass the queries of a transaction. The
+// grace period is checked again by the update, so the user purged or restored since it was read fails
+// the same way as in CheckRestorable.
+func RestoreUser(
+	ctx context.Context,
+	queries *db_queries.Queries,
+	actor shared_audit.Actor,
+	user db_queries.User,
+) (db_queries.User, exceptions.ITrackableException) {
+	restoredUser, restoreError := queries.RestoreUser(ctx, user.ID)
+	switch {
+	case errors.Is(restoreError, pgx.ErrNoRows):
+		return db_queries.User{}, invalidDeletionState("user is not scheduled for deletion or its grace period is over")
+	case restoreError != nil:
+		return db_queries.User{}, exceptions.WrapErrorWithTrackableException(restoreError)
+	}
+
+	auditError := shared_audit.Record(
+		ctx,
+		queries,
+		actor,
+		shared_audit.Entry{
+			Action:     audit.ActionUserRestored,
+			TargetType: audit.TargetUser,
+			TargetID:   restoredUser.ID.String(),
+			Before:     user,
+			After:      restoredUser,
+		},
+	)
+	if auditError != nil {
+		return db_queries.User{}, auditError
+	}
+
+	return restoredUser, nil
+}
+
+func invalidDeletionState(message string) exceptions.ITrackableException {
+	return common_exceptions.InvalidBodyException{
+		BaseRestException: exceptions.BaseRestException{
+			ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+			Message:             message,
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package shared_webhooks
+
+import (
+	"chat_app_backend/application/models/webhooks/events"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// PublishEvent enqueues a delivery for every subscription of the event type. Pass the queries of the
+// transaction that makes the change, so the event is stored only if the change is committed.
+func PublishEvent(
+	ctx context.Context,
+	queries *db_queries.Queries,
+	eventType string,
+	data interface{},
+) exceptions.ITrackableException {
+	payload, marshalingError := json.Marshal(
+		events.EventDto{
+			Type:       eventType,
+			OccurredAt: time.Now().UTC(),
+			Data:       data,
+		},
+	)
+	if marshalingError != nil {
+		return exceptions.WrapErrorWithTrackableException(marshalingError)
+	}
+
+	enqueueError := queries.EnqueueWebhookDeliveries(
+		ctx,
+		db_queries.EnqueueWebhookDeliveriesParams{
+			EventType: eventType,
+			Payload:   payload,
+		},
+	)
+	if enqueueError != nil {
+		return exceptions.WrapErrorWithTrackableException(enqueueError)
+	}
+
+	return nil
+}
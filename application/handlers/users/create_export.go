@@ -0,0 +1,35 @@
+package users
+
+import (
+	"chat_app_backend/application/models/users/create_export"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CreateExportHandler struct{}
+
+func (c CreateExportHandler) Handle(
+	request *create_export.CreateExportRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*create_export.CreateExportResponseDto, exceptions.ITrackableException) {
+	dataExport, creationError := services.GetDbConnection().
+		GetQueries().
+		CreateDataExport(ctx, request.UserID)
+	if creationError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(creationError)
+	}
+
+	var response create_export.CreateExportResponseDto
+	mappingError := mapper.Mapper{}.Map(&response, dataExport)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
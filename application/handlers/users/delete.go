@@ -1,15 +1,23 @@
 package users
 
 import (
+	"chat_app_backend/application/application_config"
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	shared_users "chat_app_backend/application/handlers/shared/users"
 	delete2 "chat_app_backend/application/models/users/delete"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/etag"
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/exceptions/common_exceptions"
 	"chat_app_backend/internal/request_env"
 	"chat_app_backend/internal/service_wrapper"
 	"chat_app_backend/internal/sqlc/db_queries"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
 )
 
 type DeleteUserHandler struct{}
@@ -25,17 +33,80 @@ func (d DeleteUserHandler) Handle(
 		message := fmt.Sprintf("can't delete user with id %s", request.ID)
 		return nil, common_exceptions.ForbiddenException{
 			BaseRestException: exceptions.BaseRestException{
-				ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
 				Message:             message,
 			},
 		}
 	}
 
-	userDeletionError := service.GetDbConnection().
-		GetQueries().
-		RemoveUser(ctx, request.ID)
-	if userDeletionError != nil {
-		return nil, exceptions.WrapErrorWithTrackableException(userDeletionError)
+	userDataConfig, configError := service.GetConfiguration().Get(&application_config.UserDataConfig{})
+	if configError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(configError)
 	}
-	return &delete2.DeleteUserResponseDto{}, nil
+
+	gracePeriod, gracePeriodParseError := userDataConfig.(*application_config.UserDataConfig).GetDeletionGracePeriod()
+	if gracePeriodParseError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(gracePeriodParseError)
+	}
+
+	userToDelete, userQueryError := service.GetDbConnection().GetQueries().GetUserById(ctx, request.ID)
+
+	switch {
+	case errors.Is(userQueryError, pgx.ErrNoRows):
+		return nil, common_exceptions.ResourceNotFoundException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.WrapErrorWithTrackableException(userQueryError),
+				Message:             "user not found",
+			},
+		}
+	case userQueryError != nil:
+		return nil, exceptions.WrapErrorWithTrackableException(userQueryError)
+	}
+
+	purgeAfter, schedulingError := shared_users.ScheduleDeletion(&userToDelete, gracePeriod, time.Now())
+	if schedulingError != nil {
+		return nil, schedulingError
+	}
+
+	var deletedUser db_queries.User
+
+	transactionError := service.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			softDeletedUser, userDeletionError := queries.SoftDeleteUser(ctx, db_queries.SoftDeleteUserParams{
+				PurgeAfter:       &purgeAfter,
+				ID:               request.ID,
+				ExpectedVersions: etag.ExpectedVersions(ctx),
+			})
+			switch {
+			case errors.Is(userDeletionError, pgx.ErrNoRows):
+				return common_exceptions.PreconditionFailedException{
+					BaseRestException: exceptions.BaseRestException{
+						ITrackableException: exceptions.WrapErrorWithTrackableException(userDeletionError),
+						Message:             "user was changed since it was read",
+					},
+				}
+			case userDeletionError != nil:
+				return exceptions.WrapErrorWithTrackableException(userDeletionError)
+			}
+
+			deletedUser = softDeletedUser
+
+			return shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				shared_audit.Entry{
+					Action:     audit.ActionUserDeleted,
+					TargetType: audit.TargetUser,
+					TargetID:   deletedUser.ID.String(),
+					Before:     userToDelete,
+					After:      deletedUser,
+				},
+			)
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	return &delete2.DeleteUserResponseDto{PurgeAfter: *deletedUser.PurgeAfter}, nil
 }
@@ -0,0 +1,49 @@
+package users
+
+import (
+	"chat_app_backend/application/models/users/download_export"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/response"
+	"chat_app_backend/internal/s3"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DownloadExportHandler struct{}
+
+// Handle redirects to the presigned url of the export file, so the file is downloaded from s3 directly.
+func (d DownloadExportHandler) Handle(
+	request *download_export.DownloadExportRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*response.Redirect, exceptions.ITrackableException) {
+	dataExport, exportError := getUserDataExport(ctx, services, request.UserID, request.ExportID)
+	if exportError != nil {
+		return nil, exportError
+	}
+
+	if dataExport.Status != db_queries.DataExportStatusREADY || dataExport.FileName == nil {
+		return nil, common_exceptions.ResourceNotFoundException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF(
+					"data export %s is %s",
+					dataExport.ID,
+					dataExport.Status,
+				),
+				Message: "data export file is not ready",
+			},
+		}
+	}
+
+	link, downloadLinkGenerationError := services.GetS3Client().GetDownloadUrl(ctx, *dataExport.FileName, s3.ExportsBucket)
+	if downloadLinkGenerationError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(downloadLinkGenerationError)
+	}
+
+	return &response.Redirect{Location: link}, nil
+}
@@ -0,0 +1,86 @@
+package users
+
+import (
+	"chat_app_backend/application/models/users/get_export"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/s3"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+)
+
+type GetExportHandler struct{}
+
+func (g GetExportHandler) Handle(
+	request *get_export.GetExportRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*get_export.GetExportResponseDto, exceptions.ITrackableException) {
+	dataExport, exportError := getUserDataExport(ctx, services, request.UserID, request.ExportID)
+	if exportError != nil {
+		return nil, exportError
+	}
+
+	var downloadLink *string
+	if dataExport.Status == db_queries.DataExportStatusREADY && dataExport.FileName != nil {
+		link, downloadLinkGenerationError := services.GetS3Client().
+			GetDownloadUrl(ctx, *dataExport.FileName, s3.ExportsBucket)
+
+		if downloadLinkGenerationError != nil {
+			return nil, exceptions.WrapErrorWithTrackableException(downloadLinkGenerationError)
+		}
+
+		downloadLink = &link
+	}
+
+	var response get_export.GetExportResponseDto
+	mappingError := mapper.Mapper{}.Map(
+		&response,
+		dataExport,
+		struct {
+			DownloadLink *string
+		}{
+			DownloadLink: downloadLink,
+		},
+	)
+
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
+
+// getUserDataExport returns the export of the user, the exports of the other users are reported as missing.
+func getUserDataExport(
+	ctx *gin.Context,
+	services service_wrapper.IServiceWrapper,
+	userId extensions.UUID,
+	exportId extensions.UUID,
+) (*db_queries.DataExport, exceptions.ITrackableException) {
+	dataExport, exportQueryError := services.GetDbConnection().
+		GetQueries().
+		GetDataExportById(ctx, exportId)
+
+	switch {
+	case errors.Is(exportQueryError, pgx.ErrNoRows) || (exportQueryError == nil && dataExport.UserID != userId):
+		return nil, common_exceptions.ResourceNotFoundException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF("data export %s not found", exportId),
+				Message:             "data export not found",
+			},
+		}
+	case exportQueryError != nil:
+		return nil, exceptions.WrapErrorWithTrackableException(exportQueryError)
+	}
+
+	return &dataExport, nil
+}
@@ -1,13 +1,17 @@
 package users
 
 import (
+	shared_images "chat_app_backend/application/handlers/shared/images"
 	sharedinterests "chat_app_backend/application/handlers/shared/interests"
 	interests "chat_app_backend/application/models/interests/get"
 	"chat_app_backend/application/models/users/get_user_data"
+	"chat_app_backend/internal/etag"
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/exceptions/common_exceptions"
 	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/privacy"
 	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/response"
 	"chat_app_backend/internal/s3"
 	"chat_app_backend/internal/service_wrapper"
 	"chat_app_backend/internal/sqlc/db_queries"
@@ -26,18 +30,10 @@ func (g GetUserDataHandler) Handle(
 	ctx *gin.Context,
 	requestEnvironment *request_env.RequestEnv,
 ) (*get_user_data.GetUserDataResponseDto, exceptions.ITrackableException) {
-	requestingUser := *requestEnvironment.User
-	if requestingUser.Role == db_queries.RoleTypeUSER && requestingUser.ID != request.ID {
-		message := fmt.Sprintf("can't get user with id %s", request.ID)
-		return nil, common_exceptions.ForbiddenException{
-			BaseRestException: exceptions.BaseRestException{
-				ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
-				Message:             message,
-			},
-		}
-	}
+	requestingUser := requestEnvironment.User
+	queries := services.GetDbConnection().GetQueries()
 
-	user, userQueryError := services.GetDbConnection().GetQueries().GetUserById(ctx, request.ID)
+	user, userQueryError := queries.GetUserById(ctx, request.ID)
 
 	switch {
 	case errors.Is(userQueryError, pgx.ErrNoRows):
@@ -49,36 +45,102 @@ func (g GetUserDataHandler) Handle(
 		}
 	case userQueryError != nil:
 		return nil, exceptions.WrapErrorWithTrackableException(userQueryError)
+	// the users scheduled for deletion are hidden from everyone except the admins
+	case user.DeletedAt != nil && requestingUser.Role != db_queries.RoleTypeADMIN:
+		return nil, common_exceptions.ResourceNotFoundException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF("user %s is scheduled for deletion", user.ID),
+				Message:             "user not found",
+			},
+		}
+	}
+
+	isPrivileged := requestingUser.ID == user.ID || requestingUser.Role == db_queries.RoleTypeADMIN
+
+	areContacts := false
+	if !isPrivileged {
+		contactsQueryResult, contactsQueryError := queries.AreContacts(
+			ctx,
+			db_queries.AreContactsParams{
+				UserID:    requestingUser.ID,
+				ContactID: user.ID,
+			},
+		)
+		if contactsQueryError != nil {
+			return nil, exceptions.WrapErrorWithTrackableException(contactsQueryError)
+		}
+
+		areContacts = contactsQueryResult
+	}
+
+	if !privacy.IsVisibleTo(user.ProfileVisibility, requestingUser, user.ID, areContacts) {
+		message := fmt.Sprintf("can't get user with id %s", request.ID)
+		return nil, common_exceptions.ForbiddenException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+				Message:             message,
+			},
+		}
 	}
 
-	rawInterests, interestsQueryError := services.GetDbConnection().GetQueries().GetUserInterests(ctx, request.ID)
+	rawInterests, interestsQueryError := queries.GetUserInterests(ctx, request.ID)
 	if interestsQueryError != nil {
 		return nil, exceptions.WrapErrorWithTrackableException(interestsQueryError)
 	}
 
-	avatarDownloadLink, downloadLinkGenerationError := services.GetS3Client().
-		GetDownloadUrl(ctx, user.AvatarFileName, s3.AvatarsBucket)
-
-	if downloadLinkGenerationError != nil {
-		return nil, exceptions.WrapErrorWithTrackableException(downloadLinkGenerationError)
+	avatar, avatarGetError := shared_images.GetImage(ctx, services, user.AvatarFileName, s3.AvatarsBucket)
+	if avatarGetError != nil {
+		return nil, avatarGetError
 	}
 
-	mappedInterests, err := sharedinterests.GetInterestIcons(rawInterests, services.GetS3Client(), ctx)
+	mappedInterests, err := sharedinterests.GetInterestIcons(rawInterests, services, ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	var response get_user_data.GetUserDataResponseDto
+	if localizationError := sharedinterests.LocalizeInterests(mappedInterests, false, services, ctx); localizationError != nil {
+		return nil, localizationError
+	}
+
+	var privacySettings *get_user_data.PrivacySettingsDto
+	if isPrivileged {
+		privacySettings = &get_user_data.PrivacySettingsDto{
+			ProfileVisibility:     user.ProfileVisibility,
+			PresenceVisibility:    user.PresenceVisibility,
+			PrivateChatPermission: user.PrivateChatPermission,
+		}
+	}
+
+	var result get_user_data.GetUserDataResponseDto
 	_ = mapper.Mapper{}.Map(
-		&response,
+		&result,
 		user,
 		struct {
-			Interests          []interests.GetInterestResponseDto
-			AvatarDownloadLink string
+			Interests            []interests.GetInterestResponseDto
+			AvatarDownloadLink   string
+			AvatarThumbnailLinks map[string]string
+			Privacy              *get_user_data.PrivacySettingsDto
+			ETag                 string
 		}{
-			Interests:          mappedInterests,
-			AvatarDownloadLink: avatarDownloadLink,
+			Interests:            mappedInterests,
+			AvatarDownloadLink:   avatar.DownloadLink,
+			AvatarThumbnailLinks: avatar.ThumbnailLinks,
+			Privacy:              privacySettings,
+			ETag:                 etag.FromVersion(user.UpdatedAt),
 		},
 	)
-	return &response, nil
+
+	if isPrivileged {
+		result.Email = &user.Email
+	}
+
+	if privacy.IsVisibleTo(user.PresenceVisibility, requestingUser, user.ID, areContacts) {
+		result.Online = &user.Online
+		result.LastSeen = &user.LastSeen
+	}
+
+	// the body depends on who reads it and has the links which expire, so it is tagged as it is
+	response.TagBody(ctx)
+
+	return &result, nil
 }
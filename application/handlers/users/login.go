@@ -1,18 +1,24 @@
 package users
 
 import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	shared_images "chat_app_backend/application/handlers/shared/images"
 	sharedinterests "chat_app_backend/application/handlers/shared/interests"
+	shared_users "chat_app_backend/application/handlers/shared/users"
 	interests "chat_app_backend/application/models/interests/get"
 	"chat_app_backend/application/models/jwt_claims"
 	"chat_app_backend/application/models/users/login"
+	"chat_app_backend/internal/audit"
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/exceptions/common_exceptions"
 	"chat_app_backend/internal/mapper"
-	"chat_app_backend/internal/password"
+	"chat_app_backend/internal/moderation"
 	"chat_app_backend/internal/request_env"
 	"chat_app_backend/internal/s3"
 	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
 	"errors"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5"
@@ -40,16 +46,111 @@ func (l LoginHandler) Handle(
 		return nil, exceptions.WrapErrorWithTrackableException(userExistenceError)
 	}
 
-	if !password.ComparePassword(request.Password, user.Password) {
+	passwordHasher := services.GetPasswordHasher()
+
+	passwordMatches, comparisonError := passwordHasher.ComparePassword(request.Password, user.Password)
+	if comparisonError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(comparisonError)
+	}
+
+	if !passwordMatches {
+		auditError := shared_audit.Record(
+			ctx,
+			services.GetDbConnection().GetQueries(),
+			shared_audit.AnonymousActor,
+			shared_audit.Entry{
+				Action:     audit.ActionUserLoginFailed,
+				TargetType: audit.TargetUser,
+				TargetID:   user.ID.String(),
+			},
+		)
+		if auditError != nil {
+			services.GetLogger().
+				CreateErrorMessage(auditError).
+				Log()
+		}
+
 		message := "invalid credentials"
 		return nil, common_exceptions.InvalidBodyException{
 			BaseRestException: exceptions.BaseRestException{
-				ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+				Message:             message,
+			},
+		}
+	}
+
+	if moderation.IsSuspended(&user, time.Now()) {
+		message := moderation.SuspensionMessage(&user)
+		return nil, common_exceptions.ForbiddenException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
 				Message:             message,
 			},
 		}
 	}
 
+	if user.DeletedAt != nil {
+		if !request.Restore {
+			message := "the account is scheduled for deletion, log in with restore to cancel it"
+			return nil, common_exceptions.ForbiddenException{
+				BaseRestException: exceptions.BaseRestException{
+					ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+					Message:             message,
+				},
+			}
+		}
+
+		if restorationError := shared_users.CheckRestorable(&user, time.Now()); restorationError != nil {
+			return nil, restorationError
+		}
+
+		transactionError := services.GetDbConnection().
+			CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+				restoredUser, restorationError := shared_users.RestoreUser(
+					ctx,
+					queries,
+					shared_audit.UserActor(user.ID),
+					user,
+				)
+				user = restoredUser
+				return restorationError
+			})
+		if transactionError != nil {
+			return nil, transactionError
+		}
+	}
+
+	if passwordHasher.NeedsRehash(user.Password) {
+		rehashError := services.GetDbConnection().GetQueries().UpdateUserPassword(
+			ctx,
+			db_queries.UpdateUserPasswordParams{
+				Password: passwordHasher.HashPassword(request.Password),
+				ID:       user.ID,
+			},
+		)
+
+		// the old hash is still valid, so login shouldn't fail because of the upgrade
+		if rehashError != nil {
+			services.GetLogger().
+				CreateErrorMessage(exceptions.WrapErrorWithTrackableException(rehashError)).
+				Log()
+		}
+	}
+
+	auditError := shared_audit.Record(
+		ctx,
+		services.GetDbConnection().GetQueries(),
+		shared_audit.UserActor(user.ID),
+		shared_audit.Entry{
+			Action:     audit.ActionUserLoggedIn,
+			TargetType: audit.TargetUser,
+			TargetID:   user.ID.String(),
+		},
+	)
+	if auditError != nil {
+		return nil, auditError
+	}
+
 	rawInterests, interestsQueryError := services.GetDbConnection().GetQueries().GetUserInterests(ctx, user.ID)
 	if interestsQueryError != nil {
 		return nil, exceptions.WrapErrorWithTrackableException(interestsQueryError)
@@ -67,33 +168,37 @@ func (l LoginHandler) Handle(
 		return nil, exceptions.WrapErrorWithTrackableException(tokenGenerationError)
 	}
 
-	avatarDownloadLink, downloadLinkGenerationError := services.GetS3Client().
-		GetDownloadUrl(ctx, user.AvatarFileName, s3.AvatarsBucket)
-
-	if downloadLinkGenerationError != nil {
-		return nil, exceptions.WrapErrorWithTrackableException(downloadLinkGenerationError)
+	avatar, avatarGetError := shared_images.GetImage(ctx, services, user.AvatarFileName, s3.AvatarsBucket)
+	if avatarGetError != nil {
+		return nil, avatarGetError
 	}
 
-	mappedInterests, err := sharedinterests.GetInterestIcons(rawInterests, services.GetS3Client(), ctx)
+	mappedInterests, err := sharedinterests.GetInterestIcons(rawInterests, services, ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if localizationError := sharedinterests.LocalizeInterests(mappedInterests, false, services, ctx); localizationError != nil {
+		return nil, localizationError
+	}
+
 	var response login.LoginResponseDto
 
 	mappingErr = mapper.Mapper{}.Map(
 		&response,
 		user,
 		struct {
-			Interests          []interests.GetInterestResponseDto
-			AccessToken        string
-			RefreshToken       string
-			AvatarDownloadLink string
+			Interests            []interests.GetInterestResponseDto
+			AccessToken          string
+			RefreshToken         string
+			AvatarDownloadLink   string
+			AvatarThumbnailLinks map[string]string
 		}{
-			AvatarDownloadLink: avatarDownloadLink,
-			Interests:          mappedInterests,
-			AccessToken:        accessToken.GetToken(),
-			RefreshToken:       refreshToken.GetToken(),
+			AvatarDownloadLink:   avatar.DownloadLink,
+			AvatarThumbnailLinks: avatar.ThumbnailLinks,
+			Interests:            mappedInterests,
+			AccessToken:          accessToken.GetToken(),
+			RefreshToken:         refreshToken.GetToken(),
 		},
 	)
 
@@ -1,15 +1,18 @@
 package users
 
 import (
+	shared_images "chat_app_backend/application/handlers/shared/images"
 	"chat_app_backend/application/models/users/refresh_token"
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/exceptions/common_exceptions"
 	"chat_app_backend/internal/jwt"
 	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/moderation"
 	"chat_app_backend/internal/request_env"
 	"chat_app_backend/internal/s3"
 	"chat_app_backend/internal/service_wrapper"
 	"errors"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5"
@@ -56,7 +59,27 @@ func (r RefreshTokenHandler) Handle(
 		message := "claims and user data does not match"
 		return nil, common_exceptions.UnauthorizedException{
 			BaseRestException: exceptions.BaseRestException{
-				ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+				Message:             message,
+			},
+		}
+	}
+
+	if moderation.IsSuspended(&user, time.Now()) {
+		message := moderation.SuspensionMessage(&user)
+		return nil, common_exceptions.ForbiddenException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+				Message:             message,
+			},
+		}
+	}
+
+	if user.DeletedAt != nil {
+		message := "the account is scheduled for deletion"
+		return nil, common_exceptions.ForbiddenException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
 				Message:             message,
 			},
 		}
@@ -67,11 +90,9 @@ func (r RefreshTokenHandler) Handle(
 		return nil, exceptions.WrapErrorWithTrackableException(accessTokenGenerationError)
 	}
 
-	avatarDownloadLink, downloadLinkGenerationError := services.GetS3Client().
-		GetDownloadUrl(ctx, user.AvatarFileName, s3.AvatarsBucket)
-
-	if downloadLinkGenerationError != nil {
-		return nil, exceptions.WrapErrorWithTrackableException(downloadLinkGenerationError)
+	avatar, avatarGetError := shared_images.GetImage(ctx, services, user.AvatarFileName, s3.AvatarsBucket)
+	if avatarGetError != nil {
+		return nil, avatarGetError
 	}
 
 	var response refresh_token.RefreshTokenResponseDto
@@ -79,11 +100,13 @@ func (r RefreshTokenHandler) Handle(
 		&response,
 		user,
 		struct {
-			AccessToken        string
-			AvatarDownloadLink string
+			AccessToken          string
+			AvatarDownloadLink   string
+			AvatarThumbnailLinks map[string]string
 		}{
-			AvatarDownloadLink: avatarDownloadLink,
-			AccessToken:        accessToken.GetToken(),
+			AvatarDownloadLink:   avatar.DownloadLink,
+			AvatarThumbnailLinks: avatar.ThumbnailLinks,
+			AccessToken:          accessToken.GetToken(),
 		},
 	)
 	if mappingErr != nil {
@@ -1,17 +1,20 @@
 package users
 
 import (
+	shared_images "chat_app_backend/application/handlers/shared/images"
 	sharedinterests "chat_app_backend/application/handlers/shared/interests"
+	shared_webhooks "chat_app_backend/application/handlers/shared/webhooks"
 	interests "chat_app_backend/application/models/interests/get"
 	"chat_app_backend/application/models/jwt_claims"
 	"chat_app_backend/application/models/users/register"
+	"chat_app_backend/application/models/webhooks/events"
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/mapper"
-	"chat_app_backend/internal/password"
 	"chat_app_backend/internal/request_env"
 	"chat_app_backend/internal/s3"
 	"chat_app_backend/internal/service_wrapper"
 	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/webhooks"
 
 	"github.com/gin-gonic/gin"
 )
@@ -24,28 +27,43 @@ func (r RegisterHandler) Handle(
 	ctx *gin.Context,
 	_ *request_env.RequestEnv,
 ) (*register.RegisterResponseDto, exceptions.ITrackableException) {
+	if limitError := sharedinterests.CheckInterestsLimit(services, int64(len(request.Interests))); limitError != nil {
+		return nil, limitError
+	}
+
 	var response register.RegisterResponseDto
 
 	transactionError := services.
 		GetDbConnection().
 		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
-			_, avatarFileType, _ := s3.DeconstructFileName(request.Avatar.Filename)
-			avatarFileName := s3.ConstructFilenameFromFileType(avatarFileType)
-
-			avatarDownloadLink, fileUploadError := services.GetS3Client().
-				UploadFile(ctx, request.Avatar, avatarFileName, s3.AvatarsBucket)
+			var avatar *shared_images.StoredImage
+			var avatarError exceptions.ITrackableException
+
+			if request.Avatar != nil {
+				avatar, avatarError = shared_images.UploadImage(
+					ctx,
+					services,
+					request.Avatar,
+					s3.AvatarsBucket,
+					s3.Png,
+					s3.Jpeg,
+				)
+			} else {
+				avatar, avatarError = shared_images.GenerateAvatar(ctx, services, request.FullName)
+			}
 
-			if fileUploadError != nil {
-				return exceptions.WrapErrorWithTrackableException(fileUploadError)
+			if avatarError != nil {
+				return avatarError
 			}
 
 			createUserParams := db_queries.CreateUserParams{
-				FullName:       request.FullName,
-				Birthday:       request.Birthday,
-				Gender:         request.Gender,
-				Email:          request.Email,
-				Password:       password.HashPassword(request.Password),
-				AvatarFileName: avatarFileName,
+				FullName:        request.FullName,
+				Birthday:        request.Birthday,
+				Gender:          request.Gender,
+				Email:           request.Email,
+				Password:        services.GetPasswordHasher().HashPassword(request.Password),
+				AvatarFileName:  avatar.FileName,
+				AvatarGenerated: request.Avatar == nil,
 			}
 
 			user, createUserError := queries.CreateUser(ctx, createUserParams)
@@ -62,16 +80,34 @@ func (r RegisterHandler) Handle(
 				return exceptions.WrapErrorWithTrackableException(assignInterestError)
 			}
 
+			publishingError := shared_webhooks.PublishEvent(
+				ctx,
+				queries,
+				webhooks.EventUserRegistered,
+				events.UserRegisteredEventDto{
+					ID:        user.ID,
+					FullName:  user.FullName,
+					CreatedAt: user.CreatedAt,
+				},
+			)
+			if publishingError != nil {
+				return publishingError
+			}
+
 			rawInterests, getInterestsError := queries.GetUserInterests(ctx, user.ID)
 			if getInterestsError != nil {
 				return exceptions.WrapErrorWithTrackableException(getInterestsError)
 			}
 
-			mappedInterests, err := sharedinterests.GetInterestIcons(rawInterests, services.GetS3Client(), ctx)
+			mappedInterests, err := sharedinterests.GetInterestIcons(rawInterests, services, ctx)
 			if err != nil {
 				return err
 			}
 
+			if localizationError := sharedinterests.LocalizeInterests(mappedInterests, false, services, ctx); localizationError != nil {
+				return localizationError
+			}
+
 			var claims jwt_claims.UserClaims
 			mappingErr := mapper.Mapper{}.Map(&claims, user)
 			if mappingErr != nil {
@@ -90,15 +126,17 @@ func (r RegisterHandler) Handle(
 				&response,
 				user,
 				struct {
-					AvatarDownloadLink string
-					Interests          []interests.GetInterestResponseDto
-					AccessToken        string
-					RefreshToken       string
+					AvatarDownloadLink   string
+					AvatarThumbnailLinks map[string]string
+					Interests            []interests.GetInterestResponseDto
+					AccessToken          string
+					RefreshToken         string
 				}{
-					AvatarDownloadLink: avatarDownloadLink,
-					Interests:          mappedInterests,
-					AccessToken:        accessToken.GetToken(),
-					RefreshToken:       refreshToken.GetToken(),
+					AvatarDownloadLink:   avatar.DownloadLink,
+					AvatarThumbnailLinks: avatar.ThumbnailLinks,
+					Interests:            mappedInterests,
+					AccessToken:          accessToken.GetToken(),
+					RefreshToken:         refreshToken.GetToken(),
 				},
 			)
 
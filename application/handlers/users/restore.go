@@ -0,0 +1,60 @@
+package users
+
+import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	shared_users "chat_app_backend/application/handlers/shared/users"
+	"chat_app_backend/application/models/users/restore"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"errors"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+)
+
+type RestoreUserHandler struct{}
+
+func (r RestoreUserHandler) Handle(
+	request *restore.RestoreUserRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*restore.RestoreUserResponseDto, exceptions.ITrackableException) {
+	user, userQueryError := services.GetDbConnection().GetQueries().GetUserById(ctx, request.ID)
+
+	switch {
+	case errors.Is(userQueryError, pgx.ErrNoRows):
+		return nil, common_exceptions.ResourceNotFoundException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.WrapErrorWithTrackableException(userQueryError),
+				Message:             "user not found",
+			},
+		}
+	case userQueryError != nil:
+		return nil, exceptions.WrapErrorWithTrackableException(userQueryError)
+	}
+
+	if restorationError := shared_users.CheckRestorable(&user, time.Now()); restorationError != nil {
+		return nil, restorationError
+	}
+
+	transactionError := services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			_, restorationError := shared_users.RestoreUser(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				user,
+			)
+			return restorationError
+		})
+	if transactionError != nil {
+		return nil, transactionError
+	}
+
+	return &restore.RestoreUserResponseDto{}, nil
+}
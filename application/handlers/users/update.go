@@ -1,19 +1,25 @@
 package users
 
 import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	shared_images "chat_app_backend/application/handlers/shared/images"
 	"chat_app_backend/application/models/jwt_claims"
 	"chat_app_backend/application/models/users/update"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/etag"
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/exceptions/common_exceptions"
 	"chat_app_backend/internal/mapper"
-	"chat_app_backend/internal/password"
 	"chat_app_backend/internal/request_env"
 	"chat_app_backend/internal/s3"
 	"chat_app_backend/internal/service_wrapper"
 	"chat_app_backend/internal/sqlc/db_queries"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
 )
 
 type UpdateUserHandler struct{}
@@ -30,7 +36,7 @@ func (u UpdateUserHandler) Handle(
 		message := fmt.Sprintf("can't update user with id %s", request.ID)
 		return nil, common_exceptions.ForbiddenException{
 			BaseRestException: exceptions.BaseRestException{
-				ITrackableException: exceptions.CreateTrackableExceptionFromStringF(message),
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
 				Message:             message,
 			},
 		}
@@ -41,7 +47,7 @@ func (u UpdateUserHandler) Handle(
 	var newPasswordBytes *[]byte
 	if request.PasswordString != nil {
 		newPasswordBytes = new([]byte)
-		*newPasswordBytes = password.HashPassword(*request.PasswordString)
+		*newPasswordBytes = service.GetPasswordHasher().HashPassword(*request.PasswordString)
 	}
 
 	nullGender := db_queries.NullGender{}
@@ -56,91 +62,191 @@ func (u UpdateUserHandler) Handle(
 		nullRole.Valid = true
 	}
 
-	var downloadLink *string
-	newFileName := user.AvatarFileName
+	// admins can update other users, so the avatar has to be taken from the user being updated
+	targetUser := user
+	if request.ID != user.ID {
+		storedUser, userQueryError := service.GetDbConnection().GetQueries().GetUserById(ctx, request.ID)
 
-	if request.Avatar != nil {
-		fileUUID, oldFileType, _ := s3.DeconstructFileName(user.AvatarFileName)
-		_, newFileType, _ := s3.DeconstructFileName(request.Avatar.Filename)
-
-		if oldFileType != newFileType {
-			newFileName = fmt.Sprintf("%s.%s", fileUUID, newFileType)
+		switch {
+		case errors.Is(userQueryError, pgx.ErrNoRows):
+			return nil, common_exceptions.ResourceNotFoundException{
+				BaseRestException: exceptions.BaseRestException{
+					ITrackableException: exceptions.WrapErrorWithTrackableException(userQueryError),
+					Message:             "user not found",
+				},
+			}
+		case userQueryError != nil:
+			return nil, exceptions.WrapErrorWithTrackableException(userQueryError)
 		}
 
-		link, err := service.GetS3Client().
-			ModifyFileContents(ctx, request.Avatar, user.AvatarFileName, newFileName, s3.AvatarsBucket)
+		targetUser = storedUser
+	}
 
-		if err != nil {
-			return nil, exceptions.WrapErrorWithTrackableException(err)
-		}
+	// generated avatars are derived from the name, so they are regenerated when it changes, the avatars
+	// uploaded by the user are kept as they are
+	regenerateAvatar := request.Avatar == nil &&
+		targetUser.AvatarGenerated &&
+		request.FullName != nil &&
+		*request.FullName != targetUser.FullName
 
-		downloadLink = &link
+	var avatar *shared_images.StoredImage
+	var avatarError exceptions.ITrackableException
+	var avatarGenerated *bool
+
+	switch {
+	case request.Avatar != nil:
+		avatar, avatarError = shared_images.UploadImage(
+			ctx,
+			service,
+			request.Avatar,
+			s3.AvatarsBucket,
+			s3.Png,
+			s3.Jpeg,
+		)
+		avatarGenerated = new(bool)
+	case regenerateAvatar:
+		avatar, avatarError = shared_images.GenerateAvatar(ctx, service, *request.FullName)
+	default:
+		avatar, avatarError = shared_images.GetImage(ctx, service, targetUser.AvatarFileName, s3.AvatarsBucket)
 	}
 
-	if downloadLink == nil {
-		link, err := service.GetS3Client().
-			GetDownloadUrl(ctx, user.AvatarFileName, s3.AvatarsBucket)
+	if avatarError != nil {
+		return nil, avatarError
+	}
 
-		if err != nil {
-			return nil, exceptions.WrapErrorWithTrackableException(err)
+	// the uploaded or regenerated avatar is removed when the user doesn't get to point to it, so a failed
+	// update doesn't leave an orphaned file
+	removeNewAvatar := func() {
+		if avatar.FileName == targetUser.AvatarFileName {
+			return
 		}
 
-		downloadLink = &link
+		if removeError := shared_images.RemoveImage(ctx, service, avatar.FileName, s3.AvatarsBucket); removeError != nil {
+			service.GetLogger().
+				CreateErrorMessage(removeError).
+				Log()
+		}
 	}
 
 	mapperError := mapper.Mapper{}.Map(
 		&updateUserParams,
 		*request,
 		struct {
-			Password       *[]byte
-			AvatarFileName *string
-			Gender         db_queries.NullGender
-			Role           db_queries.NullRoleType
-			Online         *bool
+			Password         *[]byte
+			AvatarFileName   *string
+			AvatarGenerated  *bool
+			Gender           db_queries.NullGender
+			Role             db_queries.NullRoleType
+			Online           *bool
+			ExpectedVersions []time.Time
 		}{
-			Password:       newPasswordBytes,
-			AvatarFileName: &newFileName,
-			Gender:         nullGender,
-			Online:         nil,
-			Role:           nullRole,
+			Password:         newPasswordBytes,
+			AvatarFileName:   &avatar.FileName,
+			AvatarGenerated:  avatarGenerated,
+			Gender:           nullGender,
+			Online:           nil,
+			Role:             nullRole,
+			ExpectedVersions: etag.ExpectedVersions(ctx),
 		},
 	)
 
 	if mapperError != nil {
+		removeNewAvatar()
 		return nil, exceptions.WrapErrorWithTrackableException(mapperError)
 	}
 
-	newUser, updateUserError := service.GetDbConnection().GetQueries().UpdateUser(ctx, updateUserParams)
-	if updateUserError != nil {
-		return nil, exceptions.WrapErrorWithTrackableException(updateUserError)
+	var newUser db_queries.User
+
+	transactionError := service.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			updatedUser, updateUserError := queries.UpdateUser(ctx, updateUserParams)
+			switch {
+			case errors.Is(updateUserError, pgx.ErrNoRows):
+				return common_exceptions.PreconditionFailedException{
+					BaseRestException: exceptions.BaseRestException{
+						ITrackableException: exceptions.WrapErrorWithTrackableException(updateUserError),
+						Message:             "user was changed since it was read",
+					},
+				}
+			case updateUserError != nil:
+				return exceptions.WrapErrorWithTrackableException(updateUserError)
+			}
+
+			newUser = updatedUser
+
+			action := audit.ActionUserUpdated
+			if newUser.Role != targetUser.Role {
+				action = audit.ActionUserRoleChanged
+			}
+
+			return shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.ActorFromEnvironment(requestEnvironment),
+				shared_audit.Entry{
+					Action:     action,
+					TargetType: audit.TargetUser,
+					TargetID:   newUser.ID.String(),
+					Before:     targetUser,
+					After:      newUser,
+				},
+			)
+		})
+	if transactionError != nil {
+		removeNewAvatar()
+		return nil, transactionError
 	}
 
-	var claims jwt_claims.UserClaims
-	claimsMappingError := mapper.Mapper{}.Map(
-		&claims,
-		newUser,
-	)
-	if claimsMappingError != nil {
-		return nil, exceptions.WrapErrorWithTrackableException(claimsMappingError)
+	// the old avatar is removed only after the user points to the new one, failing to remove it leaves
+	// an orphaned file, which is better than a user without an avatar
+	if avatar.FileName != targetUser.AvatarFileName {
+		if removeError := shared_images.RemoveImage(ctx, service, targetUser.AvatarFileName, s3.AvatarsBucket); removeError != nil {
+			service.GetLogger().
+				CreateErrorMessage(removeError).
+				Log()
+		}
 	}
 
-	accessToken, refreshToken, tokenGenerationError := service.GetJwtHandler().GenerateJwtPair(claims)
-	if tokenGenerationError != nil {
-		return nil, exceptions.WrapErrorWithTrackableException(tokenGenerationError)
+	// the tokens are renewed only for the user's own session, as the claims change with the update. The
+	// admins updating other users would get a full session of the user otherwise
+	var accessToken, refreshToken *string
+	if request.ID == user.ID {
+		var claims jwt_claims.UserClaims
+		claimsMappingError := mapper.Mapper{}.Map(
+			&claims,
+			newUser,
+		)
+		if claimsMappingError != nil {
+			return nil, exceptions.WrapErrorWithTrackableException(claimsMappingError)
+		}
+
+		accessJwt, refreshJwt, tokenGenerationError := service.GetJwtHandler().GenerateJwtPair(claims)
+		if tokenGenerationError != nil {
+			return nil, exceptions.WrapErrorWithTrackableException(tokenGenerationError)
+		}
+
+		accessToken = new(string)
+		*accessToken = accessJwt.GetToken()
+		refreshToken = new(string)
+		*refreshToken = refreshJwt.GetToken()
 	}
 
+	etag.Set(ctx, newUser.UpdatedAt)
+
 	var response update.UpdateUserResponseDto
 	responseMappingError := mapper.Mapper{}.Map(
 		&response,
 		newUser,
 		struct {
-			AccessToken        string
-			RefreshToken       string
-			AvatarDownloadLink string
+			AccessToken          *string
+			RefreshToken         *string
+			AvatarDownloadLink   string
+			AvatarThumbnailLinks map[string]string
 		}{
-			AvatarDownloadLink: *downloadLink,
-			AccessToken:        accessToken.GetToken(),
-			RefreshToken:       refreshToken.GetToken(),
+			AvatarDownloadLink:   avatar.DownloadLink,
+			AvatarThumbnailLinks: avatar.ThumbnailLinks,
+			AccessToken:          accessToken,
+			RefreshToken:         refreshToken,
 		},
 	)
 	if responseMappingError != nil {
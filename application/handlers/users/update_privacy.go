@@ -0,0 +1,50 @@
+package users
+
+import (
+	"chat_app_backend/application/models/users/update_privacy"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UpdatePrivacyHandler struct{}
+
+func (u UpdatePrivacyHandler) Handle(
+	request *update_privacy.UpdatePrivacyRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*update_privacy.UpdatePrivacyResponseDto, exceptions.ITrackableException) {
+	user, updateError := services.GetDbConnection().GetQueries().UpdateUserPrivacySettings(
+		ctx,
+		db_queries.UpdateUserPrivacySettingsParams{
+			ProfileVisibility:     toNullVisibilityLevel(request.ProfileVisibility),
+			PresenceVisibility:    toNullVisibilityLevel(request.PresenceVisibility),
+			PrivateChatPermission: toNullVisibilityLevel(request.PrivateChatPermission),
+			ID:                    request.ID,
+		},
+	)
+	if updateError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(updateError)
+	}
+
+	var response update_privacy.UpdatePrivacyResponseDto
+	mappingError := mapper.Mapper{}.Map(&response, user)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
+
+func toNullVisibilityLevel(level *db_queries.VisibilityLevel) db_queries.NullVisibilityLevel {
+	if level == nil {
+		return db_queries.NullVisibilityLevel{}
+	}
+
+	return db_queries.NullVisibilityLevel{VisibilityLevel: *level, Valid: true}
+}
@@ -0,0 +1,59 @@
+package webhooks
+
+import (
+	"chat_app_backend/application/models/webhooks/create"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+)
+
+const generatedSecretSize = 32
+
+type CreateWebhookHandler struct{}
+
+func (c CreateWebhookHandler) Handle(
+	request *create.CreateWebhookRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	requestEnvironment *request_env.RequestEnv,
+) (*create.CreateWebhookResponseDto, exceptions.ITrackableException) {
+	var secret string
+	if request.Secret != nil {
+		secret = *request.Secret
+	} else {
+		secretBytes := make([]byte, generatedSecretSize)
+		if _, generationError := rand.Read(secretBytes); generationError != nil {
+			return nil, exceptions.WrapErrorWithTrackableException(generationError)
+		}
+		secret = hex.EncodeToString(secretBytes)
+	}
+
+	subscription, creationError := services.GetDbConnection().
+		GetQueries().
+		CreateWebhookSubscription(
+			ctx,
+			db_queries.CreateWebhookSubscriptionParams{
+				Url:        request.Url,
+				EventTypes: request.EventTypes,
+				Secret:     secret,
+				CreatedBy:  &requestEnvironment.User.ID,
+			},
+		)
+	if creationError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(creationError)
+	}
+
+	var response create.CreateWebhookResponseDto
+	mappingError := mapper.Mapper{}.Map(&response, subscription)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
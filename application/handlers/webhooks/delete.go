@@ -0,0 +1,25 @@
+package webhooks
+
+import (
+	"chat_app_backend/application/models/webhooks/delete"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DeleteWebhookHandler struct{}
+
+func (d DeleteWebhookHandler) Handle(
+	request *delete.DeleteWebhookRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*delete.DeleteWebhookResponseDto, exceptions.ITrackableException) {
+	if removalError := services.GetDbConnection().GetQueries().RemoveWebhookSubscription(ctx, request.ID); removalError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(removalError)
+	}
+
+	return &delete.DeleteWebhookResponseDto{}, nil
+}
@@ -0,0 +1,41 @@
+package webhooks
+
+import (
+	"chat_app_backend/application/models/webhooks/get"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetWebhooksHandler struct{}
+
+func (g GetWebhooksHandler) Handle(
+	_ *get.GetWebhooksRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*get.GetWebhooksResponseDto, exceptions.ITrackableException) {
+	subscriptions, queryError := services.GetDbConnection().GetQueries().GetWebhookSubscriptions(ctx)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	var response get.GetWebhooksResponseDto
+	mappingError := mapper.Mapper{}.Map(
+		&response,
+		struct {
+			Webhooks []db_queries.WebhookSubscription
+		}{
+			Webhooks: subscriptions,
+		},
+	)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,50 @@
+package webhooks
+
+import (
+	"chat_app_backend/application/models/webhooks/get_deliveries"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetDeliveriesHandler struct{}
+
+func (g GetDeliveriesHandler) Handle(
+	request *get_deliveries.GetDeliveriesRequestDto,
+	services service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	_ *request_env.RequestEnv,
+) (*get_deliveries.GetDeliveriesResponseDto, exceptions.ITrackableException) {
+	deliveries, queryError := services.GetDbConnection().
+		GetQueries().
+		GetWebhookDeliveries(
+			ctx,
+			db_queries.GetWebhookDeliveriesParams{
+				SubscriptionID: request.ID,
+				SkipCount:      request.Offset,
+				MaxCount:       request.Limit,
+			},
+		)
+	if queryError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(queryError)
+	}
+
+	var response get_deliveries.GetDeliveriesResponseDto
+	mappingError := mapper.Mapper{}.Map(
+		&response,
+		struct {
+			Deliveries []db_queries.WebhookDelivery
+		}{
+			Deliveries: deliveries,
+		},
+	)
+	if mappingError != nil {
+		return nil, exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	return &response, nil
+}
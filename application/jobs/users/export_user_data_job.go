@@ -0,0 +1,225 @@
+package users
+
+import (
+	"archive/zip"
+	"bytes"
+	"chat_app_backend/application/models/users/data_export"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/mapper"
+	"chat_app_backend/internal/s3"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+const exportContentType = "application/zip"
+
+type ExportUserDataJob struct {
+	Services  service_wrapper.IServiceWrapper
+	BatchSize int32
+}
+
+type exportedFile struct {
+	entryName  string
+	filename   string
+	bucketName s3.Buckets
+}
+
+func (e ExportUserDataJob) Run(ctx context.Context) exceptions.ITrackableException {
+	dataExports, claimError := e.Services.GetDbConnection().
+		GetQueries().
+		ClaimPendingDataExports(ctx, e.BatchSize)
+
+	if claimError != nil {
+		return exceptions.WrapErrorWithTrackableException(claimError)
+	}
+
+	for _, dataExport := range dataExports {
+		exportError := e.export(ctx, dataExport)
+		if exportError == nil {
+			continue
+		}
+
+		e.Services.GetLogger().
+			CreateErrorMessage(exportError).
+			Log()
+
+		if failError := e.Services.GetDbConnection().GetQueries().FailDataExport(ctx, dataExport.ID); failError != nil {
+			e.Services.GetLogger().
+				CreateErrorMessage(exceptions.WrapErrorWithTrackableException(failError)).
+				Log()
+		}
+	}
+
+	return nil
+}
+
+func (e ExportUserDataJob) export(ctx context.Context, dataExport db_queries.DataExport) exceptions.ITrackableException {
+	queries := e.Services.GetDbConnection().GetQueries()
+
+	user, userQueryError := queries.GetUserById(ctx, dataExport.UserID)
+	if userQueryError != nil {
+		return exceptions.WrapErrorWithTrackableException(userQueryError)
+	}
+
+	interests, interestsQueryError := queries.GetUserInterests(ctx, user.ID)
+	if interestsQueryError != nil {
+		return exceptions.WrapErrorWithTrackableException(interestsQueryError)
+	}
+
+	chats, chatsQueryError := queries.GetUserChats(ctx, user.ID)
+	if chatsQueryError != nil {
+		return exceptions.WrapErrorWithTrackableException(chatsQueryError)
+	}
+
+	messages, messagesQueryError := queries.GetUserMessages(ctx, user.ID)
+	if messagesQueryError != nil {
+		return exceptions.WrapErrorWithTrackableException(messagesQueryError)
+	}
+
+	attachments, attachmentsQueryError := queries.GetUserAttachments(ctx, user.ID)
+	if attachmentsQueryError != nil {
+		return exceptions.WrapErrorWithTrackableException(attachmentsQueryError)
+	}
+
+	var exportData data_export.DataExportDto
+	mappingError := mapper.Mapper{}.Map(
+		&exportData,
+		struct {
+			Profile     db_queries.User
+			Interests   []db_queries.Interest
+			Chats       []db_queries.Chat
+			Messages    []db_queries.Message
+			Attachments []db_queries.Attachment
+		}{
+			Profile:     user,
+			Interests:   interests,
+			Chats:       chats,
+			Messages:    messages,
+			Attachments: attachments,
+		},
+	)
+
+	if mappingError != nil {
+		return exceptions.WrapErrorWithTrackableException(mappingError)
+	}
+
+	buffer := new(bytes.Buffer)
+	archive := zip.NewWriter(buffer)
+
+	sections := []struct {
+		name string
+		data interface{}
+	}{
+		{name: "profile.json", data: exportData.Profile},
+		{name: "interests.json", data: exportData.Interests},
+		{name: "chats.json", data: exportData.Chats},
+		{name: "messages.json", data: exportData.Messages},
+		{name: "attachments.json", data: exportData.Attachments},
+	}
+
+	for _, section := range sections {
+		if err := writeJsonEntry(archive, section.name, section.data); err != nil {
+			return exceptions.WrapErrorWithTrackableException(err)
+		}
+	}
+
+	files := []exportedFile{
+		{entryName: fmt.Sprintf("avatar/%s", user.AvatarFileName), filename: user.AvatarFileName, bucketName: s3.AvatarsBucket},
+	}
+
+	for _, attachment := range attachments {
+		files = append(files, exportedFile{
+			entryName:  fmt.Sprintf("attachments/%s", attachment.Filename),
+			filename:   attachment.Filename,
+			bucketName: s3.AttachmentsBucket,
+		})
+	}
+
+	// a missing or unreadable file is listed in the archive instead of failing the export, which would
+	// never succeed otherwise
+	missingFiles := make([]data_export.MissingFileDto, 0)
+	for _, file := range files {
+		copied, err := e.copyFileEntry(ctx, archive, file)
+		if err != nil {
+			return exceptions.WrapErrorWithTrackableException(err)
+		}
+
+		if !copied {
+			missingFiles = append(missingFiles, data_export.MissingFileDto{Entry: file.entryName, Filename: file.filename})
+		}
+	}
+
+	if err := writeJsonEntry(archive, "missing_files.json", missingFiles); err != nil {
+		return exceptions.WrapErrorWithTrackableException(err)
+	}
+
+	if err := archive.Close(); err != nil {
+		return exceptions.WrapErrorWithTrackableException(err)
+	}
+
+	filename := s3.ConstructFilenameFromFileType(s3.Zip)
+	if _, uploadError := e.Services.GetS3Client().
+		UploadBytes(ctx, buffer.Bytes(), exportContentType, filename, s3.ExportsBucket); uploadError != nil {
+		return exceptions.WrapErrorWithTrackableException(uploadError)
+	}
+
+	completionError := queries.CompleteDataExport(ctx, db_queries.CompleteDataExportParams{
+		FileName: &filename,
+		ID:       dataExport.ID,
+	})
+
+	if completionError != nil {
+		return exceptions.WrapErrorWithTrackableException(completionError)
+	}
+
+	return nil
+}
+
+// copyFileEntry copies the file into the archive. The file is read before the entry is created, so the
+// files which can't be read are skipped without leaving a partial entry, in which case it returns false.
+// The error is returned only when the archive can't be written.
+func (e ExportUserDataJob) copyFileEntry(ctx context.Context, archive *zip.Writer, file exportedFile) (bool, error) {
+	data, readingError := e.readFile(ctx, file.filename, file.bucketName)
+	if readingError != nil {
+		e.Services.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(readingError)).
+			Log()
+		return false, nil
+	}
+
+	entry, entryCreationError := archive.Create(file.entryName)
+	if entryCreationError != nil {
+		return false, entryCreationError
+	}
+
+	_, writeError := entry.Write(data)
+	return writeError == nil, writeError
+}
+
+func (e ExportUserDataJob) readFile(ctx context.Context, filename string, bucketName s3.Buckets) ([]byte, error) {
+	file, fileGettingError := e.Services.GetS3Client().GetFile(ctx, filename, bucketName)
+	if fileGettingError != nil {
+		return nil, fileGettingError
+	}
+
+	defer func(file io.ReadCloser) {
+		_ = file.Close()
+	}(file)
+
+	return io.ReadAll(file)
+}
+
+func writeJsonEntry(archive *zip.Writer, entryName string, data interface{}) error {
+	entry, entryCreationError := archive.Create(entryName)
+	if entryCreationError != nil {
+		return entryCreationError
+	}
+
+	encoder := json.NewEncoder(entry)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(data)
+}
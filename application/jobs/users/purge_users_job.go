@@ -0,0 +1,156 @@
+package users
+
+import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	shared_images "chat_app_backend/application/handlers/shared/images"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/s3"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type PurgeUsersJob struct {
+	Services  service_wrapper.IServiceWrapper
+	BatchSize int32
+}
+
+type storedFile struct {
+	filename   string
+	bucketName s3.Buckets
+}
+
+func (p PurgeUsersJob) Run(ctx context.Context) exceptions.ITrackableException {
+	users, usersQueryError := p.Services.GetDbConnection().
+		GetQueries().
+		GetUsersToPurge(ctx, p.BatchSize)
+
+	if usersQueryError != nil {
+		return exceptions.WrapErrorWithTrackableException(usersQueryError)
+	}
+
+	for _, user := range users {
+		if purgeError := p.purge(ctx, user); purgeError != nil {
+			p.Services.GetLogger().
+				CreateErrorMessage(purgeError).
+				Log()
+		}
+	}
+
+	return nil
+}
+
+func (p PurgeUsersJob) purge(ctx context.Context, user db_queries.User) exceptions.ITrackableException {
+	queries := p.Services.GetDbConnection().GetQueries()
+
+	ownedChatAttachments, attachmentsQueryError := queries.GetUserOwnedChatAttachments(ctx, user.ID)
+	if attachmentsQueryError != nil {
+		return exceptions.WrapErrorWithTrackableException(attachmentsQueryError)
+	}
+
+	dataExports, exportsQueryError := queries.GetUserDataExports(ctx, user.ID)
+	if exportsQueryError != nil {
+		return exceptions.WrapErrorWithTrackableException(exportsQueryError)
+	}
+
+	files := make([]storedFile, 0)
+
+	for _, avatarFileName := range shared_images.GetStoredFileNames(p.Services, user.AvatarFileName) {
+		files = append(files, storedFile{filename: avatarFileName, bucketName: s3.AvatarsBucket})
+	}
+
+	for _, attachment := range ownedChatAttachments {
+		files = append(files, storedFile{filename: attachment.Filename, bucketName: s3.AttachmentsBucket})
+	}
+
+	for _, dataExport := range dataExports {
+		if dataExport.FileName != nil {
+			files = append(files, storedFile{filename: *dataExport.FileName, bucketName: s3.ExportsBucket})
+		}
+	}
+
+	purged := false
+
+	transactionError := p.Services.GetDbConnection().
+		CreateTransaction(ctx, func(queries *db_queries.Queries) exceptions.ITrackableException {
+			// the user is locked and checked again, as it could be restored since the batch was read. The
+			// restoration waits for the lock and fails once the user is purged
+			_, lockError := queries.LockUserToPurge(ctx, user.ID)
+			switch {
+			case errors.Is(lockError, pgx.ErrNoRows):
+				return nil
+			case lockError != nil:
+				return exceptions.WrapErrorWithTrackableException(lockError)
+			}
+
+			if anonymizationError := queries.AnonymizeUserMessages(ctx, user.ID); anonymizationError != nil {
+				return exceptions.WrapErrorWithTrackableException(anonymizationError)
+			}
+
+			if chatsRemovalError := queries.RemoveUserOwnedChats(ctx, user.ID); chatsRemovalError != nil {
+				return exceptions.WrapErrorWithTrackableException(chatsRemovalError)
+			}
+
+			// the interests of the user were subtracted from the statistics when it was soft deleted, the
+			// triggers skip the rows removed with the user, so the counters don't change here
+			removedCount, userRemovalError := queries.RemoveUser(ctx, user.ID)
+			if userRemovalError != nil {
+				return exceptions.WrapErrorWithTrackableException(userRemovalError)
+			}
+
+			if removedCount == 0 {
+				return exceptions.CreateTrackableExceptionFromStringF(
+					"user with id: %s can't be purged",
+					user.ID,
+				)
+			}
+
+			purged = true
+
+			// the purged data isn't copied into the entry, otherwise the append-only log would keep it forever
+			return shared_audit.Record(
+				ctx,
+				queries,
+				shared_audit.SystemActor,
+				shared_audit.Entry{
+					Action:     audit.ActionUserPurged,
+					TargetType: audit.TargetUser,
+					TargetID:   user.ID.String(),
+				},
+			)
+		})
+
+	if transactionError != nil || !purged {
+		return transactionError
+	}
+
+	for _, file := range files {
+		p.removeFile(ctx, file)
+	}
+
+	return nil
+}
+
+func (p PurgeUsersJob) removeFile(ctx context.Context, file storedFile) {
+	exists, existenceCheckError := p.Services.GetS3Client().FileExists(ctx, file.filename, file.bucketName)
+	if existenceCheckError != nil {
+		p.Services.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(existenceCheckError)).
+			Log()
+		return
+	}
+
+	if !exists {
+		return
+	}
+
+	if removeError := p.Services.GetS3Client().DeleteFile(ctx, file.filename, file.bucketName); removeError != nil {
+		p.Services.GetLogger().
+			CreateErrorMessage(exceptions.WrapErrorWithTrackableException(removeError)).
+			Log()
+	}
+}
@@ -0,0 +1,100 @@
+package webhooks
+
+import (
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/webhooks"
+	"context"
+	"time"
+)
+
+// DeliverWebhooksJob sends due deliveries. Claimed deliveries are hidden from other workers for
+// LeaseDuration, so a crash during sending leads to a retry instead of a lost delivery.
+type DeliverWebhooksJob struct {
+	Services      service_wrapper.IServiceWrapper
+	Sender        webhooks.ISender
+	BatchSize     int32
+	LeaseDuration time.Duration
+}
+
+func (d DeliverWebhooksJob) Run(ctx context.Context) exceptions.ITrackableException {
+	deliveries, claimError := d.Services.GetDbConnection().
+		GetQueries().
+		ClaimDueWebhookDeliveries(
+			ctx,
+			db_queries.ClaimDueWebhookDeliveriesParams{
+				LeaseSeconds: d.LeaseDuration.Seconds(),
+				MaxCount:     d.BatchSize,
+			},
+		)
+
+	if claimError != nil {
+		return exceptions.WrapErrorWithTrackableException(claimError)
+	}
+
+	for _, delivery := range deliveries {
+		if deliveryError := d.deliver(ctx, delivery); deliveryError != nil {
+			d.Services.GetLogger().
+				CreateErrorMessage(deliveryError).
+				Log()
+		}
+	}
+
+	return nil
+}
+
+func (d DeliverWebhooksJob) deliver(ctx context.Context, delivery db_queries.ClaimDueWebhookDeliveriesRow) exceptions.ITrackableException {
+	queries := d.Services.GetDbConnection().GetQueries()
+
+	result := d.Sender.Send(
+		ctx,
+		webhooks.Delivery{
+			ID:        delivery.ID.String(),
+			Url:       delivery.Url,
+			Secret:    delivery.Secret,
+			EventType: delivery.EventType,
+			Payload:   delivery.Payload,
+		},
+	)
+
+	if result.Succeeded() {
+		markingError := queries.MarkWebhookDeliveryDelivered(
+			ctx,
+			db_queries.MarkWebhookDeliveryDeliveredParams{
+				LastResponseCode: result.StatusCode,
+				ID:               delivery.ID,
+			},
+		)
+		if markingError != nil {
+			return exceptions.WrapErrorWithTrackableException(markingError)
+		}
+
+		return nil
+	}
+
+	retryPolicy := d.Sender.GetRetryPolicy()
+	failedAttempts := delivery.Attempts + 1
+
+	status := db_queries.WebhookDeliveryStatusFAILED
+	if retryPolicy.ShouldGiveUp(failedAttempts) {
+		status = db_queries.WebhookDeliveryStatusDEAD
+	}
+
+	lastError := result.Err.Error()
+	markingError := queries.MarkWebhookDeliveryFailed(
+		ctx,
+		db_queries.MarkWebhookDeliveryFailedParams{
+			Status:           status,
+			NextAttemptAt:    time.Now().Add(retryPolicy.NextDelay(failedAttempts)),
+			LastResponseCode: result.StatusCode,
+			LastError:        &lastError,
+			ID:               delivery.ID,
+		},
+	)
+	if markingError != nil {
+		return exceptions.WrapErrorWithTrackableException(markingError)
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package export_audit_log
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type ExportAuditLogRequestDto struct {
+	ActorID     *extensions.UUID `form:"actor_id"`
+	Action      *string          `form:"action"`
+	TargetType  *string          `form:"target_type"`
+	TargetID    *string          `form:"target_id"`
+	RequestID   *string          `form:"request_id"`
+	CreatedFrom *time.Time       `form:"created_from"`
+	CreatedTo   *time.Time       `form:"created_to"`
+}
@@ -0,0 +1,7 @@
+package force_logout
+
+import "chat_app_backend/internal/extensions"
+
+type ForceLogoutRequestDto struct {
+	ID extensions.UUID `uri:"id" validator:"not_empty"`
+}
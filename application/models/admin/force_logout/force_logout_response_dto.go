@@ -0,0 +1,7 @@
+package force_logout
+
+import "chat_app_backend/application/models/admin/get_users"
+
+type ForceLogoutResponseDto struct {
+	User get_users.GetUserResponseDto `json:"user"`
+}
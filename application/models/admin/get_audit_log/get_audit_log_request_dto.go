@@ -0,0 +1,18 @@
+package get_audit_log
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type GetAuditLogRequestDto struct {
+	ActorID     *extensions.UUID `form:"actor_id"`
+	Action      *string          `form:"action"`
+	TargetType  *string          `form:"target_type"`
+	TargetID    *string          `form:"target_id"`
+	RequestID   *string          `form:"request_id"`
+	CreatedFrom *time.Time       `form:"created_from"`
+	CreatedTo   *time.Time       `form:"created_to"`
+	Limit       int32            `form:"limit,default=20" validator:"gt 0;lte 100"`
+	Offset      int32            `form:"offset" validator:"gte 0"`
+}
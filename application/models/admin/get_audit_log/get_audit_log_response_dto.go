@@ -0,0 +1,25 @@
+package get_audit_log
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"encoding/json"
+	"time"
+)
+
+type GetAuditLogEntryResponseDto struct {
+	ID         extensions.UUID           `json:"id"`
+	ActorType  db_queries.AuditActorType `json:"actor_type"`
+	ActorID    *extensions.UUID          `json:"actor_id"`
+	Action     string                    `json:"action"`
+	TargetType string                    `json:"target_type"`
+	TargetID   string                    `json:"target_id"`
+	Changes    json.RawMessage           `json:"changes"`
+	Ip         *string                   `json:"ip"`
+	RequestID  *string                   `json:"request_id"`
+	CreatedAt  time.Time                 `json:"created_at"`
+}
+
+type GetAuditLogResponseDto struct {
+	Entries []GetAuditLogEntryResponseDto `json:"entries" csv:"rows"`
+}
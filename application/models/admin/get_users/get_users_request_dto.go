@@ -0,0 +1,24 @@
+package get_users
+
+import (
+	"chat_app_backend/internal/sqlc/db_queries"
+	"time"
+)
+
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+type GetUsersRequestDto struct {
+	Role          *db_queries.RoleType `form:"role"`
+	EmailVerified *bool                `form:"email_verified"`
+	Online        *bool                `form:"online"`
+	CreatedFrom   *time.Time           `form:"created_from"`
+	CreatedTo     *time.Time           `form:"created_to"`
+	Search        *string              `form:"search" validator:"length lt 255"`
+	SortBy        string               `form:"sort_by,default=created_at" validator:"one_of [created_at,full_name,email,last_seen]"`
+	Order         string               `form:"order,default=desc" validator:"one_of [asc,desc]"`
+	Limit         int32                `form:"limit,default=20" validator:"gt 0;lte 100"`
+	Offset        int32                `form:"offset" validator:"gte 0"`
+}
@@ -0,0 +1,27 @@
+package get_users
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"time"
+)
+
+type GetUserResponseDto struct {
+	ID               extensions.UUID     `json:"id"`
+	FullName         string              `json:"full_name"`
+	Email            string              `json:"email"`
+	Role             db_queries.RoleType `json:"role"`
+	EmailVerified    bool                `json:"email_verified"`
+	Online           bool                `json:"online"`
+	LastSeen         time.Time           `json:"last_seen"`
+	CreatedAt        time.Time           `json:"created_at"`
+	UpdatedAt        time.Time           `json:"updated_at"`
+	DeletedAt        *time.Time          `json:"deleted_at"`
+	SuspendedUntil   *time.Time          `json:"suspended_until"`
+	SuspensionReason *string             `json:"suspension_reason"`
+}
+
+type GetUsersResponseDto struct {
+	Users []GetUserResponseDto `json:"users" csv:"rows"`
+	Total int64                `json:"total"`
+}
@@ -0,0 +1,7 @@
+package impersonate
+
+import "chat_app_backend/internal/extensions"
+
+type ImpersonateRequestDto struct {
+	ID extensions.UUID `uri:"id" validator:"not_empty"`
+}
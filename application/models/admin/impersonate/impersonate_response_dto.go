@@ -0,0 +1,10 @@
+package impersonate
+
+import "chat_app_backend/application/models/admin/get_users"
+
+// ImpersonateResponseDto has no refresh token, a new impersonation has to be started once the access
+// token expires.
+type ImpersonateResponseDto struct {
+	AccessToken string                       `json:"access_token"`
+	User        get_users.GetUserResponseDto `json:"user"`
+}
@@ -0,0 +1,7 @@
+package lift_suspension
+
+import "chat_app_backend/internal/extensions"
+
+type LiftSuspensionRequestDto struct {
+	ID extensions.UUID `uri:"id" validator:"not_empty"`
+}
@@ -0,0 +1,7 @@
+package lift_suspension
+
+import "chat_app_backend/application/models/admin/get_users"
+
+type LiftSuspensionResponseDto struct {
+	User get_users.GetUserResponseDto `json:"user"`
+}
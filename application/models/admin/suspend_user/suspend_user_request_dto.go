@@ -0,0 +1,12 @@
+package suspend_user
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type SuspendUserRequestDto struct {
+	ID             extensions.UUID `uri:"id" validator:"not_empty"`
+	SuspendedUntil time.Time       `json:"suspended_until" validator:"not_empty"`
+	Reason         string          `json:"reason" validator:"not_empty;length lt 1000"`
+}
@@ -0,0 +1,7 @@
+package suspend_user
+
+import "chat_app_backend/application/models/admin/get_users"
+
+type SuspendUserResponseDto struct {
+	User get_users.GetUserResponseDto `json:"user"`
+}
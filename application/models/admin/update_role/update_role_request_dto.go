@@ -0,0 +1,11 @@
+package update_role
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+)
+
+type UpdateRoleRequestDto struct {
+	ID   extensions.UUID     `uri:"id" validator:"not_empty"`
+	Role db_queries.RoleType `json:"role" validator:"not_empty"`
+}
@@ -0,0 +1,7 @@
+package update_role
+
+import "chat_app_backend/application/models/admin/get_users"
+
+type UpdateRoleResponseDto struct {
+	User get_users.GetUserResponseDto `json:"user"`
+}
@@ -0,0 +1,3 @@
+package get_chats
+
+type GetBotChatsRequestDto struct{}
@@ -0,0 +1,19 @@
+package get_chats
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"time"
+)
+
+type GetBotChatResponseDto struct {
+	ID        extensions.UUID     `json:"id"`
+	Title     *string             `json:"title"`
+	CType     db_queries.ChatType `json:"c_type"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+type GetBotChatsResponseDto struct {
+	Chats []GetBotChatResponseDto `json:"chats" csv:"rows"`
+}
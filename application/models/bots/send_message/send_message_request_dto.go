@@ -0,0 +1,9 @@
+package send_message
+
+import "chat_app_backend/internal/extensions"
+
+type SendMessageRequestDto struct {
+	ChatID             extensions.UUID  `uri:"chat_id" validator:"not_empty"`
+	RawText            string           `json:"raw_text" validator:"not_empty;length lt 2048"`
+	MessageReferenceID *extensions.UUID `json:"message_reference_id"`
+}
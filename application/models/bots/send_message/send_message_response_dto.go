@@ -0,0 +1,15 @@
+package send_message
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type SendMessageResponseDto struct {
+	ID                     extensions.UUID  `json:"id"`
+	ChatID                 extensions.UUID  `json:"chat_id"`
+	SenderServiceAccountID *extensions.UUID `json:"sender_service_account_id"`
+	RawText                *string          `json:"raw_text"`
+	MessageReferenceID     *extensions.UUID `json:"message_reference_id"`
+	CreatedAt              time.Time        `json:"created_at"`
+}
@@ -0,0 +1,8 @@
+package add_bot
+
+import "chat_app_backend/internal/extensions"
+
+type AddBotRequestDto struct {
+	ChatID           extensions.UUID `uri:"chat_id" validator:"not_empty"`
+	ServiceAccountID extensions.UUID `json:"service_account_id" validator:"not_empty"`
+}
@@ -0,0 +1,3 @@
+package add_bot
+
+type AddBotResponseDto struct{}
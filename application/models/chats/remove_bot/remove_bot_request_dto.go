@@ -0,0 +1,8 @@
+package remove_bot
+
+import "chat_app_backend/internal/extensions"
+
+type RemoveBotRequestDto struct {
+	ChatID           extensions.UUID `uri:"chat_id" validator:"not_empty"`
+	ServiceAccountID extensions.UUID `uri:"service_account_id" validator:"not_empty"`
+}
@@ -0,0 +1,3 @@
+package remove_bot
+
+type RemoveBotResponseDto struct{}
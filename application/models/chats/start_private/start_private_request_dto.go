@@ -0,0 +1,7 @@
+package start_private
+
+import "chat_app_backend/internal/extensions"
+
+type StartPrivateChatRequestDto struct {
+	UserID extensions.UUID `json:"user_id" validator:"not_empty"`
+}
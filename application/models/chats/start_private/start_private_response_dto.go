@@ -0,0 +1,13 @@
+package start_private
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"time"
+)
+
+type StartPrivateChatResponseDto struct {
+	ID        extensions.UUID     `json:"id"`
+	CType     db_queries.ChatType `json:"c_type"`
+	CreatedAt time.Time           `json:"created_at"`
+}
@@ -0,0 +1,6 @@
+package get
+
+type GetContactsRequestDto struct {
+	Limit  int32 `form:"limit,default=20" validator:"gt 0;lte 100"`
+	Offset int32 `form:"offset" validator:"gte 0"`
+}
@@ -0,0 +1,19 @@
+package get
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type GetContactResponseDto struct {
+	ID           extensions.UUID `json:"id"`
+	FullName     string          `json:"full_name"`
+	Online       *bool           `json:"online,omitempty"`
+	LastSeen     *time.Time      `json:"last_seen,omitempty"`
+	ContactSince time.Time       `json:"contact_since"`
+}
+
+type GetContactsResponseDto struct {
+	Contacts []GetContactResponseDto `json:"contacts" csv:"rows"`
+	Total    int64                   `json:"total"`
+}
@@ -0,0 +1,12 @@
+package get_requests
+
+const (
+	DirectionIncoming = "incoming"
+	DirectionOutgoing = "outgoing"
+)
+
+type GetContactRequestsRequestDto struct {
+	Direction string `form:"direction,default=incoming"`
+	Limit     int32  `form:"limit,default=20" validator:"gt 0;lte 100"`
+	Offset    int32  `form:"offset" validator:"gte 0"`
+}
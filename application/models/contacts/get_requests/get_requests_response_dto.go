@@ -0,0 +1,19 @@
+package get_requests
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"time"
+)
+
+type GetContactRequestResponseDto struct {
+	ID         extensions.UUID                 `json:"id"`
+	SenderID   extensions.UUID                 `json:"sender_id"`
+	ReceiverID extensions.UUID                 `json:"receiver_id"`
+	Status     db_queries.ContactRequestStatus `json:"status"`
+	CreatedAt  time.Time                       `json:"created_at"`
+}
+
+type GetContactRequestsResponseDto struct {
+	Requests []GetContactRequestResponseDto `json:"requests" csv:"rows"`
+}
@@ -0,0 +1,7 @@
+package remove
+
+import "chat_app_backend/internal/extensions"
+
+type RemoveContactRequestDto struct {
+	UserID extensions.UUID `uri:"user_id" validator:"not_empty"`
+}
@@ -0,0 +1,3 @@
+package remove
+
+type RemoveContactResponseDto struct{}
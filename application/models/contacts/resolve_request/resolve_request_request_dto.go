@@ -0,0 +1,7 @@
+package resolve_request
+
+import "chat_app_backend/internal/extensions"
+
+type ResolveContactRequestRequestDto struct {
+	ID extensions.UUID `uri:"id" validator:"not_empty"`
+}
@@ -0,0 +1,16 @@
+package resolve_request
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"time"
+)
+
+type ResolveContactRequestResponseDto struct {
+	ID         extensions.UUID                 `json:"id"`
+	SenderID   extensions.UUID                 `json:"sender_id"`
+	ReceiverID extensions.UUID                 `json:"receiver_id"`
+	Status     db_queries.ContactRequestStatus `json:"status"`
+	CreatedAt  time.Time                       `json:"created_at"`
+	UpdatedAt  time.Time                       `json:"updated_at"`
+}
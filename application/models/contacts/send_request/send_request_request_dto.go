@@ -0,0 +1,7 @@
+package send_request
+
+import "chat_app_backend/internal/extensions"
+
+type SendContactRequestRequestDto struct {
+	ReceiverID extensions.UUID `json:"receiver_id" validator:"not_empty"`
+}
@@ -0,0 +1,15 @@
+package send_request
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"time"
+)
+
+type SendContactRequestResponseDto struct {
+	ID         extensions.UUID                 `json:"id"`
+	SenderID   extensions.UUID                 `json:"sender_id"`
+	ReceiverID extensions.UUID                 `json:"receiver_id"`
+	Status     db_queries.ContactRequestStatus `json:"status"`
+	CreatedAt  time.Time                       `json:"created_at"`
+}
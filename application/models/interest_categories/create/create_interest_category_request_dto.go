@@ -0,0 +1,9 @@
+package create
+
+import "chat_app_backend/internal/extensions"
+
+type CreateInterestCategoryRequestDto struct {
+	Title    string           `json:"title" validator:"not_empty;length lt 255"`
+	ParentID *extensions.UUID `json:"parent_id"`
+	Position int32            `json:"position" validator:"gte 0"`
+}
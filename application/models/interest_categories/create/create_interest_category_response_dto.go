@@ -0,0 +1,15 @@
+package create
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type CreateInterestCategoryResponseDto struct {
+	ID        extensions.UUID  `json:"id"`
+	ParentID  *extensions.UUID `json:"parent_id"`
+	Title     string           `json:"title"`
+	Position  int32            `json:"position"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
@@ -0,0 +1,7 @@
+package delete
+
+import "chat_app_backend/internal/extensions"
+
+type DeleteInterestCategoryRequestDto struct {
+	ID extensions.UUID `uri:"id" validator:"not_empty"`
+}
@@ -0,0 +1,4 @@
+package delete
+
+type DeleteInterestCategoryResponseDto struct {
+}
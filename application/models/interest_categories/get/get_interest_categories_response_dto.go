@@ -0,0 +1,19 @@
+package get
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type GetInterestCategoryResponseDto struct {
+	ID        extensions.UUID  `json:"id"`
+	ParentID  *extensions.UUID `json:"parent_id"`
+	Title     string           `json:"title"`
+	Position  int32            `json:"position"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+type GetInterestCategoriesResponseDto struct {
+	Categories []GetInterestCategoryResponseDto `json:"categories" csv:"rows"`
+}
@@ -0,0 +1,13 @@
+package update
+
+import "chat_app_backend/internal/extensions"
+
+// UpdateInterestCategoryRequestDto changes only the passed fields, ClearParent moves the category to
+// the root and takes precedence over ParentID.
+type UpdateInterestCategoryRequestDto struct {
+	ID          extensions.UUID  `uri:"id" validator:"not_empty"`
+	Title       *string          `json:"title" validator:"length lt 255"`
+	ParentID    *extensions.UUID `json:"parent_id"`
+	ClearParent bool             `json:"clear_parent"`
+	Position    *int32           `json:"position" validator:"gte 0"`
+}
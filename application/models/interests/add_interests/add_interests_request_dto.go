@@ -0,0 +1,10 @@
+package add_interests
+
+import "chat_app_backend/internal/extensions"
+
+// AddInterestsRequestDto adds the interests to the ones the user has, the interests the user already has
+// are skipped.
+type AddInterestsRequestDto struct {
+	UserID      extensions.UUID   `json:"user_id" validator:"not_empty"`
+	InterestIds []extensions.UUID `json:"interest_ids" validator:"not_empty"`
+}
@@ -0,0 +1,8 @@
+package add_interests
+
+import "chat_app_backend/application/models/interests/get"
+
+// AddInterestsResponseDto has all the interests of the user after the change.
+type AddInterestsResponseDto struct {
+	Interests []get.GetInterestResponseDto `json:"interests"`
+}
@@ -1,6 +1,7 @@
 package create
 
 import (
+	"chat_app_backend/internal/extensions"
 	"mime/multipart"
 )
 
@@ -8,4 +9,6 @@ type CreateInterestRequestDto struct {
 	Title       string                `form:"title" validator:"not_empty;length lt 255"`
 	Icon        *multipart.FileHeader `form:"icon" validator:"not_empty"`
 	Description string                `form:"description" validator:"not_empty"`
+	CategoryID  *extensions.UUID      `form:"category_id"`
+	Position    int32                 `form:"position" validator:"gte 0"`
 }
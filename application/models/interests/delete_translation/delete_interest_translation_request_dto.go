@@ -0,0 +1,8 @@
+package delete_translation
+
+import "chat_app_backend/internal/extensions"
+
+type DeleteInterestTranslationRequestDto struct {
+	ID     extensions.UUID `uri:"id" validator:"not_empty"`
+	Locale string          `uri:"locale" validator:"not_empty"`
+}
@@ -0,0 +1,4 @@
+package delete_translation
+
+type DeleteInterestTranslationResponseDto struct {
+}
@@ -0,0 +1,5 @@
+package export_interests
+
+type ExportInterestsRequestDto struct {
+	Format string `form:"format,default=json" validator:"one_of [csv,json]"`
+}
@@ -0,0 +1,11 @@
+package export_stats
+
+const (
+	FormatCsv  = "csv"
+	FormatJson = "json"
+)
+
+type ExportInterestStatsRequestDto struct {
+	WindowDays int32  `form:"window_days,default=7" validator:"gt 0;lte 365"`
+	Format     string `form:"format,default=csv" validator:"one_of [csv,json]"`
+}
@@ -2,7 +2,34 @@ package get
 
 import "chat_app_backend/internal/extensions"
 
+const (
+	SortByTitle      = "title"
+	SortByCreatedAt  = "created_at"
+	SortByPopularity = "popularity"
+	SortByPosition   = "position"
+)
+
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// GetInterestsRequestDto filters by CategoryID, including the nested categories when
+// IncludeSubcategories is set. Tree groups the found interests by the category tree. Search matches
+// the words of the title and the description by prefix. SortBy defaults to the position within the
+// categories when CategoryID or Tree is set and to the title otherwise. Cursor is taken from the previous
+// page and has to be used with the same sort. AllTranslations adds the text of the interests in every
+// locale. Name is the deprecated name of Search, it is ignored when Search is set.
 type GetInterestsRequestDto struct {
-	Name *string           `json:"name" validator:"length lt 255"`
-	Ids  []extensions.UUID `json:"ids"`
+	Search               *string           `json:"search" validator:"length lt 255"`
+	Name                 *string           `json:"name" validator:"length lt 255"`
+	Ids                  []extensions.UUID `json:"ids"`
+	CategoryID           *extensions.UUID  `json:"category_id"`
+	IncludeSubcategories bool              `json:"include_subcategories"`
+	Tree                 bool              `json:"tree"`
+	SortBy               *string           `json:"sort_by" validator:"one_of [title,created_at,popularity,position]"`
+	Order                *string           `json:"order" validator:"one_of [asc,desc]"`
+	Cursor               *string           `json:"cursor" validator:"length lt 1024"`
+	Limit                *int32            `json:"limit" validator:"gt 0;lte 100"`
+	AllTranslations      bool              `json:"all_translations"`
 }
@@ -5,15 +5,50 @@ import (
 	"time"
 )
 
+type InterestTranslationDto struct {
+	Locale      string `json:"locale"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// GetInterestResponseDto has Title and Description in Locale, which is resolved from the Accept-Language
+// header. Translations has the text in every locale, the default one included, and is set only when all
+// the translations are requested. ETag is the version of the interest to send in If-Match.
 type GetInterestResponseDto struct {
-	ID               extensions.UUID `json:"id"`
-	Title            string          `json:"title"`
-	Description      string          `json:"description"`
-	IconDownloadLink string          `json:"icon_download_link"`
-	CreatedAt        time.Time       `json:"created_at"`
-	UpdatedAt        time.Time       `json:"updated_at"`
+	ID                 extensions.UUID          `json:"id"`
+	Title              string                   `json:"title"`
+	Description        string                   `json:"description"`
+	Locale             string                   `json:"locale" mapper:"exclude"`
+	Translations       []InterestTranslationDto `json:"translations,omitempty" mapper:"exclude"`
+	IconDownloadLink   string                   `json:"icon_download_link"`
+	IconThumbnailLinks map[string]string        `json:"icon_thumbnail_links"`
+	CategoryID         *extensions.UUID         `json:"category_id"`
+	Position           int32                    `json:"position"`
+	CreatedAt          time.Time                `json:"created_at"`
+	UpdatedAt          time.Time                `json:"updated_at"`
+	ETag               string                   `json:"etag"`
+}
+
+type InterestCategoryNodeDto struct {
+	ID            extensions.UUID           `json:"id"`
+	ParentID      *extensions.UUID          `json:"parent_id"`
+	Title         string                    `json:"title"`
+	Position      int32                     `json:"position"`
+	Interests     []GetInterestResponseDto  `json:"interests"`
+	Subcategories []InterestCategoryNodeDto `json:"subcategories"`
+}
+
+// PaginationDto has NextCursor only when there are more interests after the page.
+type PaginationDto struct {
+	Limit      int32   `json:"limit"`
+	HasMore    bool    `json:"has_more"`
+	NextCursor *string `json:"next_cursor"`
 }
 
+// GetInterestsResponseDto has Categories only when the tree is requested, the interests of the page are
+// placed in their categories then and Interests keeps only the uncategorized ones.
 type GetInterestsResponseDto struct {
-	Interests []GetInterestResponseDto `json:"interests"`
+	Interests  []GetInterestResponseDto  `json:"interests" csv:"rows"`
+	Categories []InterestCategoryNodeDto `json:"categories,omitempty"`
+	Pagination PaginationDto             `json:"pagination"`
 }
@@ -0,0 +1,7 @@
+package get_translations
+
+import "chat_app_backend/internal/extensions"
+
+type GetInterestTranslationsRequestDto struct {
+	ID extensions.UUID `uri:"id" validator:"not_empty"`
+}
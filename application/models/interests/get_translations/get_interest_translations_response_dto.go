@@ -0,0 +1,22 @@
+package get_translations
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type InterestTranslationResponseDto struct {
+	Locale      string    `json:"locale"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// GetInterestTranslationsResponseDto has only the stored translations, the text of the interest itself
+// is used for DefaultLocale.
+type GetInterestTranslationsResponseDto struct {
+	InterestID    extensions.UUID                  `json:"interest_id"`
+	DefaultLocale string                           `json:"default_locale"`
+	Translations  []InterestTranslationResponseDto `json:"translations" csv:"rows"`
+}
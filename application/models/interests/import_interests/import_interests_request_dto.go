@@ -0,0 +1,10 @@
+package import_interests
+
+import "mime/multipart"
+
+// ImportInterestsRequestDto takes a zip with manifest.json or manifest.csv and the icons it refers to.
+// Nothing is changed on DryRun, the response shows what the import would do then.
+type ImportInterestsRequestDto struct {
+	Archive *multipart.FileHeader `form:"archive" validator:"not_empty"`
+	DryRun  bool                  `form:"dry_run"`
+}
@@ -0,0 +1,30 @@
+package import_interests
+
+import "chat_app_backend/internal/extensions"
+
+const (
+	ActionCreated   = "created"
+	ActionUpdated   = "updated"
+	ActionUnchanged = "unchanged"
+)
+
+// ImportedInterestDto describes a row of the manifest, rows are numbered from one. Action is empty for
+// the rows with errors.
+type ImportedInterestDto struct {
+	Row        int32            `json:"row"`
+	Title      string           `json:"title"`
+	Action     string           `json:"action"`
+	InterestID *extensions.UUID `json:"interest_id"`
+	Errors     []string         `json:"errors"`
+}
+
+// ImportInterestsResponseDto has Applied only when the import wasn't a dry run and every row was valid,
+// a single invalid row keeps the whole import from being applied.
+type ImportInterestsResponseDto struct {
+	DryRun    bool                  `json:"dry_run"`
+	Applied   bool                  `json:"applied"`
+	Created   int32                 `json:"created"`
+	Updated   int32                 `json:"updated"`
+	Unchanged int32                 `json:"unchanged"`
+	Rows      []ImportedInterestDto `json:"rows"`
+}
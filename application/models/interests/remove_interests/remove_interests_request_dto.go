@@ -0,0 +1,9 @@
+package remove_interests
+
+import "chat_app_backend/internal/extensions"
+
+// RemoveInterestsRequestDto removes only the passed interests, the ones the user doesn't have are skipped.
+type RemoveInterestsRequestDto struct {
+	UserID      extensions.UUID   `json:"user_id" validator:"not_empty"`
+	InterestIds []extensions.UUID `json:"interest_ids" validator:"not_empty"`
+}
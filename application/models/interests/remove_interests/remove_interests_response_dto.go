@@ -0,0 +1,8 @@
+package remove_interests
+
+import "chat_app_backend/application/models/interests/get"
+
+// RemoveInterestsResponseDto has all the interests of the user after the change.
+type RemoveInterestsResponseDto struct {
+	Interests []get.GetInterestResponseDto `json:"interests"`
+}
@@ -0,0 +1,9 @@
+package stats
+
+// GetInterestStatsRequestDto counts the additions and removals of the last WindowDays days, the days are
+// in utc and the current day is included.
+type GetInterestStatsRequestDto struct {
+	WindowDays int32 `form:"window_days,default=7" validator:"gt 0;lte 365"`
+	Limit      int32 `form:"limit,default=20" validator:"gt 0;lte 100"`
+	Offset     int32 `form:"offset" validator:"gte 0"`
+}
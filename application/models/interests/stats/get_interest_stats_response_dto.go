@@ -0,0 +1,20 @@
+package stats
+
+import "chat_app_backend/internal/extensions"
+
+// InterestStatsResponseDto has GrowthRate relative to the users count at the start of the window, it is
+// nil when the interest had no users then.
+type InterestStatsResponseDto struct {
+	InterestID extensions.UUID `json:"interest_id"`
+	Title      string          `json:"title"`
+	UsersCount int64           `json:"users_count"`
+	Added      int64           `json:"added"`
+	Removed    int64           `json:"removed"`
+	Growth     int64           `json:"growth"`
+	GrowthRate *float64        `json:"growth_rate"`
+}
+
+type GetInterestStatsResponseDto struct {
+	WindowDays int32                      `json:"window_days"`
+	Interests  []InterestStatsResponseDto `json:"interests" csv:"rows"`
+}
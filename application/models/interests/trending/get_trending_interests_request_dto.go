@@ -0,0 +1,6 @@
+package trending
+
+type GetTrendingInterestsRequestDto struct {
+	WindowDays int32 `form:"window_days,default=7" validator:"gt 0;lte 365"`
+	Limit      int32 `form:"limit,default=10" validator:"gt 0;lte 100"`
+}
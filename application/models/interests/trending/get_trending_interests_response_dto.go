@@ -0,0 +1,9 @@
+package trending
+
+import "chat_app_backend/application/models/interests/stats"
+
+// GetTrendingInterestsResponseDto has the interests that gained the most users during the window.
+type GetTrendingInterestsResponseDto struct {
+	WindowDays int32                            `json:"window_days"`
+	Interests  []stats.InterestStatsResponseDto `json:"interests" csv:"rows"`
+}
@@ -5,8 +5,13 @@ import (
 	"mime/multipart"
 )
 
+// UpdateInterestRequestDto changes only the passed fields, ClearCategory moves the interest out of its
+// category and takes precedence over CategoryID.
 type UpdateInterestRequestDto struct {
-	ID          extensions.UUID       `uri:"id" validator:"not_empty"`
-	Icon        *multipart.FileHeader `form:"icon" mapper:"exclude"`
-	Description *string               `form:"description" mapper:"exclude"`
+	ID            extensions.UUID       `uri:"id" validator:"not_empty"`
+	Icon          *multipart.FileHeader `form:"icon" mapper:"exclude"`
+	Description   *string               `form:"description"`
+	CategoryID    *extensions.UUID      `form:"category_id"`
+	ClearCategory bool                  `form:"clear_category"`
+	Position      *int32                `form:"position" validator:"gte 0"`
 }
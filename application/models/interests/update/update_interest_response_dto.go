@@ -6,10 +6,14 @@ import (
 )
 
 type UpdateInterestResponseDto struct {
-	ID               extensions.UUID `json:"id"`
-	Title            string          `json:"title"`
-	Description      string          `json:"description"`
-	IconDownloadLink string          `json:"icon_download_link"`
-	CreatedAt        time.Time       `json:"created_at"`
-	UpdatedAt        time.Time       `json:"updated_at"`
+	ID                 extensions.UUID   `json:"id"`
+	Title              string            `json:"title"`
+	Description        string            `json:"description"`
+	IconDownloadLink   string            `json:"icon_download_link"`
+	IconThumbnailLinks map[string]string `json:"icon_thumbnail_links"`
+	CategoryID         *extensions.UUID  `json:"category_id"`
+	Position           int32             `json:"position"`
+	CreatedAt          time.Time         `json:"created_at"`
+	UpdatedAt          time.Time         `json:"updated_at"`
+	ETag               string            `json:"etag"`
 }
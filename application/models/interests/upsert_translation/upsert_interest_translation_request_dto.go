@@ -0,0 +1,11 @@
+package upsert_translation
+
+import "chat_app_backend/internal/extensions"
+
+// UpsertInterestTranslationRequestDto replaces the translation to Locale, when there is one already.
+type UpsertInterestTranslationRequestDto struct {
+	ID          extensions.UUID `uri:"id" validator:"not_empty"`
+	Locale      string          `uri:"locale" validator:"not_empty"`
+	Title       string          `json:"title" validator:"not_empty;length lt 255"`
+	Description string          `json:"description"`
+}
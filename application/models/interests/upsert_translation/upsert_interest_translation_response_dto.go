@@ -0,0 +1,15 @@
+package upsert_translation
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type UpsertInterestTranslationResponseDto struct {
+	InterestID  extensions.UUID `json:"interest_id"`
+	Locale      string          `json:"locale"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
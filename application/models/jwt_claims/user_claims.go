@@ -5,12 +5,24 @@ import (
 	"chat_app_backend/internal/sqlc/db_queries"
 )
 
+// ImpersonatorClaims identify the admin acting as the user. The session version is checked the same way
+// as the user's one, so forcing the admin to log out ends the impersonation as well.
+type ImpersonatorClaims struct {
+	ID             extensions.UUID `json:"id"`
+	SessionVersion int32           `json:"session_version"`
+}
+
+// UserClaims are compared with the stored user on every request, so any change of the listed fields
+// invalidates the issued tokens. SessionVersion is increased on forced logout for that purpose.
+// Impersonator is set only in the impersonation tokens, it is never mapped from the user.
 type UserClaims struct {
-	ID            extensions.UUID     `json:"id"`
-	FullName      string              `json:"full_name"`
-	Email         string              `json:"email"`
-	Role          db_queries.RoleType `json:"role"`
-	EmailVerified bool                `json:"email_verified"`
+	ID             extensions.UUID     `json:"id"`
+	FullName       string              `json:"full_name"`
+	Email          string              `json:"email"`
+	Role           db_queries.RoleType `json:"role"`
+	EmailVerified  bool                `json:"email_verified"`
+	SessionVersion int32               `json:"session_version"`
+	Impersonator   *ImpersonatorClaims `json:"impersonator,omitempty" mapper:"exclude"`
 }
 
 func (uc *UserClaims) Equals(user *db_queries.User) bool {
@@ -18,5 +30,10 @@ func (uc *UserClaims) Equals(user *db_queries.User) bool {
 		uc.FullName == user.FullName &&
 		uc.Email == user.Email &&
 		uc.EmailVerified == user.EmailVerified &&
-		uc.Role == user.Role
+		uc.Role == user.Role &&
+		uc.SessionVersion == user.SessionVersion
+}
+
+func (ic *ImpersonatorClaims) Equals(user *db_queries.User) bool {
+	return ic.ID == user.ID && ic.SessionVersion == user.SessionVersion
 }
@@ -0,0 +1,6 @@
+package create
+
+type CreateServiceAccountRequestDto struct {
+	Name        string `json:"name" validator:"not_empty;length lt 255"`
+	Description string `json:"description"`
+}
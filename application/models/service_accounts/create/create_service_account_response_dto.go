@@ -0,0 +1,15 @@
+package create
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type CreateServiceAccountResponseDto struct {
+	ID          extensions.UUID  `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	CreatedBy   *extensions.UUID `json:"created_by"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
@@ -0,0 +1,8 @@
+package create_key
+
+import "chat_app_backend/internal/extensions"
+
+type CreateApiKeyRequestDto struct {
+	ServiceAccountID extensions.UUID `uri:"id" validator:"not_empty"`
+	Scopes           []string        `json:"scopes"`
+}
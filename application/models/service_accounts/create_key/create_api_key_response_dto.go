@@ -0,0 +1,14 @@
+package create_key
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type CreateApiKeyResponseDto struct {
+	ID        extensions.UUID `json:"id"`
+	Key       string          `json:"key"`
+	Prefix    string          `json:"prefix"`
+	Scopes    []string        `json:"scopes"`
+	CreatedAt time.Time       `json:"created_at"`
+}
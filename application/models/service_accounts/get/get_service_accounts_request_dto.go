@@ -0,0 +1,3 @@
+package get
+
+type GetServiceAccountsRequestDto struct{}
@@ -0,0 +1,7 @@
+package get_keys
+
+import "chat_app_backend/internal/extensions"
+
+type GetApiKeysRequestDto struct {
+	ServiceAccountID extensions.UUID `uri:"id" validator:"not_empty"`
+}
@@ -0,0 +1,19 @@
+package get_keys
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type GetApiKeyResponseDto struct {
+	ID         extensions.UUID `json:"id"`
+	Prefix     string          `json:"prefix"`
+	Scopes     []string        `json:"scopes"`
+	LastUsedAt *time.Time      `json:"last_used_at"`
+	RevokedAt  *time.Time      `json:"revoked_at"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+type GetApiKeysResponseDto struct {
+	ApiKeys []GetApiKeyResponseDto `json:"api_keys" csv:"rows"`
+}
@@ -0,0 +1,8 @@
+package revoke_key
+
+import "chat_app_backend/internal/extensions"
+
+type RevokeApiKeyRequestDto struct {
+	ServiceAccountID extensions.UUID `uri:"id" validator:"not_empty"`
+	ApiKeyID         extensions.UUID `uri:"key_id" validator:"not_empty"`
+}
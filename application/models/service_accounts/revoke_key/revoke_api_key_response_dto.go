@@ -0,0 +1,15 @@
+package revoke_key
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type RevokeApiKeyResponseDto struct {
+	ID         extensions.UUID `json:"id"`
+	Prefix     string          `json:"prefix"`
+	Scopes     []string        `json:"scopes"`
+	LastUsedAt *time.Time      `json:"last_used_at"`
+	RevokedAt  *time.Time      `json:"revoked_at"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
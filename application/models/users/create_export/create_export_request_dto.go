@@ -0,0 +1,7 @@
+package create_export
+
+import "chat_app_backend/internal/extensions"
+
+type CreateExportRequestDto struct {
+	UserID extensions.UUID `uri:"id" validator:"not_empty"`
+}
@@ -0,0 +1,14 @@
+package create_export
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"time"
+)
+
+type CreateExportResponseDto struct {
+	ID        extensions.UUID             `json:"id"`
+	Status    db_queries.DataExportStatus `json:"status"`
+	CreatedAt time.Time                   `json:"created_at"`
+	UpdatedAt time.Time                   `json:"updated_at"`
+}
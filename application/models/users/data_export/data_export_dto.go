@@ -0,0 +1,67 @@
+package data_export
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"time"
+)
+
+type ProfileDto struct {
+	ID             extensions.UUID     `json:"id"`
+	FullName       string              `json:"full_name"`
+	Birthday       time.Time           `json:"birthday"`
+	Gender         db_queries.Gender   `json:"gender"`
+	Email          string              `json:"email"`
+	AvatarFileName string              `json:"avatar_file_name"`
+	Online         bool                `json:"online"`
+	EmailVerified  bool                `json:"email_verified"`
+	LastSeen       time.Time           `json:"last_seen"`
+	CreatedAt      time.Time           `json:"created_at"`
+	UpdatedAt      time.Time           `json:"updated_at"`
+	Role           db_queries.RoleType `json:"role"`
+}
+
+type InterestDto struct {
+	ID          extensions.UUID `json:"id"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+}
+
+type ChatDto struct {
+	ID        extensions.UUID     `json:"id"`
+	Title     *string             `json:"title"`
+	CType     db_queries.ChatType `json:"type"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+type MessageDto struct {
+	ID                 extensions.UUID  `json:"id"`
+	ChatID             extensions.UUID  `json:"chat_id"`
+	RawText            *string          `json:"raw_text"`
+	Edited             bool             `json:"edited"`
+	MessageReferenceID *extensions.UUID `json:"message_reference_id"`
+	CreatedAt          time.Time        `json:"created_at"`
+	UpdatedAt          time.Time        `json:"updated_at"`
+}
+
+type AttachmentDto struct {
+	ID        extensions.UUID `json:"id"`
+	MessageID extensions.UUID `json:"message_id"`
+	Filename  string          `json:"filename"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type DataExportDto struct {
+	Profile     ProfileDto
+	Interests   []InterestDto
+	Chats       []ChatDto
+	Messages    []MessageDto
+	Attachments []AttachmentDto
+}
+
+// MissingFileDto is a file of the user which couldn't be read from the storage, so it isn't in the archive.
+type MissingFileDto struct {
+	Entry    string `json:"entry"`
+	Filename string `json:"filename"`
+}
@@ -1,3 +1,7 @@
 package delete
 
-type DeleteUserResponseDto struct{}
+import "time"
+
+type DeleteUserResponseDto struct {
+	PurgeAfter time.Time `json:"purge_after"`
+}
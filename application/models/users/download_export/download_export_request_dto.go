@@ -0,0 +1,8 @@
+package download_export
+
+import "chat_app_backend/internal/extensions"
+
+type DownloadExportRequestDto struct {
+	UserID   extensions.UUID `uri:"id" validator:"not_empty"`
+	ExportID extensions.UUID `uri:"export_id" validator:"not_empty"`
+}
@@ -0,0 +1,8 @@
+package get_export
+
+import "chat_app_backend/internal/extensions"
+
+type GetExportRequestDto struct {
+	UserID   extensions.UUID `uri:"id" validator:"not_empty"`
+	ExportID extensions.UUID `uri:"export_id" validator:"not_empty"`
+}
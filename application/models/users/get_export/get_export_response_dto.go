@@ -0,0 +1,15 @@
+package get_export
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"time"
+)
+
+type GetExportResponseDto struct {
+	ID           extensions.UUID             `json:"id"`
+	Status       db_queries.DataExportStatus `json:"status"`
+	CreatedAt    time.Time                   `json:"created_at"`
+	UpdatedAt    time.Time                   `json:"updated_at"`
+	DownloadLink *string                     `json:"download_link,omitempty"`
+}
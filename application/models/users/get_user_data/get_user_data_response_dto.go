@@ -7,18 +7,30 @@ import (
 	"time"
 )
 
+type PrivacySettingsDto struct {
+	ProfileVisibility     db_queries.VisibilityLevel `json:"profile_visibility"`
+	PresenceVisibility    db_queries.VisibilityLevel `json:"presence_visibility"`
+	PrivateChatPermission db_queries.VisibilityLevel `json:"private_chat_permission"`
+}
+
+// GetUserDataResponseDto hides the email and privacy settings from everyone except the user and admins,
+// online and last_seen are omitted when the presence visibility of the user does not allow them. ETag is
+// the version of the user to send in If-Match, the ETag header tags only this representation.
 type GetUserDataResponseDto struct {
-	ID                 extensions.UUID                    `json:"id"`
-	FullName           string                             `json:"full_name"`
-	Birthday           time.Time                          `json:"birthday"`
-	Gender             db_queries.Gender                  `json:"gender"`
-	Email              string                             `json:"email"`
-	Online             bool                               `json:"online"`
-	EmailVerified      bool                               `json:"email_verified"`
-	LastSeen           time.Time                          `json:"last_seen"`
-	CreatedAt          time.Time                          `json:"created_at"`
-	UpdatedAt          time.Time                          `json:"updated_at"`
-	Role               db_queries.RoleType                `json:"role"`
-	Interests          []interests.GetInterestResponseDto `json:"interests"`
-	AvatarDownloadLink string                             `json:"avatar_download_link"`
+	ID                   extensions.UUID                    `json:"id"`
+	FullName             string                             `json:"full_name"`
+	Birthday             time.Time                          `json:"birthday"`
+	Gender               db_queries.Gender                  `json:"gender"`
+	Email                *string                            `json:"email,omitempty"`
+	Online               *bool                              `json:"online,omitempty"`
+	EmailVerified        bool                               `json:"email_verified"`
+	LastSeen             *time.Time                         `json:"last_seen,omitempty"`
+	CreatedAt            time.Time                          `json:"created_at"`
+	UpdatedAt            time.Time                          `json:"updated_at"`
+	Role                 db_queries.RoleType                `json:"role"`
+	Interests            []interests.GetInterestResponseDto `json:"interests"`
+	AvatarDownloadLink   string                             `json:"avatar_download_link"`
+	AvatarThumbnailLinks map[string]string                  `json:"avatar_thumbnail_links"`
+	Privacy              *PrivacySettingsDto                `json:"privacy,omitempty"`
+	ETag                 string                             `json:"etag"`
 }
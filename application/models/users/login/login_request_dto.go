@@ -1,6 +1,9 @@
 package login
 
+// LoginRequestDto is rejected for the accounts scheduled for deletion, unless Restore is set, which
+// cancels the deletion.
 type LoginRequestDto struct {
 	Email    string `validator:"not_empty" json:"email"`
 	Password string `validator:"not_empty" json:"password"`
+	Restore  bool   `json:"restore"`
 }
@@ -8,19 +8,20 @@ import (
 )
 
 type RegisterResponseDto struct {
-	ID                 extensions.UUID                    `json:"id"`
-	FullName           string                             `json:"full_name"`
-	Birthday           time.Time                          `json:"birthday"`
-	Gender             db_queries.Gender                  `json:"gender"`
-	Email              string                             `json:"email"`
-	Online             bool                               `json:"online"`
-	EmailVerified      bool                               `json:"email_verified"`
-	LastSeen           time.Time                          `json:"last_seen"`
-	CreatedAt          time.Time                          `json:"created_at"`
-	UpdatedAt          time.Time                          `json:"updated_at"`
-	Interests          []interests.GetInterestResponseDto `json:"interests"`
-	Role               db_queries.RoleType                `json:"role"`
-	AccessToken        string                             `json:"access_token"`
-	RefreshToken       string                             `json:"refresh_token"`
-	AvatarDownloadLink string                             `json:"avatar_download_link"`
+	ID                   extensions.UUID                    `json:"id"`
+	FullName             string                             `json:"full_name"`
+	Birthday             time.Time                          `json:"birthday"`
+	Gender               db_queries.Gender                  `json:"gender"`
+	Email                string                             `json:"email"`
+	Online               bool                               `json:"online"`
+	EmailVerified        bool                               `json:"email_verified"`
+	LastSeen             time.Time                          `json:"last_seen"`
+	CreatedAt            time.Time                          `json:"created_at"`
+	UpdatedAt            time.Time                          `json:"updated_at"`
+	Interests            []interests.GetInterestResponseDto `json:"interests"`
+	Role                 db_queries.RoleType                `json:"role"`
+	AccessToken          string                             `json:"access_token"`
+	RefreshToken         string                             `json:"refresh_token"`
+	AvatarDownloadLink   string                             `json:"avatar_download_link"`
+	AvatarThumbnailLinks map[string]string                  `json:"avatar_thumbnail_links"`
 }
@@ -0,0 +1,7 @@
+package restore
+
+import "chat_app_backend/internal/extensions"
+
+type RestoreUserRequestDto struct {
+	ID extensions.UUID `uri:"id" validator:"not_empty"`
+}
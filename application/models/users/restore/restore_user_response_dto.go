@@ -0,0 +1,3 @@
+package restore
+
+type RestoreUserResponseDto struct{}
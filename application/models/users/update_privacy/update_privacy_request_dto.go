@@ -0,0 +1,13 @@
+package update_privacy
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+)
+
+type UpdatePrivacyRequestDto struct {
+	ID                    extensions.UUID             `uri:"id" validator:"not_empty"`
+	ProfileVisibility     *db_queries.VisibilityLevel `json:"profile_visibility"`
+	PresenceVisibility    *db_queries.VisibilityLevel `json:"presence_visibility"`
+	PrivateChatPermission *db_queries.VisibilityLevel `json:"private_chat_permission"`
+}
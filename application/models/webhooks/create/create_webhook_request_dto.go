@@ -0,0 +1,7 @@
+package create
+
+type CreateWebhookRequestDto struct {
+	Url        string   `json:"url" validator:"not_empty;length lt 2048"`
+	EventTypes []string `json:"event_types"`
+	Secret     *string  `json:"secret" validator:"length lt 255"`
+}
@@ -0,0 +1,16 @@
+package create
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type CreateWebhookResponseDto struct {
+	ID         extensions.UUID `json:"id"`
+	Url        string          `json:"url"`
+	EventTypes []string        `json:"event_types"`
+	Secret     string          `json:"secret"`
+	Active     bool            `json:"active"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
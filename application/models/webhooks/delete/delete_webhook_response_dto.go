@@ -0,0 +1,3 @@
+package delete
+
+type DeleteWebhookResponseDto struct{}
@@ -0,0 +1,62 @@
+package events
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type EventDto struct {
+	Type       string      `json:"type"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       interface{} `json:"data"`
+}
+
+type MessageCreatedEventDto struct {
+	ID                     extensions.UUID  `json:"id"`
+	ChatID                 extensions.UUID  `json:"chat_id"`
+	SenderID               *extensions.UUID `json:"sender_id"`
+	SenderServiceAccountID *extensions.UUID `json:"sender_service_account_id"`
+	RawText                *string          `json:"raw_text"`
+	MessageReferenceID     *extensions.UUID `json:"message_reference_id"`
+	CreatedAt              time.Time        `json:"created_at"`
+}
+
+type ChatMemberAddedEventDto struct {
+	ChatID           extensions.UUID  `json:"chat_id"`
+	UserID           *extensions.UUID `json:"user_id"`
+	ServiceAccountID *extensions.UUID `json:"service_account_id"`
+	InvitedBy        *extensions.UUID `json:"invited_by"`
+}
+
+type UserRegisteredEventDto struct {
+	ID        extensions.UUID `json:"id"`
+	FullName  string          `json:"full_name"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type UserInterestsUpdatedEventDto struct {
+	UserID      extensions.UUID   `json:"user_id"`
+	InterestIds []extensions.UUID `json:"interest_ids"`
+}
+
+type InterestEventDto struct {
+	ID          extensions.UUID `json:"id"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+}
+
+type InterestDeletedEventDto struct {
+	ID extensions.UUID `json:"id"`
+}
+
+type ContactRequestEventDto struct {
+	ID         extensions.UUID `json:"id"`
+	SenderID   extensions.UUID `json:"sender_id"`
+	ReceiverID extensions.UUID `json:"receiver_id"`
+	Status     string          `json:"status"`
+}
+
+type ContactRemovedEventDto struct {
+	UserID    extensions.UUID `json:"user_id"`
+	ContactID extensions.UUID `json:"contact_id"`
+}
@@ -0,0 +1,3 @@
+package get
+
+type GetWebhooksRequestDto struct{}
@@ -0,0 +1,20 @@
+package get
+
+import (
+	"chat_app_backend/internal/extensions"
+	"time"
+)
+
+type GetWebhookResponseDto struct {
+	ID         extensions.UUID  `json:"id"`
+	Url        string           `json:"url"`
+	EventTypes []string         `json:"event_types"`
+	Active     bool             `json:"active"`
+	CreatedBy  *extensions.UUID `json:"created_by"`
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+}
+
+type GetWebhooksResponseDto struct {
+	Webhooks []GetWebhookResponseDto `json:"webhooks" csv:"rows"`
+}
@@ -0,0 +1,9 @@
+package get_deliveries
+
+import "chat_app_backend/internal/extensions"
+
+type GetDeliveriesRequestDto struct {
+	ID     extensions.UUID `uri:"id" validator:"not_empty"`
+	Limit  int32           `form:"limit,default=20" validator:"gt 0;lte 100"`
+	Offset int32           `form:"offset" validator:"gte 0"`
+}
@@ -0,0 +1,26 @@
+package get_deliveries
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"encoding/json"
+	"time"
+)
+
+type GetDeliveryResponseDto struct {
+	ID               extensions.UUID                  `json:"id"`
+	EventType        string                           `json:"event_type"`
+	Payload          json.RawMessage                  `json:"payload"`
+	Status           db_queries.WebhookDeliveryStatus `json:"status"`
+	Attempts         int32                            `json:"attempts"`
+	NextAttemptAt    time.Time                        `json:"next_attempt_at"`
+	LastAttemptAt    *time.Time                       `json:"last_attempt_at"`
+	LastResponseCode *int32                           `json:"last_response_code"`
+	LastError        *string                          `json:"last_error"`
+	CreatedAt        time.Time                        `json:"created_at"`
+	UpdatedAt        time.Time                        `json:"updated_at"`
+}
+
+type GetDeliveriesResponseDto struct {
+	Deliveries []GetDeliveryResponseDto `json:"deliveries" csv:"rows"`
+}
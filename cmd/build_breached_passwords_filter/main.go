@@ -0,0 +1,39 @@
+package main
+
+import (
+	"chat_app_backend/internal/breached_passwords"
+	"chat_app_backend/internal/env_loader"
+	"flag"
+	"log"
+)
+
+// Builds the breached passwords filter from a list of SHA-1 hashes (one per line, optionally followed
+// by ":count"). The output path and the false positive rate are taken from BreachedPasswordsConfig.
+func main() {
+	hashListPath := flag.String("hashes", "", "path to the file with sha1 hashes of breached passwords")
+	flag.Parse()
+
+	if *hashListPath == "" {
+		log.Fatal("-hashes flag is required")
+	}
+
+	config := &breached_passwords.BreachedPasswordsConfig{}
+	if err := env_loader.CreateLoaderFromEnv().LoadDataIntoStruct(config); err != nil {
+		log.Fatal(err)
+	}
+
+	if config.FilterPath == "" {
+		log.Fatal("filter path should be set to build the filter")
+	}
+
+	filter, err := breached_passwords.BuildFilterFromHashList(*hashListPath, config.FalsePositiveRate)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = filter.SaveToFile(config.FilterPath); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("breached passwords filter saved to %s", config.FilterPath)
+}
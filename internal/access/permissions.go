@@ -0,0 +1,32 @@
+package access
+
+import (
+	"chat_app_backend/internal/sqlc/db_queries"
+	"slices"
+)
+
+type Permission string
+
+const (
+	ManageUsers           Permission = "users:manage"
+	ReadAuditLog          Permission = "audit_log:read"
+	ManageInterests       Permission = "interests:manage"
+	ManageServiceAccounts Permission = "service_accounts:manage"
+	ManageWebhooks        Permission = "webhooks:manage"
+)
+
+var rolePermissions = map[db_queries.RoleType][]Permission{
+	db_queries.RoleTypeUSER: {},
+	db_queries.RoleTypeADMIN: {
+		ManageUsers,
+		ReadAuditLog,
+		ManageInterests,
+		ManageServiceAccounts,
+		ManageWebhooks,
+	},
+}
+
+// HasPermission reports whether the role grants the permission, the unknown roles grant nothing.
+func HasPermission(role db_queries.RoleType, permission Permission) bool {
+	return slices.Contains(rolePermissions[role], permission)
+}
@@ -0,0 +1,72 @@
+package api_keys
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+const KeyPrefix = "cab"
+const Separator = "_"
+
+const prefixSize = 6
+const secretSize = 32
+
+// GeneratedKey holds the plain key, which is shown to the caller only once, and the data that is stored.
+type GeneratedKey struct {
+	Key    string
+	Prefix string
+	Hash   []byte
+}
+
+// HashKey uses plain sha256 as the keys are random and long enough to make slow hashes pointless.
+func HashKey(key string) []byte {
+	hash := sha256.Sum256([]byte(key))
+	return hash[:]
+}
+
+func VerifyKey(key string, hash []byte) bool {
+	return subtle.ConstantTimeCompare(HashKey(key), hash) == 1
+}
+
+// ParseKey extracts the lookup prefix from the key of the "cab_<prefix>_<secret>" format.
+func ParseKey(key string) (string, error) {
+	split := strings.SplitN(key, Separator, 3)
+	if len(split) != 3 || split[0] != KeyPrefix {
+		return "", errors.New("api key has unknown format")
+	}
+
+	if len(split[1]) != hex.EncodedLen(prefixSize) || len(split[2]) != base64.RawURLEncoding.EncodedLen(secretSize) {
+		return "", errors.New("api key has wrong length")
+	}
+
+	return split[1], nil
+}
+
+func GenerateKey() (*GeneratedKey, error) {
+	prefix := make([]byte, prefixSize)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, err
+	}
+
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	encodedPrefix := hex.EncodeToString(prefix)
+	key := strings.Join(
+		[]string{KeyPrefix, encodedPrefix, base64.RawURLEncoding.EncodeToString(secret)},
+		Separator,
+	)
+
+	return &GeneratedKey{
+		Key:    key,
+		Prefix: encodedPrefix,
+		Hash:   HashKey(key),
+	}, nil
+}
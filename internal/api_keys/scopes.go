@@ -0,0 +1,14 @@
+package api_keys
+
+import "slices"
+
+const (
+	ScopeChatsRead     = "chats:read"
+	ScopeMessagesWrite = "messages:write"
+)
+
+var AllScopes = []string{ScopeChatsRead, ScopeMessagesWrite}
+
+func IsKnownScope(scope string) bool {
+	return slices.Contains(AllScopes, scope)
+}
@@ -0,0 +1,30 @@
+package audit
+
+const (
+	ActionUserLoggedIn               = "user.logged_in"
+	ActionUserLoginFailed            = "user.login_failed"
+	ActionUserUpdated                = "user.updated"
+	ActionUserRoleChanged            = "user.role_changed"
+	ActionUserDeleted                = "user.deleted"
+	ActionUserRestored               = "user.restored"
+	ActionUserPurged                 = "user.purged"
+	ActionUserSuspended              = "user.suspended"
+	ActionUserSuspensionLifted       = "user.suspension_lifted"
+	ActionUserForcedLogout           = "user.forced_logout"
+	ActionInterestCreated            = "interest.created"
+	ActionInterestUpdated            = "interest.updated"
+	ActionInterestDeleted            = "interest.deleted"
+	ActionInterestTranslationSet     = "interest.translation_set"
+	ActionInterestTranslationDeleted = "interest.translation_deleted"
+	ActionInterestCategoryCreated    = "interest_category.created"
+	ActionInterestCategoryUpdated    = "interest_category.updated"
+	ActionInterestCategoryDeleted    = "interest_category.deleted"
+	ActionImpersonationStarted       = "impersonation.started"
+	ActionImpersonatedRequest        = "impersonation.request"
+)
+
+const (
+	TargetUser             = "user"
+	TargetInterest         = "interest"
+	TargetInterestCategory = "interest_category"
+)
@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// values of redactedFields never get into the log, only the fact they changed. Besides the secrets these
+// are the personal data of the users, the log is append-only, so it can't be erased when the user is
+// purged. The names are compared after the conversion to snake case.
+var redactedFields = []string{"password", "email", "full_name", "birthday"}
+
+const RedactedValue = "[redacted]"
+
+type Change struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// Diff compares the json representations of the values and returns only the changed fields. Either of
+// the values can be nil, which is the case for creations and deletions. Field names are converted to
+// snake case, so structs without json tags give the same names as the api.
+func Diff(before, after interface{}) (map[string]Change, error) {
+	beforeFields, beforeError := toFields(before)
+	if beforeError != nil {
+		return nil, beforeError
+	}
+
+	afterFields, afterError := toFields(after)
+	if afterError != nil {
+		return nil, afterError
+	}
+
+	changes := make(map[string]Change)
+
+	for name, value := range beforeFields {
+		if afterValue, ok := afterFields[name]; !ok || !reflect.DeepEqual(value, afterValue) {
+			changes[name] = Change{Before: value, After: afterFields[name]}
+		}
+	}
+
+	for name, value := range afterFields {
+		if _, ok := beforeFields[name]; !ok {
+			changes[name] = Change{Before: nil, After: value}
+		}
+	}
+
+	for name, change := range changes {
+		if slices.Contains(redactedFields, name) {
+			changes[name] = Change{Before: redact(change.Before), After: redact(change.After)}
+		}
+	}
+
+	return changes, nil
+}
+
+func toFields(value interface{}) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if value == nil {
+		return fields, nil
+	}
+
+	serialized, serializationError := json.Marshal(value)
+	if serializationError != nil {
+		return nil, serializationError
+	}
+
+	rawFields := make(map[string]interface{})
+	if deserializationError := json.Unmarshal(serialized, &rawFields); deserializationError != nil {
+		return nil, deserializationError
+	}
+
+	for name, fieldValue := range rawFields {
+		fields[toSnakeCase(name)] = fieldValue
+	}
+
+	return fields, nil
+}
+
+func redact(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	return RedactedValue
+}
+
+// toSnakeCase converts go field names, abbreviations are kept together, so "ID" gives "id" and
+// "IconFileName" gives "icon_file_name".
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+	var builder strings.Builder
+
+	for idx, current := range runes {
+		if unicode.IsUpper(current) && idx > 0 {
+			previous := runes[idx-1]
+			nextIsLower := idx+1 < len(runes) && unicode.IsLower(runes[idx+1])
+
+			if previous != '_' && (unicode.IsLower(previous) || unicode.IsDigit(previous) || nextIsLower) {
+				builder.WriteRune('_')
+			}
+		}
+
+		builder.WriteRune(unicode.ToLower(current))
+	}
+
+	return builder.String()
+}
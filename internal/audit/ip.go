@@ -0,0 +1,32 @@
+package audit
+
+import "net/netip"
+
+const (
+	ipv4PrefixBits = 24
+	ipv6PrefixBits = 48
+)
+
+// AnonymizeIp keeps only the network of the address, the last octet of ipv4 and all but the first 48 bits
+// of ipv6 are zeroed, which is enough to tell the networks apart without identifying the user. Values
+// that are not addresses give an empty string.
+func AnonymizeIp(ip string) string {
+	address, parseError := netip.ParseAddr(ip)
+	if parseError != nil {
+		return ""
+	}
+
+	address = address.Unmap()
+
+	prefixBits := ipv6PrefixBits
+	if address.Is4() {
+		prefixBits = ipv4PrefixBits
+	}
+
+	prefix, prefixError := address.WithZone("").Prefix(prefixBits)
+	if prefixError != nil {
+		return ""
+	}
+
+	return prefix.Addr().String()
+}
@@ -0,0 +1,174 @@
+package authentication
+
+import (
+	"chat_app_backend/application/models/jwt_claims"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/jwt"
+	"chat_app_backend/internal/moderation"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"encoding/json"
+	"regexp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+var authorizationHeaderRegexp = regexp.MustCompile("Bearer (?P<token>\\S+)")
+
+// Identity is the authenticated user. During impersonation User is the impersonated user and
+// Impersonator is the admin acting as them.
+type Identity struct {
+	User         *db_queries.User
+	Impersonator *db_queries.User
+}
+
+type IAuthenticator interface {
+	Authenticate(ctx *gin.Context) (*Identity, exceptions.ITrackableException)
+}
+
+// JwtAuthenticator authenticates the user by the access token from the Authorization header.
+type JwtAuthenticator struct {
+	jwtHandler jwt.IHandler[jwt_claims.UserClaims]
+	db         db.IDbConnection
+}
+
+func CreateJwtAuthenticator(jwtHandler jwt.IHandler[jwt_claims.UserClaims], db db.IDbConnection) *JwtAuthenticator {
+	return &JwtAuthenticator{
+		jwtHandler: jwtHandler,
+		db:         db,
+	}
+}
+
+func (a *JwtAuthenticator) Authenticate(ctx *gin.Context) (*Identity, exceptions.ITrackableException) {
+	authorizationHeader := ctx.GetHeader("Authorization")
+
+	matches := authorizationHeaderRegexp.FindStringSubmatch(authorizationHeader)
+	if matches == nil {
+		return nil, unauthorized(
+			exceptions.CreateTrackableExceptionFromStringF("access token format does not match"),
+		)
+	}
+
+	token := jwt.CreateTokenFromHandlerAndString(
+		a.jwtHandler,
+		matches[authorizationHeaderRegexp.SubexpIndex("token")],
+		jwt.AccessToken,
+	)
+	validToken, validationError := token.Validate()
+	if validationError != nil {
+		return nil, unauthorized(exceptions.WrapErrorWithTrackableException(validationError))
+	}
+
+	user, userExistenceError := a.db.GetQueries().GetUserById(ctx, validToken.GetClaims().ID)
+
+	if userExistenceError != nil || !validToken.GetClaims().Equals(&user) {
+		return nil, unauthorized(
+			exceptions.CreateTrackableExceptionFromStringF(
+				"user with id: %s no longer exists or related data does not match",
+				validToken.GetClaims().ID,
+			),
+		)
+	}
+
+	if moderation.IsSuspended(&user, time.Now()) {
+		return nil, common_exceptions.ForbiddenException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF(
+					"user with id: %s is suspended",
+					user.ID,
+				),
+				Message: moderation.SuspensionMessage(&user),
+			},
+		}
+	}
+
+	identity := &Identity{User: &user}
+
+	if impersonator := validToken.GetClaims().Impersonator; impersonator != nil {
+		admin, adminExistenceError := a.db.GetQueries().GetUserById(ctx, impersonator.ID)
+
+		// the impersonation ends as soon as the admin loses the role, gets suspended, deleted or logged out
+		if adminExistenceError != nil ||
+			!impersonator.Equals(&admin) ||
+			admin.Role != db_queries.RoleTypeADMIN ||
+			admin.DeletedAt != nil ||
+			moderation.IsSuspended(&admin, time.Now()) {
+			return nil, unauthorized(
+				exceptions.CreateTrackableExceptionFromStringF(
+					"impersonator with id: %s no longer exists or related data does not match",
+					impersonator.ID,
+				),
+			)
+		}
+
+		// requests which can't be recorded are rejected, so there are no impersonated requests missing
+		// from the audit log
+		if recordingError := a.recordImpersonatedRequest(ctx, &admin, &user); recordingError != nil {
+			return nil, recordingError
+		}
+
+		identity.Impersonator = &admin
+	}
+
+	return identity, nil
+}
+
+func (a *JwtAuthenticator) recordImpersonatedRequest(
+	ctx *gin.Context,
+	admin *db_queries.User,
+	user *db_queries.User,
+) exceptions.ITrackableException {
+	changes, diffError := audit.Diff(
+		nil,
+		struct {
+			Method string
+			Path   string
+		}{
+			Method: ctx.Request.Method,
+			Path:   ctx.Request.URL.Path,
+		},
+	)
+	if diffError != nil {
+		return exceptions.WrapErrorWithTrackableException(diffError)
+	}
+
+	serializedChanges, serializationError := json.Marshal(changes)
+	if serializationError != nil {
+		return exceptions.WrapErrorWithTrackableException(serializationError)
+	}
+
+	ip := ctx.ClientIP()
+	params := db_queries.CreateAuditLogEntryParams{
+		ActorType:  db_queries.AuditActorTypeUSER,
+		ActorID:    &admin.ID,
+		Action:     audit.ActionImpersonatedRequest,
+		TargetType: audit.TargetUser,
+		TargetID:   user.ID.String(),
+		Changes:    serializedChanges,
+		Ip:         &ip,
+	}
+
+	if env := request_env.FromContext(ctx); env != nil && env.RequestId != "" {
+		requestId := env.RequestId
+		params.RequestID = &requestId
+	}
+
+	if creationError := a.db.GetQueries().CreateAuditLogEntry(ctx, params); creationError != nil {
+		return exceptions.WrapErrorWithTrackableException(creationError)
+	}
+
+	return nil
+}
+
+func unauthorized(cause exceptions.ITrackableException) exceptions.ITrackableException {
+	return common_exceptions.UnauthorizedException{
+		BaseRestException: exceptions.BaseRestException{
+			ITrackableException: cause,
+			Message:             "",
+		},
+	}
+}
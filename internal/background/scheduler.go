@@ -0,0 +1,99 @@
+package background
+
+import (
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/logger"
+	"context"
+	"sync"
+	"time"
+)
+
+type Job = func(ctx context.Context) exceptions.ITrackableException
+
+type IScheduler interface {
+	Schedule(name string, interval time.Duration, job Job) IScheduler
+	Start()
+	Stop()
+}
+
+type scheduledJob struct {
+	name     string
+	interval time.Duration
+	job      Job
+}
+
+type Scheduler struct {
+	logger logger.ILogger
+	jobs   []scheduledJob
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (s *Scheduler) Schedule(name string, interval time.Duration, job Job) IScheduler {
+	if interval <= 0 {
+		panic("job interval should be positive")
+	}
+
+	s.jobs = append(s.jobs, scheduledJob{
+		name:     name,
+		interval: interval,
+		job:      job,
+	})
+	return s
+}
+
+func (s *Scheduler) Start() {
+	if s.cancel != nil {
+		panic("scheduler is already started")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.run(ctx, job)
+	}
+}
+
+func (s *Scheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+
+	s.cancel()
+	s.wg.Wait()
+	s.cancel = nil
+}
+
+func (s *Scheduler) run(ctx context.Context, job scheduledJob) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job.job(ctx); err != nil {
+				s.logger.
+					CreateErrorMessage(err).
+					Log()
+				continue
+			}
+
+			s.logger.
+				CreateDebugMessageF("job %s finished", job.name).
+				Log()
+		}
+	}
+}
+
+func CreateScheduler(logger logger.ILogger) IScheduler {
+	return &Scheduler{
+		logger: logger,
+		jobs:   make([]scheduledJob, 0),
+	}
+}
@@ -0,0 +1,80 @@
+package breached_passwords
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// parseHashLine accepts lines in the "SHA1HEX" or "SHA1HEX:COUNT" format, empty lines are skipped.
+func parseHashLine(line string) (digest [sha1.Size]byte, ok bool, err error) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return digest, false, nil
+	}
+
+	hash, _, _ := strings.Cut(line, ":")
+	if len(hash) != hex.EncodedLen(sha1.Size) {
+		return digest, false, fmt.Errorf("%q is not a sha1 hash", hash)
+	}
+
+	if _, err = hex.Decode(digest[:], []byte(hash)); err != nil {
+		return digest, false, err
+	}
+
+	return digest, true, nil
+}
+
+func readHashList(r io.Reader, onHash func(digest [sha1.Size]byte)) error {
+	scanner := bufio.NewScanner(r)
+
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+
+		digest, ok, err := parseHashLine(scanner.Text())
+		if err != nil {
+			return fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		if ok {
+			onHash(digest)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// BuildFilterFromHashList reads the list twice: first to size the filter and then to fill it,
+// so huge lists never have to fit into memory.
+func BuildFilterFromHashList(path string, falsePositiveRate float64) (*Filter, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var itemCount uint64
+	if err = readHashList(file, func([sha1.Size]byte) { itemCount++ }); err != nil {
+		return nil, err
+	}
+
+	filter, err := CreateEmptyFilter(itemCount, falsePositiveRate)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	if err = readHashList(file, filter.AddHash); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,8 @@
+package breached_passwords
+
+// BreachedPasswordsConfig describes where the filter file lives. FalsePositiveRate is only used when the
+// filter is built, a loaded filter keeps the rate it was built with. Empty FilterPath disables the check.
+type BreachedPasswordsConfig struct {
+	FilterPath        string  `env:"FILTER_PATH"`
+	FalsePositiveRate float64 `env:"FALSE_POSITIVE_RATE"`
+}
@@ -0,0 +1,172 @@
+package breached_passwords
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+)
+
+var fileMagic = [4]byte{'B', 'P', 'F', '1'}
+
+const minBitCount = 64
+
+type IFilter interface {
+	ContainsPassword(password string) bool
+	ContainsHash(digest [sha1.Size]byte) bool
+}
+
+// Filter is a bloom filter over SHA-1 digests of breached passwords. Digests are already uniformly
+// distributed, so the bit positions are derived from the digest itself with double hashing.
+type Filter struct {
+	words     []uint64
+	bitCount  uint64
+	hashCount uint32
+}
+
+func (f *Filter) positions(digest [sha1.Size]byte, yield func(position uint64)) {
+	h1 := binary.LittleEndian.Uint64(digest[0:8])
+	h2 := binary.LittleEndian.Uint64(digest[8:16]) | 1
+
+	for i := range uint64(f.hashCount) {
+		yield((h1 + i*h2) % f.bitCount)
+	}
+}
+
+func (f *Filter) AddHash(digest [sha1.Size]byte) {
+	f.positions(digest, func(position uint64) {
+		f.words[position/64] |= 1 << (position % 64)
+	})
+}
+
+func (f *Filter) ContainsHash(digest [sha1.Size]byte) bool {
+	if f.bitCount == 0 {
+		return false
+	}
+
+	contains := true
+	f.positions(digest, func(position uint64) {
+		if f.words[position/64]&(1<<(position%64)) == 0 {
+			contains = false
+		}
+	})
+
+	return contains
+}
+
+func (f *Filter) ContainsPassword(password string) bool {
+	return f.ContainsHash(sha1.Sum([]byte(password)))
+}
+
+func (f *Filter) WriteTo(w io.Writer) (int64, error) {
+	writer := bufio.NewWriter(w)
+
+	header := make([]byte, 0, 16)
+	header = append(header, fileMagic[:]...)
+	header = binary.LittleEndian.AppendUint32(header, f.hashCount)
+	header = binary.LittleEndian.AppendUint64(header, f.bitCount)
+
+	written, err := writer.Write(header)
+	total := int64(written)
+	if err != nil {
+		return total, err
+	}
+
+	word := make([]byte, 8)
+	for _, value := range f.words {
+		binary.LittleEndian.PutUint64(word, value)
+		written, err = writer.Write(word)
+		total += int64(written)
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, writer.Flush()
+}
+
+func (f *Filter) SaveToFile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.WriteTo(file); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func ReadFilter(r io.Reader) (*Filter, error) {
+	reader := bufio.NewReader(r)
+
+	header := make([]byte, 16)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, fmt.Errorf("can't read filter header: %w", err)
+	}
+
+	if [4]byte(header[0:4]) != fileMagic {
+		return nil, errors.New("file is not a breached passwords filter")
+	}
+
+	hashCount := binary.LittleEndian.Uint32(header[4:8])
+	bitCount := binary.LittleEndian.Uint64(header[8:16])
+
+	if hashCount == 0 || bitCount == 0 || bitCount%64 != 0 {
+		return nil, errors.New("breached passwords filter header is corrupted")
+	}
+
+	words := make([]uint64, bitCount/64)
+	word := make([]byte, 8)
+	for idx := range words {
+		if _, err := io.ReadFull(reader, word); err != nil {
+			return nil, fmt.Errorf("can't read filter contents: %w", err)
+		}
+		words[idx] = binary.LittleEndian.Uint64(word)
+	}
+
+	return &Filter{
+		words:     words,
+		bitCount:  bitCount,
+		hashCount: hashCount,
+	}, nil
+}
+
+// CreateEmptyFilter sizes the filter for the expected amount of items, so that the false positive
+// rate stays under the requested one.
+func CreateEmptyFilter(expectedItems uint64, falsePositiveRate float64) (*Filter, error) {
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		return nil, errors.New("false positive rate should be between 0 and 1")
+	}
+
+	items := math.Max(float64(expectedItems), 1)
+	bits := math.Ceil(-items * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	bitCount := (uint64(math.Max(bits, minBitCount)) + 63) / 64 * 64
+	hashCount := uint32(math.Max(math.Round(float64(bitCount)/items*math.Ln2), 1))
+
+	return &Filter{
+		words:     make([]uint64, bitCount/64),
+		bitCount:  bitCount,
+		hashCount: hashCount,
+	}, nil
+}
+
+func CreateFilter(cfg *BreachedPasswordsConfig) (*Filter, error) {
+	if cfg.FilterPath == "" {
+		return &Filter{}, nil
+	}
+
+	file, err := os.Open(cfg.FilterPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ReadFilter(file)
+}
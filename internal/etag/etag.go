@@ -0,0 +1,123 @@
+package etag
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	Header            = "ETag"
+	IfMatchHeader     = "If-Match"
+	IfNoneMatchHeader = "If-None-Match"
+)
+
+// entityTag is a tag from the conditional headers, the opaque part is kept without the quotes.
+type entityTag struct {
+	weak   bool
+	opaque string
+}
+
+// FromVersion returns the strong tag of the record version, i.e. of its updated_at. The representations
+// of the same version share the tag, so it can be sent back in If-Match whatever the format was.
+func FromVersion(updatedAt time.Time) string {
+	return `"` + strconv.FormatInt(updatedAt.UnixMicro(), 36) + `"`
+}
+
+// FromBody returns the weak tag of the encoded representation. It is used when the representation
+// depends on more than the record, e.g. on who reads it, so it can't be sent back in If-Match.
+func FromBody(body []byte) string {
+	hash := sha256.Sum256(body)
+	return `W/"` + base64.RawURLEncoding.EncodeToString(hash[:18]) + `"`
+}
+
+// Set sends the tag of the record version with the response.
+func Set(ctx *gin.Context, updatedAt time.Time) {
+	ctx.Header(Header, FromVersion(updatedAt))
+}
+
+// ExpectedVersions returns the versions from If-Match, one of which the record has to have to be changed.
+// It is nil when any version can be changed, i.e. without the header or with "*". The weak and the
+// unknown tags never match, so the versions are empty and the change fails when there are no others.
+func ExpectedVersions(ctx *gin.Context) []time.Time {
+	header := strings.Join(ctx.Request.Header.Values(IfMatchHeader), ",")
+	if strings.TrimSpace(header) == "" || strings.TrimSpace(header) == "*" {
+		return nil
+	}
+
+	versions := make([]time.Time, 0)
+	for _, tag := range parseTags(header) {
+		if tag.weak {
+			continue
+		}
+
+		micros, parsingError := strconv.ParseInt(tag.opaque, 36, 64)
+		if parsingError != nil {
+			continue
+		}
+
+		versions = append(versions, time.UnixMicro(micros))
+	}
+
+	return versions
+}
+
+// IsNotModified reports whether the tag of the response matches If-None-Match, i.e. whether the client
+// already has the representation. The comparison is weak, as the header only revalidates the caches.
+func IsNotModified(ctx *gin.Context) bool {
+	current := parseTags(ctx.Writer.Header().Get(Header))
+	header := strings.Join(ctx.Request.Header.Values(IfNoneMatchHeader), ",")
+
+	if len(current) != 1 || strings.TrimSpace(header) == "" {
+		return false
+	}
+
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+
+	for _, tag := range parseTags(header) {
+		if tag.opaque == current[0].opaque {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseTags reads the comma separated tags, skipping the malformed ones.
+func parseTags(header string) []entityTag {
+	tags := make([]entityTag, 0)
+
+	for rest := header; ; {
+		rest = strings.TrimLeft(rest, " \t,")
+		if rest == "" {
+			return tags
+		}
+
+		tag := entityTag{}
+		if strings.HasPrefix(rest, "W/") {
+			tag.weak = true
+			rest = rest[len("W/"):]
+		}
+
+		// the opaque part can contain commas, so the tag ends at the closing quote
+		if !strings.HasPrefix(rest, `"`) {
+			_, rest, _ = strings.Cut(rest, ",")
+			continue
+		}
+
+		opaque, remainder, closed := strings.Cut(rest[1:], `"`)
+		if !closed {
+			return tags
+		}
+
+		tag.opaque = opaque
+		tags = append(tags, tag)
+		rest = remainder
+	}
+}
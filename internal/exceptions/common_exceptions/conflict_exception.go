@@ -0,0 +1,21 @@
+package common_exceptions
+
+import (
+	"chat_app_backend/internal/exceptions"
+	"fmt"
+	"net/http"
+)
+
+type ConflictException struct {
+	exceptions.BaseRestException
+}
+
+func (c ConflictException) GetHttpStatusCode() int {
+	return http.StatusConflict
+}
+
+func (c ConflictException) GetResponse() exceptions.Response {
+	return exceptions.Response{
+		Message: fmt.Sprintf("Conflict: %s", c.Message),
+	}
+}
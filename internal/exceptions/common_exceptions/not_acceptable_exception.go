@@ -0,0 +1,21 @@
+package common_exceptions
+
+import (
+	"chat_app_backend/internal/exceptions"
+	"fmt"
+	"net/http"
+)
+
+type NotAcceptableException struct {
+	exceptions.BaseRestException
+}
+
+func (n NotAcceptableException) GetHttpStatusCode() int {
+	return http.StatusNotAcceptable
+}
+
+func (n NotAcceptableException) GetResponse() exceptions.Response {
+	return exceptions.Response{
+		Message: fmt.Sprintf("Not acceptable: %s", n.Message),
+	}
+}
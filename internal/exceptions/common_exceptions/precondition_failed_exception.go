@@ -0,0 +1,21 @@
+package common_exceptions
+
+import (
+	"chat_app_backend/internal/exceptions"
+	"fmt"
+	"net/http"
+)
+
+type PreconditionFailedException struct {
+	exceptions.BaseRestException
+}
+
+func (p PreconditionFailedException) GetHttpStatusCode() int {
+	return http.StatusPreconditionFailed
+}
+
+func (p PreconditionFailedException) GetResponse() exceptions.Response {
+	return exceptions.Response{
+		Message: fmt.Sprintf("Precondition failed: %s", p.Message),
+	}
+}
@@ -0,0 +1,21 @@
+package common_exceptions
+
+import (
+	"chat_app_backend/internal/exceptions"
+	"fmt"
+	"net/http"
+)
+
+type UnprocessableEntityException struct {
+	exceptions.BaseRestException
+}
+
+func (u UnprocessableEntityException) GetHttpStatusCode() int {
+	return http.StatusUnprocessableEntity
+}
+
+func (u UnprocessableEntityException) GetResponse() exceptions.Response {
+	return exceptions.Response{
+		Message: fmt.Sprintf("Unprocessable entity: %s", u.Message),
+	}
+}
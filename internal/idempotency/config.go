@@ -0,0 +1,18 @@
+package idempotency
+
+import "time"
+
+// IdempotencyConfig has Ttl of the stored responses and LockTtl of the requests in flight, which is
+// shorter, so a crashed request does not block the retries for long.
+type IdempotencyConfig struct {
+	Ttl     string `env:"IDEMPOTENCY_TTL"`
+	LockTtl string `env:"IDEMPOTENCY_LOCK_TTL"`
+}
+
+func (cfg *IdempotencyConfig) GetTtl() (time.Duration, error) {
+	return time.ParseDuration(cfg.Ttl)
+}
+
+func (cfg *IdempotencyConfig) GetLockTtl() (time.Duration, error) {
+	return time.ParseDuration(cfg.LockTtl)
+}
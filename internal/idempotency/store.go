@@ -0,0 +1,97 @@
+package idempotency
+
+import (
+	"chat_app_backend/internal/redis"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Record is the request with the key. Until the request completes it has only the fingerprint, then it
+// keeps the response which is replayed to the duplicates.
+type Record struct {
+	Fingerprint string      `json:"fingerprint"`
+	Completed   bool        `json:"completed"`
+	Status      int         `json:"status,omitempty"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body,omitempty"`
+}
+
+type IStore interface {
+	// Reserve stores the record of the request in flight and reports false when there already is a record
+	Reserve(ctx context.Context, key string, fingerprint string) (bool, error)
+	// Get returns nil when there is no record
+	Get(ctx context.Context, key string) (*Record, error)
+	Complete(ctx context.Context, key string, record *Record) error
+	// Release removes the record, so the request can be retried
+	Release(ctx context.Context, key string) error
+}
+
+type RedisStore struct {
+	client  *redis.Client
+	ttl     time.Duration
+	lockTtl time.Duration
+}
+
+const keyPrefix = "idempotency:"
+
+func (s *RedisStore) Reserve(ctx context.Context, key string, fingerprint string) (bool, error) {
+	serializedRecord, serializationError := json.Marshal(Record{Fingerprint: fingerprint})
+	if serializationError != nil {
+		return false, serializationError
+	}
+
+	return s.client.SetNX(ctx, keyPrefix+key, serializedRecord, s.lockTtl).Result()
+}
+
+func (s *RedisStore) Get(ctx context.Context, key string) (*Record, error) {
+	serializedRecord, readingError := s.client.Get(ctx, keyPrefix+key).Bytes()
+	switch {
+	case errors.Is(readingError, goredis.Nil):
+		return nil, nil
+	case readingError != nil:
+		return nil, readingError
+	}
+
+	var record Record
+	if deserializationError := json.Unmarshal(serializedRecord, &record); deserializationError != nil {
+		return nil, deserializationError
+	}
+
+	return &record, nil
+}
+
+func (s *RedisStore) Complete(ctx context.Context, key string, record *Record) error {
+	serializedRecord, serializationError := json.Marshal(record)
+	if serializationError != nil {
+		return serializationError
+	}
+
+	return s.client.Set(ctx, keyPrefix+key, serializedRecord, s.ttl).Err()
+}
+
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	return s.client.Del(ctx, keyPrefix+key).Err()
+}
+
+func CreateRedisStore(cfg *IdempotencyConfig, client *redis.Client) (*RedisStore, error) {
+	ttl, ttlParseError := cfg.GetTtl()
+	if ttlParseError != nil {
+		return nil, ttlParseError
+	}
+
+	lockTtl, lockTtlParseError := cfg.GetLockTtl()
+	if lockTtlParseError != nil {
+		return nil, lockTtlParseError
+	}
+
+	return &RedisStore{
+		client:  client,
+		ttl:     ttl,
+		lockTtl: lockTtl,
+	}, nil
+}
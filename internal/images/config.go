@@ -0,0 +1,40 @@
+package images
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type ImageProcessingConfig struct {
+	MaxDimension   int    `env:"MAX_DIMENSION"`
+	MaxPixels      int    `env:"MAX_PIXELS"`
+	ThumbnailSizes string `env:"THUMBNAIL_SIZES"`
+	JpegQuality    int    `env:"JPEG_QUALITY"`
+	RasterizeSvg   bool   `env:"RASTERIZE_SVG"`
+}
+
+// GetThumbnailSizes parses the comma separated list of thumbnail sizes, each size is the longest side in pixels.
+func (cfg *ImageProcessingConfig) GetThumbnailSizes() ([]int, error) {
+	sizes := make([]int, 0)
+
+	for _, rawSize := range strings.Split(cfg.ThumbnailSizes, ",") {
+		rawSize = strings.TrimSpace(rawSize)
+		if rawSize == "" {
+			continue
+		}
+
+		size, err := strconv.Atoi(rawSize)
+		if err != nil {
+			return nil, fmt.Errorf("can't parse thumbnail size %s: %w", rawSize, err)
+		}
+
+		if size <= 0 {
+			return nil, fmt.Errorf("thumbnail size should be positive, got %d", size)
+		}
+
+		sizes = append(sizes, size)
+	}
+
+	return sizes, nil
+}
@@ -0,0 +1,53 @@
+package images
+
+import (
+	"bytes"
+	"chat_app_backend/internal/s3"
+	"errors"
+)
+
+var ErrUnsupportedFormat = errors.New("file content is not a supported image")
+
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+var jpegSignature = []byte{0xFF, 0xD8, 0xFF}
+
+const svgSniffLength = 1024
+
+// DetectFileType looks at the content instead of the file name, so a renamed file is detected by what it really is.
+func DetectFileType(data []byte) (s3.FileType, error) {
+	switch {
+	case bytes.HasPrefix(data, pngSignature):
+		return s3.Png, nil
+	case bytes.HasPrefix(data, jpegSignature):
+		return s3.Jpeg, nil
+	case looksLikeSvg(data):
+		return s3.Svg, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+func looksLikeSvg(data []byte) bool {
+	head := data[:min(len(data), svgSniffLength)]
+	head = bytes.TrimPrefix(head, []byte("\xEF\xBB\xBF"))
+	head = bytes.TrimSpace(head)
+
+	if !bytes.HasPrefix(head, []byte("<?xml")) && !bytes.HasPrefix(head, []byte("<svg")) && !bytes.HasPrefix(head, []byte("<!--")) {
+		return false
+	}
+
+	return bytes.Contains(head, []byte("<svg"))
+}
+
+func ContentType(fileType s3.FileType) string {
+	switch fileType {
+	case s3.Png:
+		return "image/png"
+	case s3.Jpeg:
+		return "image/jpeg"
+	case s3.Svg:
+		return "image/svg+xml"
+	default:
+		return "application/octet-stream"
+	}
+}
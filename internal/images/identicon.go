@@ -0,0 +1,82 @@
+package images
+
+import (
+	"crypto/sha256"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+)
+
+const identiconGridSize = 5
+
+var identiconBackground = color.RGBA{R: 240, G: 240, B: 240, A: 255}
+
+// GenerateIdenticon draws a horizontally symmetric pattern of 5x5 cells derived from the hash of the seed,
+// the same seed always gives the same image.
+func GenerateIdenticon(seed string, size int) *image.RGBA {
+	hash := sha256.Sum256([]byte(seed))
+
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: identiconBackground}, image.Point{}, draw.Src)
+
+	cellSize := max(size*4/5/identiconGridSize, 1)
+	offset := (size - cellSize*identiconGridSize) / 2
+	foreground := &image.Uniform{C: identiconColor(hash)}
+
+	// only the left half and the middle column are random, the right half mirrors them
+	halfWidth := (identiconGridSize + 1) / 2
+	for row := range identiconGridSize {
+		for column := range halfWidth {
+			if hash[row*halfWidth+column]%2 == 1 {
+				continue
+			}
+
+			for _, mirroredColumn := range []int{column, identiconGridSize - 1 - column} {
+				cell := image.Rect(
+					offset+mirroredColumn*cellSize,
+					offset+row*cellSize,
+					offset+(mirroredColumn+1)*cellSize,
+					offset+(row+1)*cellSize,
+				)
+				draw.Draw(img, cell, foreground, image.Point{}, draw.Src)
+			}
+		}
+	}
+
+	return img
+}
+
+// identiconColor picks the hue from the hash, saturation and lightness are fixed so every color is
+// readable on the light background.
+func identiconColor(hash [sha256.Size]byte) color.RGBA {
+	hue := float64(uint16(hash[sha256.Size-2])<<8|uint16(hash[sha256.Size-1])) / math.MaxUint16 * 360
+	const saturation, lightness = 0.55, 0.5
+
+	chroma := (1 - math.Abs(2*lightness-1)) * saturation
+	x := chroma * (1 - math.Abs(math.Mod(hue/60, 2)-1))
+	m := lightness - chroma/2
+
+	var r, g, b float64
+	switch {
+	case hue < 60:
+		r, g, b = chroma, x, 0
+	case hue < 120:
+		r, g, b = x, chroma, 0
+	case hue < 180:
+		r, g, b = 0, chroma, x
+	case hue < 240:
+		r, g, b = 0, x, chroma
+	case hue < 300:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+
+	return color.RGBA{
+		R: uint8(math.Round((r + m) * 255)),
+		G: uint8(math.Round((g + m) * 255)),
+		B: uint8(math.Round((b + m) * 255)),
+		A: 255,
+	}
+}
@@ -0,0 +1,207 @@
+package images
+
+import (
+	"bytes"
+	"chat_app_backend/internal/s3"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"slices"
+	"strings"
+)
+
+type EncodedImage struct {
+	Data        []byte
+	FileType    s3.FileType
+	ContentType string
+}
+
+type Thumbnail struct {
+	Size int
+	EncodedImage
+}
+
+type ProcessedImage struct {
+	Original   EncodedImage
+	Thumbnails []Thumbnail
+}
+
+type IProcessor interface {
+	Process(data []byte, allowedTypes ...s3.FileType) (*ProcessedImage, error)
+	GetThumbnailSizes() []int
+}
+
+type Processor struct {
+	maxDimension   int
+	maxPixels      int
+	thumbnailSizes []int
+	jpegQuality    int
+	rasterizeSvg   bool
+}
+
+func (p *Processor) GetThumbnailSizes() []int {
+	return p.thumbnailSizes
+}
+
+// Process checks the real content type of the upload and re-encodes raster images. Re-encoding drops
+// all the metadata (EXIF, GPS, comments), as the standard encoders write only the pixels. Svg images
+// are rewritten by SanitizeSvg and, when rasterization is enabled, get png thumbnails for the clients
+// that can't render svg.
+func (p *Processor) Process(data []byte, allowedTypes ...s3.FileType) (*ProcessedImage, error) {
+	fileType, detectionError := DetectFileType(data)
+	if detectionError != nil {
+		return nil, detectionError
+	}
+
+	if !slices.Contains(allowedTypes, fileType) {
+		return nil, fmt.Errorf("%w: %s images are not allowed here", ErrUnsupportedFormat, fileType)
+	}
+
+	if fileType == s3.Svg {
+		return p.processSvg(data)
+	}
+
+	imageConfig, _, configDecodingError := image.DecodeConfig(bytes.NewReader(data))
+	if configDecodingError != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, configDecodingError)
+	}
+
+	// checked before decoding, so a small file that declares huge dimensions isn't expanded into memory
+	if imageConfig.Width <= 0 || imageConfig.Height <= 0 || imageConfig.Width*imageConfig.Height > p.maxPixels {
+		return nil, fmt.Errorf(
+			"%w: image of %dx%d pixels is too large",
+			ErrUnsupportedFormat,
+			imageConfig.Width,
+			imageConfig.Height,
+		)
+	}
+
+	decoded, _, decodingError := image.Decode(bytes.NewReader(data))
+	if decodingError != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, decodingError)
+	}
+
+	original, originalEncodingError := p.encode(Fit(decoded, p.maxDimension), fileType)
+	if originalEncodingError != nil {
+		return nil, originalEncodingError
+	}
+
+	processed := &ProcessedImage{
+		Original:   *original,
+		Thumbnails: make([]Thumbnail, 0, len(p.thumbnailSizes)),
+	}
+
+	for _, size := range p.thumbnailSizes {
+		thumbnail, thumbnailEncodingError := p.encode(Fit(decoded, size), fileType)
+		if thumbnailEncodingError != nil {
+			return nil, thumbnailEncodingError
+		}
+
+		processed.Thumbnails = append(processed.Thumbnails, Thumbnail{Size: size, EncodedImage: *thumbnail})
+	}
+
+	return processed, nil
+}
+
+func (p *Processor) processSvg(data []byte) (*ProcessedImage, error) {
+	sanitized, sanitizationError := SanitizeSvg(data)
+	if sanitizationError != nil {
+		return nil, sanitizationError
+	}
+
+	processed := &ProcessedImage{
+		Original:   EncodedImage{Data: sanitized, FileType: s3.Svg, ContentType: ContentType(s3.Svg)},
+		Thumbnails: make([]Thumbnail, 0),
+	}
+
+	if !p.rasterizeSvg {
+		return processed, nil
+	}
+
+	for _, size := range p.thumbnailSizes {
+		rasterized, rasterizationError := RasterizeSvg(sanitized, size)
+		if rasterizationError != nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnsafeSvg, rasterizationError)
+		}
+
+		thumbnail, thumbnailEncodingError := p.encode(rasterized, ThumbnailFileType(s3.Svg))
+		if thumbnailEncodingError != nil {
+			return nil, thumbnailEncodingError
+		}
+
+		processed.Thumbnails = append(processed.Thumbnails, Thumbnail{Size: size, EncodedImage: *thumbnail})
+	}
+
+	return processed, nil
+}
+
+func (p *Processor) encode(img image.Image, fileType s3.FileType) (*EncodedImage, error) {
+	var buffer bytes.Buffer
+	var encodingError error
+
+	switch fileType {
+	case s3.Png:
+		encodingError = png.Encode(&buffer, img)
+	case s3.Jpeg:
+		encodingError = jpeg.Encode(&buffer, img, &jpeg.Options{Quality: p.jpegQuality})
+	default:
+		encodingError = fmt.Errorf("can't encode %s images", fileType)
+	}
+
+	if encodingError != nil {
+		return nil, encodingError
+	}
+
+	return &EncodedImage{Data: buffer.Bytes(), FileType: fileType, ContentType: ContentType(fileType)}, nil
+}
+
+// ThumbnailFileType returns the type thumbnails of the original type are encoded with, svg is rasterized to png.
+func ThumbnailFileType(fileType s3.FileType) s3.FileType {
+	if fileType == s3.Svg {
+		return s3.Png
+	}
+
+	return fileType
+}
+
+// ThumbnailFileName derives the name of the thumbnail from the name of the original, so thumbnails
+// don't have to be stored separately.
+func ThumbnailFileName(filename string, size int) string {
+	dotIndex := strings.LastIndex(filename, ".")
+	if dotIndex == -1 {
+		return fmt.Sprintf("%s_%d", filename, size)
+	}
+
+	extension := filename[dotIndex:]
+	if extension == "."+s3.Svg {
+		extension = "." + ThumbnailFileType(s3.Svg)
+	}
+
+	return fmt.Sprintf("%s_%d%s", filename[:dotIndex], size, extension)
+}
+
+func CreateProcessor(cfg *ImageProcessingConfig) (*Processor, error) {
+	thumbnailSizes, sizesParseError := cfg.GetThumbnailSizes()
+	if sizesParseError != nil {
+		return nil, sizesParseError
+	}
+
+	switch {
+	case cfg.MaxDimension <= 0:
+		return nil, errors.New("max image dimension should be positive")
+	case cfg.MaxPixels <= 0:
+		return nil, errors.New("max image pixels should be positive")
+	case cfg.JpegQuality < 1 || cfg.JpegQuality > 100:
+		return nil, errors.New("jpeg quality should be between 1 and 100")
+	}
+
+	return &Processor{
+		maxDimension:   cfg.MaxDimension,
+		maxPixels:      cfg.MaxPixels,
+		thumbnailSizes: thumbnailSizes,
+		jpegQuality:    cfg.JpegQuality,
+		rasterizeSvg:   cfg.RasterizeSvg,
+	}, nil
+}
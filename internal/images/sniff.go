@@ -0,0 +1,27 @@
+package images
+
+import (
+	"chat_app_backend/internal/s3"
+	"io"
+	"mime/multipart"
+)
+
+// DetectUploadedFileType reads only the beginning of the upload, which is enough for DetectFileType.
+func DetectUploadedFileType(fileHeader *multipart.FileHeader) (s3.FileType, error) {
+	file, fileOpeningError := fileHeader.Open()
+	if fileOpeningError != nil {
+		return "", fileOpeningError
+	}
+
+	defer func(file multipart.File) {
+		_ = file.Close()
+	}(file)
+
+	head := make([]byte, svgSniffLength)
+	readBytes, readingError := io.ReadFull(file, head)
+	if readingError != nil && readingError != io.ErrUnexpectedEOF {
+		return "", readingError
+	}
+
+	return DetectFileType(head[:readBytes])
+}
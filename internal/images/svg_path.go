@@ -0,0 +1,375 @@
+package images
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+type point struct {
+	x, y float64
+}
+
+// polyline is a flattened subpath in user space.
+type polyline struct {
+	points []point
+	closed bool
+}
+
+const curveSegments = 16
+
+type pathTokenizer struct {
+	data     string
+	position int
+}
+
+func (t *pathTokenizer) skipSeparators() {
+	for t.position < len(t.data) {
+		switch t.data[t.position] {
+		case ' ', '\t', '\n', '\r', ',':
+			t.position++
+		default:
+			return
+		}
+	}
+}
+
+func (t *pathTokenizer) done() bool {
+	t.skipSeparators()
+	return t.position >= len(t.data)
+}
+
+func (t *pathTokenizer) nextIsNumber() bool {
+	t.skipSeparators()
+	if t.position >= len(t.data) {
+		return false
+	}
+
+	character := t.data[t.position]
+	return character == '-' || character == '+' || character == '.' || (character >= '0' && character <= '9')
+}
+
+func (t *pathTokenizer) command() (byte, bool) {
+	t.skipSeparators()
+	if t.position >= len(t.data) || t.nextIsNumber() {
+		return 0, false
+	}
+
+	command := t.data[t.position]
+	t.position++
+	return command, true
+}
+
+// number reads a float, "1.5.5" and "1-2" are valid sequences of two numbers in the path syntax.
+func (t *pathTokenizer) number() (float64, error) {
+	t.skipSeparators()
+	start := t.position
+	seenDot, seenExponent := false, false
+
+	if t.position < len(t.data) && (t.data[t.position] == '-' || t.data[t.position] == '+') {
+		t.position++
+	}
+
+	for t.position < len(t.data) {
+		character := t.data[t.position]
+		switch {
+		case character >= '0' && character <= '9':
+		case character == '.' && !seenDot && !seenExponent:
+			seenDot = true
+		case (character == 'e' || character == 'E') && !seenExponent:
+			seenExponent = true
+			if t.position+1 < len(t.data) && (t.data[t.position+1] == '-' || t.data[t.position+1] == '+') {
+				t.position++
+			}
+		default:
+			return t.parse(start)
+		}
+		t.position++
+	}
+
+	return t.parse(start)
+}
+
+func (t *pathTokenizer) parse(start int) (float64, error) {
+	value, err := strconv.ParseFloat(t.data[start:t.position], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number in path at %d", start)
+	}
+
+	return value, nil
+}
+
+// flag reads an arc flag, flags can be written without separators ("a1 1 0 00 1 1").
+func (t *pathTokenizer) flag() (bool, error) {
+	t.skipSeparators()
+	if t.position >= len(t.data) || (t.data[t.position] != '0' && t.data[t.position] != '1') {
+		return false, fmt.Errorf("invalid arc flag in path at %d", t.position)
+	}
+
+	value := t.data[t.position] == '1'
+	t.position++
+	return value, nil
+}
+
+func (t *pathTokenizer) numbers(count int) ([]float64, error) {
+	values := make([]float64, count)
+	for idx := range count {
+		value, err := t.number()
+		if err != nil {
+			return nil, err
+		}
+		values[idx] = value
+	}
+
+	return values, nil
+}
+
+type pathBuilder struct {
+	polylines   []polyline
+	current     *polyline
+	position    point
+	start       point
+	lastControl point
+	lastCommand byte
+}
+
+func (b *pathBuilder) moveTo(p point) {
+	b.flush()
+	b.current = &polyline{points: []point{p}}
+	b.position, b.start = p, p
+}
+
+func (b *pathBuilder) lineTo(p point) {
+	if b.current == nil {
+		b.moveTo(b.position)
+	}
+
+	b.current.points = append(b.current.points, p)
+	b.position = p
+}
+
+func (b *pathBuilder) close() {
+	if b.current != nil {
+		b.current.closed = true
+		b.flush()
+	}
+
+	b.position = b.start
+}
+
+func (b *pathBuilder) flush() {
+	if b.current != nil && len(b.current.points) > 1 {
+		b.polylines = append(b.polylines, *b.current)
+	}
+
+	b.current = nil
+}
+
+func (b *pathBuilder) cubicTo(c1, c2, end point) {
+	start := b.position
+	for idx := 1; idx <= curveSegments; idx++ {
+		t := float64(idx) / curveSegments
+		mt := 1 - t
+		b.lineTo(point{
+			x: mt*mt*mt*start.x + 3*mt*mt*t*c1.x + 3*mt*t*t*c2.x + t*t*t*end.x,
+			y: mt*mt*mt*start.y + 3*mt*mt*t*c1.y + 3*mt*t*t*c2.y + t*t*t*end.y,
+		})
+	}
+}
+
+func (b *pathBuilder) quadTo(control, end point) {
+	start := b.position
+	for idx := 1; idx <= curveSegments; idx++ {
+		t := float64(idx) / curveSegments
+		mt := 1 - t
+		b.lineTo(point{
+			x: mt*mt*start.x + 2*mt*t*control.x + t*t*end.x,
+			y: mt*mt*start.y + 2*mt*t*control.y + t*t*end.y,
+		})
+	}
+}
+
+// arcTo converts the endpoint parametrization to the center one as described in the SVG implementation notes.
+func (b *pathBuilder) arcTo(rx, ry, rotation float64, largeArc, sweep bool, end point) {
+	start := b.position
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 || start == end {
+		b.lineTo(end)
+		return
+	}
+
+	phi := rotation * math.Pi / 180
+	cosPhi, sinPhi := math.Cos(phi), math.Sin(phi)
+
+	dx, dy := (start.x-end.x)/2, (start.y-end.y)/2
+	x1 := cosPhi*dx + sinPhi*dy
+	y1 := -sinPhi*dx + cosPhi*dy
+
+	if lambda := x1*x1/(rx*rx) + y1*y1/(ry*ry); lambda > 1 {
+		rx *= math.Sqrt(lambda)
+		ry *= math.Sqrt(lambda)
+	}
+
+	numerator := rx*rx*ry*ry - rx*rx*y1*y1 - ry*ry*x1*x1
+	denominator := rx*rx*y1*y1 + ry*ry*x1*x1
+	coefficient := math.Sqrt(math.Max(numerator, 0) / denominator)
+	if largeArc == sweep {
+		coefficient = -coefficient
+	}
+
+	cx1 := coefficient * rx * y1 / ry
+	cy1 := -coefficient * ry * x1 / rx
+
+	cx := cosPhi*cx1 - sinPhi*cy1 + (start.x+end.x)/2
+	cy := sinPhi*cx1 + cosPhi*cy1 + (start.y+end.y)/2
+
+	angle := func(ux, uy, vx, vy float64) float64 {
+		return math.Atan2(ux*vy-uy*vx, ux*vx+uy*vy)
+	}
+
+	startAngle := angle(1, 0, (x1-cx1)/rx, (y1-cy1)/ry)
+	sweepAngle := angle((x1-cx1)/rx, (y1-cy1)/ry, (-x1-cx1)/rx, (-y1-cy1)/ry)
+
+	if !sweep && sweepAngle > 0 {
+		sweepAngle -= 2 * math.Pi
+	} else if sweep && sweepAngle < 0 {
+		sweepAngle += 2 * math.Pi
+	}
+
+	segments := max(int(math.Ceil(math.Abs(sweepAngle)/(math.Pi/16))), 1)
+	for idx := 1; idx <= segments; idx++ {
+		theta := startAngle + sweepAngle*float64(idx)/float64(segments)
+		x, y := rx*math.Cos(theta), ry*math.Sin(theta)
+		b.lineTo(point{x: cosPhi*x - sinPhi*y + cx, y: sinPhi*x + cosPhi*y + cy})
+	}
+
+	b.position = end
+}
+
+// parsePath flattens the path data into polylines, curves and arcs are approximated with line segments.
+func parsePath(data string) ([]polyline, error) {
+	tokenizer := &pathTokenizer{data: data}
+	builder := &pathBuilder{}
+
+	var command byte
+
+	for !tokenizer.done() {
+		if nextCommand, ok := tokenizer.command(); ok {
+			command = nextCommand
+		} else if command == 0 {
+			return nil, fmt.Errorf("path should start with a command")
+		}
+
+		relative := command >= 'a' && command <= 'z'
+		origin := point{}
+		if relative {
+			origin = builder.position
+		}
+
+		var handlingError error
+
+		switch command | 0x20 {
+		case 'm':
+			values, err := tokenizer.numbers(2)
+			if handlingError = err; err == nil {
+				builder.moveTo(point{origin.x + values[0], origin.y + values[1]})
+				// the coordinates after the first pair are implicit line commands
+				if relative {
+					command = 'l'
+				} else {
+					command = 'L'
+				}
+			}
+		case 'l':
+			values, err := tokenizer.numbers(2)
+			if handlingError = err; err == nil {
+				builder.lineTo(point{origin.x + values[0], origin.y + values[1]})
+			}
+		case 'h':
+			value, err := tokenizer.number()
+			if handlingError = err; err == nil {
+				builder.lineTo(point{origin.x + value, builder.position.y})
+			}
+		case 'v':
+			value, err := tokenizer.number()
+			if handlingError = err; err == nil {
+				builder.lineTo(point{builder.position.x, origin.y + value})
+			}
+		case 'c':
+			values, err := tokenizer.numbers(6)
+			if handlingError = err; err == nil {
+				c2 := point{origin.x + values[2], origin.y + values[3]}
+				builder.cubicTo(point{origin.x + values[0], origin.y + values[1]}, c2, point{origin.x + values[4], origin.y + values[5]})
+				builder.lastControl = c2
+			}
+		case 's':
+			values, err := tokenizer.numbers(4)
+			if handlingError = err; err == nil {
+				c1 := builder.position
+				if last := builder.lastCommand | 0x20; last == 'c' || last == 's' {
+					c1 = point{2*builder.position.x - builder.lastControl.x, 2*builder.position.y - builder.lastControl.y}
+				}
+				c2 := point{origin.x + values[0], origin.y + values[1]}
+				builder.cubicTo(c1, c2, point{origin.x + values[2], origin.y + values[3]})
+				builder.lastControl = c2
+			}
+		case 'q':
+			values, err := tokenizer.numbers(4)
+			if handlingError = err; err == nil {
+				control := point{origin.x + values[0], origin.y + values[1]}
+				builder.quadTo(control, point{origin.x + values[2], origin.y + values[3]})
+				builder.lastControl = control
+			}
+		case 't':
+			values, err := tokenizer.numbers(2)
+			if handlingError = err; err == nil {
+				control := builder.position
+				if last := builder.lastCommand | 0x20; last == 'q' || last == 't' {
+					control = point{2*builder.position.x - builder.lastControl.x, 2*builder.position.y - builder.lastControl.y}
+				}
+				builder.quadTo(control, point{origin.x + values[0], origin.y + values[1]})
+				builder.lastControl = control
+			}
+		case 'a':
+			handlingError = parseArc(tokenizer, builder, origin)
+		case 'z':
+			builder.close()
+		default:
+			return nil, fmt.Errorf("unknown path command %c", command)
+		}
+
+		if handlingError != nil {
+			return nil, handlingError
+		}
+
+		builder.lastCommand = command
+	}
+
+	builder.flush()
+	return builder.polylines, nil
+}
+
+func parseArc(tokenizer *pathTokenizer, builder *pathBuilder, origin point) error {
+	radii, radiiError := tokenizer.numbers(3)
+	if radiiError != nil {
+		return radiiError
+	}
+
+	largeArc, largeArcError := tokenizer.flag()
+	if largeArcError != nil {
+		return largeArcError
+	}
+
+	sweep, sweepError := tokenizer.flag()
+	if sweepError != nil {
+		return sweepError
+	}
+
+	end, endError := tokenizer.numbers(2)
+	if endError != nil {
+		return endError
+	}
+
+	builder.arcTo(radii[0], radii[1], radii[2], largeArc, sweep, point{origin.x + end[0], origin.y + end[1]})
+	return nil
+}
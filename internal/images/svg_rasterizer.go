@@ -0,0 +1,733 @@
+package images
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"image"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// the image is drawn supersampled and scaled down afterward, which gives anti-aliased edges for free
+const svgSupersampling = 4
+
+const maxSvgUseDepth = 8
+const maxSvgRenderedShapes = 10000
+
+var numberRegexp = regexp.MustCompile(`[-+]?(?:\d+\.?\d*|\.\d+)(?:[eE][-+]?\d+)?`)
+var transformRegexp = regexp.MustCompile(`(matrix|translate|scale|rotate|skewX|skewY)\s*\(([^)]*)\)`)
+
+type svgNode struct {
+	name       string
+	attributes map[string]string
+	children   []*svgNode
+}
+
+func (n *svgNode) attribute(name string) string {
+	return n.attributes[name]
+}
+
+// matrix is the affine transformation [a c e; b d f; 0 0 1].
+type matrix [6]float64
+
+var identityMatrix = matrix{1, 0, 0, 1, 0, 0}
+
+func (m matrix) multiply(n matrix) matrix {
+	return matrix{
+		m[0]*n[0] + m[2]*n[1],
+		m[1]*n[0] + m[3]*n[1],
+		m[0]*n[2] + m[2]*n[3],
+		m[1]*n[2] + m[3]*n[3],
+		m[0]*n[4] + m[2]*n[5] + m[4],
+		m[1]*n[4] + m[3]*n[5] + m[5],
+	}
+}
+
+func (m matrix) apply(p point) point {
+	return point{x: m[0]*p.x + m[2]*p.y + m[4], y: m[1]*p.x + m[3]*p.y + m[5]}
+}
+
+func (m matrix) scaleFactor() float64 {
+	return math.Sqrt(math.Abs(m[0]*m[3] - m[1]*m[2]))
+}
+
+func translation(x, y float64) matrix {
+	return matrix{1, 0, 0, 1, x, y}
+}
+
+type rgbColor struct {
+	r, g, b float64
+}
+
+type svgStyle struct {
+	fill          *rgbColor
+	stroke        *rgbColor
+	color         rgbColor
+	fillOpacity   float64
+	strokeOpacity float64
+	opacity       float64
+	strokeWidth   float64
+	evenOdd       bool
+	hidden        bool
+}
+
+type svgRenderer struct {
+	canvas        *image.RGBA
+	elementsById  map[string]*svgNode
+	renderedCount int
+}
+
+// RasterizeSvg draws the svg so that its longest side is size pixels. It supports the shapes, paths,
+// transformations and solid paint, which covers typical icons. Text, gradients (painted with their first
+// stop), clipping, masks and markers are not rendered.
+func RasterizeSvg(data []byte, size int) (image.Image, error) {
+	root, elementsById, parsingError := parseSvgTree(data)
+	if parsingError != nil {
+		return nil, parsingError
+	}
+
+	minX, minY, width, height, viewportError := parseViewport(root)
+	if viewportError != nil {
+		return nil, viewportError
+	}
+
+	scale := float64(size) / math.Max(width, height)
+	outputWidth := max(int(math.Round(width*scale)), 1)
+	outputHeight := max(int(math.Round(height*scale)), 1)
+
+	renderer := &svgRenderer{
+		canvas:       image.NewRGBA(image.Rect(0, 0, outputWidth*svgSupersampling, outputHeight*svgSupersampling)),
+		elementsById: elementsById,
+	}
+
+	supersampledScale := scale * svgSupersampling
+	ctm := matrix{supersampledScale, 0, 0, supersampledScale, 0, 0}.multiply(translation(-minX, -minY))
+
+	rootStyle := svgStyle{
+		fill:          &rgbColor{},
+		fillOpacity:   1,
+		strokeOpacity: 1,
+		opacity:       1,
+		strokeWidth:   1,
+	}
+
+	if renderingError := renderer.renderChildren(root, ctm, rootStyle, 0); renderingError != nil {
+		return nil, renderingError
+	}
+
+	return downscale(renderer.canvas, outputWidth, outputHeight), nil
+}
+
+func parseSvgTree(data []byte) (*svgNode, map[string]*svgNode, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = true
+
+	elementsById := make(map[string]*svgNode)
+	stack := make([]*svgNode, 0)
+	var root *svgNode
+
+	for {
+		token, tokenError := decoder.Token()
+		if errors.Is(tokenError, io.EOF) {
+			break
+		}
+		if tokenError != nil {
+			return nil, nil, tokenError
+		}
+
+		switch typedToken := token.(type) {
+		case xml.StartElement:
+			node := &svgNode{name: typedToken.Name.Local, attributes: make(map[string]string)}
+			for _, attribute := range typedToken.Attr {
+				node.attributes[attribute.Name.Local] = attribute.Value
+			}
+
+			if id := node.attribute("id"); id != "" {
+				elementsById[id] = node
+			}
+
+			if len(stack) == 0 {
+				root = node
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+			}
+
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if root == nil || root.name != "svg" {
+		return nil, nil, errors.New("root element should be svg")
+	}
+
+	return root, elementsById, nil
+}
+
+func parseViewport(root *svgNode) (float64, float64, float64, float64, error) {
+	if viewBox := parseNumbers(root.attribute("viewBox")); len(viewBox) == 4 && viewBox[2] > 0 && viewBox[3] > 0 {
+		return viewBox[0], viewBox[1], viewBox[2], viewBox[3], nil
+	}
+
+	width, height := parseLength(root.attribute("width")), parseLength(root.attribute("height"))
+	if width <= 0 || height <= 0 || strings.HasSuffix(root.attribute("width"), "%") {
+		return 0, 0, 0, 0, errors.New("svg should have a view box or an absolute size")
+	}
+
+	return 0, 0, width, height, nil
+}
+
+func (r *svgRenderer) renderChildren(node *svgNode, ctm matrix, style svgStyle, useDepth int) error {
+	for _, child := range node.children {
+		if renderingError := r.render(child, ctm, style, useDepth); renderingError != nil {
+			return renderingError
+		}
+	}
+
+	return nil
+}
+
+func (r *svgRenderer) render(node *svgNode, ctm matrix, inherited svgStyle, useDepth int) error {
+	switch node.name {
+	case "defs", "symbol", "clipPath", "mask", "linearGradient", "radialGradient", "pattern", "marker",
+		"title", "desc", "stop", "text", "tspan":
+		return nil
+	}
+
+	r.renderedCount++
+	if r.renderedCount > maxSvgRenderedShapes {
+		return errors.New("svg has too many elements to render")
+	}
+
+	style := r.resolveStyle(node, inherited)
+	if style.hidden {
+		return nil
+	}
+
+	ctm = ctm.multiply(parseTransform(node.attribute("transform")))
+
+	switch node.name {
+	case "g":
+		return r.renderChildren(node, ctm, style, useDepth)
+	case "svg":
+		offset := translation(parseLength(node.attribute("x")), parseLength(node.attribute("y")))
+		return r.renderChildren(node, ctm.multiply(offset), style, useDepth)
+	case "use":
+		return r.renderUse(node, ctm, style, useDepth)
+	}
+
+	polylines, geometryError := shapeGeometry(node)
+	if geometryError != nil {
+		return geometryError
+	}
+
+	r.paint(polylines, ctm, style)
+	return nil
+}
+
+func (r *svgRenderer) renderUse(node *svgNode, ctm matrix, style svgStyle, useDepth int) error {
+	if useDepth >= maxSvgUseDepth {
+		return errors.New("svg use elements are nested too deep")
+	}
+
+	href := node.attribute("href")
+	referenced, found := r.elementsById[strings.TrimPrefix(href, "#")]
+	if !strings.HasPrefix(href, "#") || !found {
+		return nil
+	}
+
+	ctm = ctm.multiply(translation(parseLength(node.attribute("x")), parseLength(node.attribute("y"))))
+
+	if referenced.name == "symbol" {
+		return r.renderChildren(referenced, ctm, style, useDepth+1)
+	}
+
+	return r.render(referenced, ctm, style, useDepth+1)
+}
+
+func (r *svgRenderer) resolveStyle(node *svgNode, inherited svgStyle) svgStyle {
+	style := inherited
+	style.opacity = 1
+
+	properties := make(map[string]string)
+	for _, name := range []string{
+		"fill", "stroke", "color", "fill-opacity", "stroke-opacity", "opacity", "stroke-width",
+		"fill-rule", "display", "visibility",
+	} {
+		if value, ok := node.attributes[name]; ok {
+			properties[name] = value
+		}
+	}
+
+	for _, declaration := range strings.Split(node.attribute("style"), ";") {
+		name, value, found := strings.Cut(declaration, ":")
+		if found {
+			properties[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+	}
+
+	if value, ok := properties["color"]; ok {
+		if parsedColor, isNone, valid := r.parsePaint(value, inherited.color); valid && !isNone {
+			style.color = *parsedColor
+		}
+	}
+
+	if value, ok := properties["fill"]; ok {
+		if parsedColor, isNone, valid := r.parsePaint(value, style.color); valid {
+			style.fill = parsedColor
+			if isNone {
+				style.fill = nil
+			}
+		}
+	}
+
+	if value, ok := properties["stroke"]; ok {
+		if parsedColor, isNone, valid := r.parsePaint(value, style.color); valid {
+			style.stroke = parsedColor
+			if isNone {
+				style.stroke = nil
+			}
+		}
+	}
+
+	if value, ok := properties["fill-opacity"]; ok {
+		style.fillOpacity = parseOpacity(value)
+	}
+	if value, ok := properties["stroke-opacity"]; ok {
+		style.strokeOpacity = parseOpacity(value)
+	}
+	if value, ok := properties["opacity"]; ok {
+		style.opacity = parseOpacity(value)
+	}
+	if value, ok := properties["stroke-width"]; ok {
+		style.strokeWidth = math.Max(parseLength(value), 0)
+	}
+	if value, ok := properties["fill-rule"]; ok {
+		style.evenOdd = value == "evenodd"
+	}
+
+	// group opacity is approximated by multiplying the opacity of every element in the group
+	style.fillOpacity *= style.opacity * inherited.opacity
+	style.strokeOpacity *= style.opacity * inherited.opacity
+	style.opacity *= inherited.opacity
+
+	style.hidden = properties["display"] == "none" || properties["visibility"] == "hidden"
+	return style
+}
+
+var namedColors = map[string]rgbColor{
+	"black": {0, 0, 0}, "white": {255, 255, 255}, "red": {255, 0, 0}, "green": {0, 128, 0},
+	"blue": {0, 0, 255}, "yellow": {255, 255, 0}, "cyan": {0, 255, 255}, "aqua": {0, 255, 255},
+	"magenta": {255, 0, 255}, "fuchsia": {255, 0, 255}, "gray": {128, 128, 128}, "grey": {128, 128, 128},
+	"silver": {192, 192, 192}, "maroon": {128, 0, 0}, "olive": {128, 128, 0}, "lime": {0, 255, 0},
+	"teal": {0, 128, 128}, "navy": {0, 0, 128}, "purple": {128, 0, 128}, "orange": {255, 165, 0},
+}
+
+// parsePaint returns the color, whether the paint is none and whether the value could be parsed at all.
+func (r *svgRenderer) parsePaint(value string, currentColor rgbColor) (*rgbColor, bool, bool) {
+	value = strings.TrimSpace(value)
+	lowered := strings.ToLower(value)
+
+	switch {
+	case lowered == "none" || lowered == "transparent":
+		return nil, true, true
+	case lowered == "currentcolor":
+		return &currentColor, false, true
+	case strings.HasPrefix(lowered, "url("):
+		return r.parseGradientPaint(value, currentColor)
+	case strings.HasPrefix(lowered, "#"):
+		return parseHexColor(lowered[1:])
+	case strings.HasPrefix(lowered, "rgb"):
+		components := parseNumbers(lowered)
+		if len(components) < 3 {
+			return nil, false, false
+		}
+		return &rgbColor{r: clampColor(components[0]), g: clampColor(components[1]), b: clampColor(components[2])}, false, true
+	}
+
+	if named, ok := namedColors[lowered]; ok {
+		return &named, false, true
+	}
+
+	return nil, false, false
+}
+
+// parseGradientPaint paints the gradient with its first stop, so at least the main color of the icon is kept.
+func (r *svgRenderer) parseGradientPaint(value string, currentColor rgbColor) (*rgbColor, bool, bool) {
+	id := strings.Trim(strings.TrimSuffix(strings.TrimPrefix(value[4:], "#"), ")"), `'" `)
+	id = strings.TrimPrefix(id, "#")
+
+	gradient, found := r.elementsById[id]
+	if !found {
+		return nil, true, true
+	}
+
+	for _, child := range gradient.children {
+		if child.name != "stop" {
+			continue
+		}
+
+		stopColor := child.attribute("stop-color")
+		for _, declaration := range strings.Split(child.attribute("style"), ";") {
+			if name, styleValue, ok := strings.Cut(declaration, ":"); ok && strings.TrimSpace(name) == "stop-color" {
+				stopColor = styleValue
+			}
+		}
+
+		if stopColor == "" {
+			return &rgbColor{}, false, true
+		}
+
+		return r.parsePaint(stopColor, currentColor)
+	}
+
+	return nil, true, true
+}
+
+func parseHexColor(hex string) (*rgbColor, bool, bool) {
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	if len(hex) != 6 {
+		return nil, false, false
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return nil, false, false
+	}
+
+	return &rgbColor{r: float64(value >> 16 & 0xFF), g: float64(value >> 8 & 0xFF), b: float64(value & 0xFF)}, false, true
+}
+
+func clampColor(value float64) float64 {
+	return math.Min(math.Max(value, 0), 255)
+}
+
+func parseOpacity(value string) float64 {
+	value = strings.TrimSpace(value)
+	opacity := parseLength(value)
+	if strings.HasSuffix(value, "%") {
+		opacity /= 100
+	}
+
+	return math.Min(math.Max(opacity, 0), 1)
+}
+
+func parseNumbers(value string) []float64 {
+	matches := numberRegexp.FindAllString(value, -1)
+	numbers := make([]float64, 0, len(matches))
+	for _, match := range matches {
+		number, err := strconv.ParseFloat(match, 64)
+		if err == nil {
+			numbers = append(numbers, number)
+		}
+	}
+
+	return numbers
+}
+
+// parseLength reads the leading number, units are ignored as the user unit is the pixel.
+func parseLength(value string) float64 {
+	match := numberRegexp.FindString(strings.TrimSpace(value))
+	number, err := strconv.ParseFloat(match, 64)
+	if err != nil {
+		return 0
+	}
+
+	return number
+}
+
+func parseTransform(value string) matrix {
+	result := identityMatrix
+
+	for _, match := range transformRegexp.FindAllStringSubmatch(value, -1) {
+		arguments := parseNumbers(match[2])
+		argument := func(idx int, fallback float64) float64 {
+			if idx < len(arguments) {
+				return arguments[idx]
+			}
+			return fallback
+		}
+
+		var transform matrix
+		switch match[1] {
+		case "matrix":
+			if len(arguments) != 6 {
+				continue
+			}
+			transform = matrix{arguments[0], arguments[1], arguments[2], arguments[3], arguments[4], arguments[5]}
+		case "translate":
+			transform = translation(argument(0, 0), argument(1, 0))
+		case "scale":
+			scaleX := argument(0, 1)
+			transform = matrix{scaleX, 0, 0, argument(1, scaleX), 0, 0}
+		case "rotate":
+			angle := argument(0, 0) * math.Pi / 180
+			cx, cy := argument(1, 0), argument(2, 0)
+			cos, sin := math.Cos(angle), math.Sin(angle)
+			transform = translation(cx, cy).multiply(matrix{cos, sin, -sin, cos, 0, 0}).multiply(translation(-cx, -cy))
+		case "skewX":
+			transform = matrix{1, 0, math.Tan(argument(0, 0) * math.Pi / 180), 1, 0, 0}
+		case "skewY":
+			transform = matrix{1, math.Tan(argument(0, 0) * math.Pi / 180), 0, 1, 0, 0}
+		}
+
+		result = result.multiply(transform)
+	}
+
+	return result
+}
+
+func shapeGeometry(node *svgNode) ([]polyline, error) {
+	length := func(name string) float64 {
+		return parseLength(node.attribute(name))
+	}
+
+	switch node.name {
+	case "path":
+		return parsePath(node.attribute("d"))
+	case "rect":
+		return rectGeometry(length("x"), length("y"), length("width"), length("height"), node), nil
+	case "circle":
+		return ellipseGeometry(length("cx"), length("cy"), length("r"), length("r")), nil
+	case "ellipse":
+		return ellipseGeometry(length("cx"), length("cy"), length("rx"), length("ry")), nil
+	case "line":
+		return []polyline{{points: []point{{length("x1"), length("y1")}, {length("x2"), length("y2")}}}}, nil
+	case "polyline", "polygon":
+		numbers := parseNumbers(node.attribute("points"))
+		points := make([]point, 0, len(numbers)/2)
+		for idx := 0; idx+1 < len(numbers); idx += 2 {
+			points = append(points, point{numbers[idx], numbers[idx+1]})
+		}
+		if len(points) < 2 {
+			return nil, nil
+		}
+		return []polyline{{points: points, closed: node.name == "polygon"}}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func rectGeometry(x, y, width, height float64, node *svgNode) []polyline {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+
+	_, hasRx := node.attributes["rx"]
+	_, hasRy := node.attributes["ry"]
+	rx, ry := parseLength(node.attribute("rx")), parseLength(node.attribute("ry"))
+
+	switch {
+	case hasRx && !hasRy:
+		ry = rx
+	case hasRy && !hasRx:
+		rx = ry
+	}
+
+	rx, ry = math.Min(math.Max(rx, 0), width/2), math.Min(math.Max(ry, 0), height/2)
+
+	if rx == 0 || ry == 0 {
+		return []polyline{{
+			points: []point{{x, y}, {x + width, y}, {x + width, y + height}, {x, y + height}},
+			closed: true,
+		}}
+	}
+
+	builder := &pathBuilder{}
+	builder.moveTo(point{x + rx, y})
+	builder.lineTo(point{x + width - rx, y})
+	builder.arcTo(rx, ry, 0, false, true, point{x + width, y + ry})
+	builder.lineTo(point{x + width, y + height - ry})
+	builder.arcTo(rx, ry, 0, false, true, point{x + width - rx, y + height})
+	builder.lineTo(point{x + rx, y + height})
+	builder.arcTo(rx, ry, 0, false, true, point{x, y + height - ry})
+	builder.lineTo(point{x, y + ry})
+	builder.arcTo(rx, ry, 0, false, true, point{x + rx, y})
+	builder.close()
+
+	return builder.polylines
+}
+
+func ellipseGeometry(cx, cy, rx, ry float64) []polyline {
+	if rx <= 0 || ry <= 0 {
+		return nil
+	}
+
+	const segments = 64
+	points := make([]point, segments)
+	for idx := range segments {
+		angle := 2 * math.Pi * float64(idx) / segments
+		points[idx] = point{cx + rx*math.Cos(angle), cy + ry*math.Sin(angle)}
+	}
+
+	return []polyline{{points: points, closed: true}}
+}
+
+func (r *svgRenderer) paint(polylines []polyline, ctm matrix, style svgStyle) {
+	if len(polylines) == 0 {
+		return
+	}
+
+	transformed := make([]polyline, len(polylines))
+	for idx, line := range polylines {
+		points := make([]point, len(line.points))
+		for pointIdx, p := range line.points {
+			points[pointIdx] = ctm.apply(p)
+		}
+		transformed[idx] = polyline{points: points, closed: line.closed}
+	}
+
+	if style.fill != nil && style.fillOpacity > 0 {
+		polygons := make([][]point, len(transformed))
+		for idx, line := range transformed {
+			polygons[idx] = line.points
+		}
+
+		r.fill(polygons, *style.fill, style.fillOpacity, style.evenOdd)
+	}
+
+	halfWidth := style.strokeWidth * ctm.scaleFactor() / 2
+	if style.stroke != nil && style.strokeOpacity > 0 && halfWidth > 0 {
+		r.fill(strokePolygons(transformed, halfWidth), *style.stroke, style.strokeOpacity, false)
+	}
+}
+
+// strokePolygons outlines every segment with a quad and puts a disc on every vertex (round joins and caps).
+// All the polygons have the same orientation, so filling them with the nonzero rule gives their union.
+func strokePolygons(polylines []polyline, halfWidth float64) [][]point {
+	polygons := make([][]point, 0)
+
+	for _, line := range polylines {
+		segmentCount := len(line.points) - 1
+		if line.closed {
+			segmentCount++
+		}
+
+		for idx := range segmentCount {
+			start, end := line.points[idx], line.points[(idx+1)%len(line.points)]
+			dx, dy := end.x-start.x, end.y-start.y
+			segmentLength := math.Hypot(dx, dy)
+			if segmentLength == 0 {
+				continue
+			}
+
+			nx, ny := -dy/segmentLength*halfWidth, dx/segmentLength*halfWidth
+			polygons = append(polygons, orient([]point{
+				{start.x + nx, start.y + ny},
+				{end.x + nx, end.y + ny},
+				{end.x - nx, end.y - ny},
+				{start.x - nx, start.y - ny},
+			}))
+		}
+
+		for _, vertex := range line.points {
+			polygons = append(polygons, orient(ellipseGeometry(vertex.x, vertex.y, halfWidth, halfWidth)[0].points))
+		}
+	}
+
+	return polygons
+}
+
+func orient(polygon []point) []point {
+	area := 0.0
+	for idx, current := range polygon {
+		next := polygon[(idx+1)%len(polygon)]
+		area += current.x*next.y - next.x*current.y
+	}
+
+	if area < 0 {
+		for left, right := 0, len(polygon)-1; left < right; left, right = left+1, right-1 {
+			polygon[left], polygon[right] = polygon[right], polygon[left]
+		}
+	}
+
+	return polygon
+}
+
+type edgeCrossing struct {
+	x         float64
+	direction int
+}
+
+// fill is a scanline polygon fill sampling the pixel centers, the polygons are closed implicitly.
+func (r *svgRenderer) fill(polygons [][]point, paint rgbColor, opacity float64, evenOdd bool) {
+	bounds := r.canvas.Bounds()
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for _, polygon := range polygons {
+		for _, p := range polygon {
+			minY, maxY = math.Min(minY, p.y), math.Max(maxY, p.y)
+		}
+	}
+
+	startRow := max(int(math.Floor(minY)), bounds.Min.Y)
+	endRow := min(int(math.Ceil(maxY)), bounds.Max.Y-1)
+
+	alpha := opacity * 255
+	sourceR, sourceG, sourceB := paint.r*opacity, paint.g*opacity, paint.b*opacity
+
+	crossings := make([]edgeCrossing, 0)
+
+	for row := startRow; row <= endRow; row++ {
+		sampleY := float64(row) + 0.5
+		crossings = crossings[:0]
+
+		for _, polygon := range polygons {
+			for idx, start := range polygon {
+				end := polygon[(idx+1)%len(polygon)]
+
+				direction := 1
+				if start.y > end.y {
+					start, end = end, start
+					direction = -1
+				}
+
+				if sampleY < start.y || sampleY >= end.y {
+					continue
+				}
+
+				x := start.x + (sampleY-start.y)*(end.x-start.x)/(end.y-start.y)
+				crossings = append(crossings, edgeCrossing{x: x, direction: direction})
+			}
+		}
+
+		sort.Slice(crossings, func(i, j int) bool {
+			return crossings[i].x < crossings[j].x
+		})
+
+		winding := 0
+		for idx := 0; idx+1 < len(crossings); idx++ {
+			winding += crossings[idx].direction
+
+			inside := winding != 0
+			if evenOdd {
+				inside = (idx+1)%2 == 1
+			}
+
+			if !inside {
+				continue
+			}
+
+			firstColumn := max(int(math.Ceil(crossings[idx].x-0.5)), bounds.Min.X)
+			lastColumn := min(int(math.Ceil(crossings[idx+1].x-0.5))-1, bounds.Max.X-1)
+
+			for column := firstColumn; column <= lastColumn; column++ {
+				offset := r.canvas.PixOffset(column, row)
+				remaining := 1 - opacity
+				r.canvas.Pix[offset] = uint8(sourceR + float64(r.canvas.Pix[offset])*remaining)
+				r.canvas.Pix[offset+1] = uint8(sourceG + float64(r.canvas.Pix[offset+1])*remaining)
+				r.canvas.Pix[offset+2] = uint8(sourceB + float64(r.canvas.Pix[offset+2])*remaining)
+				r.canvas.Pix[offset+3] = uint8(alpha + float64(r.canvas.Pix[offset+3])*remaining)
+			}
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package images
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+const svgNamespace = "http://www.w3.org/2000/svg"
+const xlinkNamespace = "http://www.w3.org/1999/xlink"
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+const maxSvgDepth = 64
+
+// allowedSvgElements only contains the elements that draw something or describe the drawing, everything
+// else (script, foreignObject, style, animations, ...) is removed together with its children.
+var allowedSvgElements = map[string]bool{
+	"svg": true, "g": true, "defs": true, "symbol": true, "use": true, "title": true, "desc": true,
+	"path": true, "rect": true, "circle": true, "ellipse": true, "line": true, "polyline": true, "polygon": true,
+	"text": true, "tspan": true, "linearGradient": true, "radialGradient": true, "stop": true,
+	"clipPath": true, "mask": true, "pattern": true, "marker": true,
+}
+
+var allowedSvgAttributes = map[string]bool{
+	"id": true, "class": true, "version": true, "width": true, "height": true, "viewBox": true,
+	"preserveAspectRatio": true, "transform": true, "x": true, "y": true, "x1": true, "y1": true, "x2": true,
+	"y2": true, "cx": true, "cy": true, "r": true, "rx": true, "ry": true, "fx": true, "fy": true, "fr": true,
+	"d": true, "points": true, "pathLength": true, "fill": true, "fill-opacity": true, "fill-rule": true,
+	"stroke": true, "stroke-width": true, "stroke-opacity": true, "stroke-linecap": true,
+	"stroke-linejoin": true, "stroke-miterlimit": true, "stroke-dasharray": true, "stroke-dashoffset": true,
+	"opacity": true, "color": true, "display": true, "visibility": true, "clip-path": true, "clip-rule": true,
+	"clipPathUnits": true, "mask": true, "maskUnits": true, "maskContentUnits": true, "style": true,
+	"offset": true, "stop-color": true, "stop-opacity": true, "gradientUnits": true, "gradientTransform": true,
+	"spreadMethod": true, "patternUnits": true, "patternContentUnits": true, "patternTransform": true,
+	"markerWidth": true, "markerHeight": true, "markerUnits": true, "refX": true, "refY": true, "orient": true,
+	"marker-start": true, "marker-mid": true, "marker-end": true, "font-family": true, "font-size": true,
+	"font-weight": true, "font-style": true, "text-anchor": true, "dominant-baseline": true,
+	"letter-spacing": true, "dx": true, "dy": true, "rotate": true, "textLength": true, "lengthAdjust": true,
+	"vector-effect": true, "shape-rendering": true, "href": true,
+}
+
+var urlReferenceRegexp = regexp.MustCompile(`(?i)url\(\s*['"]?\s*([^'")\s]*)`)
+
+var forbiddenValueMarkers = []string{"javascript:", "vbscript:", "data:", "expression(", "@import", "behavior:", "-moz-binding"}
+
+var ErrUnsafeSvg = fmt.Errorf("%w: svg can't be sanitized", ErrUnsupportedFormat)
+
+// SanitizeSvg rewrites the document keeping only the allow-listed elements and attributes. Event handlers,
+// scripts and references to anything outside the document are dropped, documents that are not well-formed
+// or don't have svg as the root are rejected.
+func SanitizeSvg(data []byte) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = true
+
+	var output bytes.Buffer
+	depth := 0
+	skippedDepth := 0
+	rootClosed := false
+
+	for {
+		token, tokenError := decoder.Token()
+		if errors.Is(tokenError, io.EOF) {
+			break
+		}
+		if tokenError != nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnsafeSvg, tokenError)
+		}
+
+		switch typedToken := token.(type) {
+		case xml.StartElement:
+			if rootClosed {
+				return nil, fmt.Errorf("%w: document has more than one root", ErrUnsafeSvg)
+			}
+
+			depth++
+			if depth > maxSvgDepth {
+				return nil, fmt.Errorf("%w: document is nested too deep", ErrUnsafeSvg)
+			}
+
+			if skippedDepth > 0 {
+				skippedDepth++
+				continue
+			}
+
+			isSvgElement := typedToken.Name.Space == svgNamespace || typedToken.Name.Space == ""
+
+			if depth == 1 && (!isSvgElement || typedToken.Name.Local != "svg") {
+				return nil, fmt.Errorf("%w: root element should be svg", ErrUnsafeSvg)
+			}
+
+			if !isSvgElement || !allowedSvgElements[typedToken.Name.Local] {
+				skippedDepth = 1
+				continue
+			}
+
+			writeStartElement(&output, typedToken, depth == 1)
+		case xml.EndElement:
+			depth--
+			if depth == 0 {
+				rootClosed = true
+			}
+
+			if skippedDepth > 0 {
+				skippedDepth--
+				continue
+			}
+
+			output.WriteString("</")
+			output.WriteString(typedToken.Name.Local)
+			output.WriteString(">")
+		case xml.CharData:
+			if depth > 0 && skippedDepth == 0 {
+				_ = xml.EscapeText(&output, typedToken)
+			}
+		}
+		// comments, processing instructions and directives (doctype with its entities) are never copied
+	}
+
+	if !rootClosed {
+		return nil, fmt.Errorf("%w: document has no svg element", ErrUnsafeSvg)
+	}
+
+	return output.Bytes(), nil
+}
+
+func writeStartElement(output *bytes.Buffer, element xml.StartElement, isRoot bool) {
+	output.WriteString("<")
+	output.WriteString(element.Name.Local)
+
+	if isRoot {
+		output.WriteString(` xmlns="` + svgNamespace + `" xmlns:xlink="` + xlinkNamespace + `"`)
+	}
+
+	for _, attribute := range element.Attr {
+		name, allowed := sanitizeAttributeName(attribute.Name)
+		if !allowed || !isSafeAttributeValue(attribute.Name.Local, attribute.Value) {
+			continue
+		}
+
+		output.WriteString(" ")
+		output.WriteString(name)
+		output.WriteString(`="`)
+		_ = xml.EscapeText(output, []byte(attribute.Value))
+		output.WriteString(`"`)
+	}
+
+	output.WriteString(">")
+}
+
+// sanitizeAttributeName returns the name the attribute is written with, namespace declarations are
+// dropped as the root declares the only namespaces the output uses.
+func sanitizeAttributeName(name xml.Name) (string, bool) {
+	switch {
+	case name.Space == "" && strings.HasPrefix(strings.ToLower(name.Local), "on"):
+		return "", false
+	case name.Space == "" && allowedSvgAttributes[name.Local]:
+		return name.Local, true
+	case name.Space == xlinkNamespace && name.Local == "href":
+		return "xlink:href", true
+	case name.Space == xmlNamespace && name.Local == "space":
+		return "xml:space", true
+	default:
+		return "", false
+	}
+}
+
+func isSafeAttributeValue(name string, value string) bool {
+	normalized := strings.ToLower(strings.Join(strings.Fields(value), ""))
+	for _, marker := range forbiddenValueMarkers {
+		if strings.Contains(normalized, marker) {
+			return false
+		}
+	}
+
+	// only references to elements of the same document are allowed
+	if name == "href" {
+		return strings.HasPrefix(strings.TrimSpace(value), "#")
+	}
+
+	for _, match := range urlReferenceRegexp.FindAllStringSubmatch(value, -1) {
+		if !strings.HasPrefix(match[1], "#") {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,278 @@
+package interest_archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"chat_app_backend/internal/extensions"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+)
+
+const (
+	FormatJson = "json"
+	FormatCsv  = "csv"
+)
+
+const (
+	jsonManifestName = "manifest.json"
+	csvManifestName  = "manifest.csv"
+	IconsDirectory   = "icons/"
+)
+
+var csvHeader = []string{"title", "description", "icon", "category_id", "position"}
+
+var (
+	ErrManifestNotFound = errors.New("archive should contain manifest.json or manifest.csv")
+	ErrArchiveTooLarge  = errors.New("archive is too large")
+	ErrInvalidArchive   = errors.New("archive is invalid")
+	ErrInvalidManifest  = errors.New("manifest is invalid")
+)
+
+// Row is an interest of the manifest, Icon is the path of the icon file inside the archive.
+type Row struct {
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	Icon        string           `json:"icon"`
+	CategoryID  *extensions.UUID `json:"category_id"`
+	Position    int32            `json:"position"`
+}
+
+// Archive has the rows of the manifest in their order and the content of every other file by its path.
+type Archive struct {
+	Rows  []Row
+	Files map[string][]byte
+}
+
+// Read parses the zip archive. The declared sizes of the files can't be trusted, so the reading stops once
+// more than maxUncompressedSize bytes are extracted in total.
+func Read(reader io.ReaderAt, size int64, maxUncompressedSize int64) (*Archive, error) {
+	zipReader, openingError := zip.NewReader(reader, size)
+	if openingError != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, openingError)
+	}
+
+	archive := &Archive{Files: make(map[string][]byte)}
+	remaining := maxUncompressedSize
+
+	var manifestName string
+	var manifest []byte
+
+	for _, file := range zipReader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		data, readingError := readFile(file, remaining)
+		if readingError != nil {
+			return nil, readingError
+		}
+		remaining -= int64(len(data))
+
+		name := path.Clean(file.Name)
+		switch name {
+		case jsonManifestName, csvManifestName:
+			if manifest != nil {
+				return nil, fmt.Errorf("%w: archive should contain only one manifest", ErrInvalidManifest)
+			}
+			manifestName = name
+			manifest = data
+		default:
+			archive.Files[name] = data
+		}
+	}
+
+	var rows []Row
+	var parsingError error
+
+	switch manifestName {
+	case jsonManifestName:
+		rows, parsingError = parseJsonManifest(manifest)
+	case csvManifestName:
+		rows, parsingError = parseCsvManifest(manifest)
+	default:
+		return nil, ErrManifestNotFound
+	}
+
+	if parsingError != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidManifest, parsingError)
+	}
+
+	archive.Rows = rows
+	return archive, nil
+}
+
+// Write creates the archive in the same format Read accepts, the files are written in the order of
+// their paths, so the same interests always produce the same archive.
+func Write(writer io.Writer, format string, rows []Row, files map[string][]byte) error {
+	zipWriter := zip.NewWriter(writer)
+
+	var manifest []byte
+	var manifestName string
+	var encodingError error
+
+	switch format {
+	case FormatJson:
+		manifestName = jsonManifestName
+		manifest, encodingError = json.MarshalIndent(rows, "", "  ")
+	case FormatCsv:
+		manifestName = csvManifestName
+		manifest, encodingError = encodeCsvManifest(rows)
+	default:
+		return fmt.Errorf("unknown manifest format %s", format)
+	}
+
+	if encodingError != nil {
+		return encodingError
+	}
+
+	if writingError := writeFile(zipWriter, manifestName, manifest); writingError != nil {
+		return writingError
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if writingError := writeFile(zipWriter, name, files[name]); writingError != nil {
+			return writingError
+		}
+	}
+
+	return zipWriter.Close()
+}
+
+func readFile(file *zip.File, limit int64) ([]byte, error) {
+	reader, openingError := file.Open()
+	if openingError != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, openingError)
+	}
+
+	defer func(reader io.ReadCloser) {
+		_ = reader.Close()
+	}(reader)
+
+	data, readingError := io.ReadAll(io.LimitReader(reader, limit+1))
+	if readingError != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, readingError)
+	}
+
+	if int64(len(data)) > limit {
+		return nil, ErrArchiveTooLarge
+	}
+
+	return data, nil
+}
+
+func writeFile(zipWriter *zip.Writer, name string, data []byte) error {
+	fileWriter, creationError := zipWriter.Create(name)
+	if creationError != nil {
+		return creationError
+	}
+
+	_, writingError := fileWriter.Write(data)
+	return writingError
+}
+
+func parseJsonManifest(data []byte) ([]Row, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var rows []Row
+	if decodingError := decoder.Decode(&rows); decodingError != nil {
+		return nil, decodingError
+	}
+
+	return rows, nil
+}
+
+func parseCsvManifest(data []byte) ([]Row, error) {
+	records, readingError := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if readingError != nil {
+		return nil, readingError
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("csv manifest should have a header")
+	}
+
+	columns := make(map[string]int, len(records[0]))
+	for idx, column := range records[0] {
+		columns[column] = idx
+	}
+
+	for _, column := range csvHeader {
+		if _, found := columns[column]; !found {
+			return nil, fmt.Errorf("csv manifest has no %s column", column)
+		}
+	}
+
+	rows := make([]Row, len(records)-1)
+	for idx, record := range records[1:] {
+		row := Row{
+			Title:       record[columns["title"]],
+			Description: record[columns["description"]],
+			Icon:        record[columns["icon"]],
+		}
+
+		// rows are numbered from one and the header is the first line
+		line := idx + 2
+
+		if categoryId := record[columns["category_id"]]; categoryId != "" {
+			row.CategoryID = new(extensions.UUID)
+			if parsingError := row.CategoryID.UnmarshalText([]byte(categoryId)); parsingError != nil {
+				return nil, fmt.Errorf("line %d: invalid category_id: %s", line, parsingError)
+			}
+		}
+
+		if position := record[columns["position"]]; position != "" {
+			parsedPosition, parsingError := strconv.ParseInt(position, 10, 32)
+			if parsingError != nil {
+				return nil, fmt.Errorf("line %d: invalid position: %s", line, parsingError)
+			}
+			row.Position = int32(parsedPosition)
+		}
+
+		rows[idx] = row
+	}
+
+	return rows, nil
+}
+
+func encodeCsvManifest(rows []Row) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+
+	if writingError := writer.Write(csvHeader); writingError != nil {
+		return nil, writingError
+	}
+
+	for _, row := range rows {
+		categoryId := ""
+		if row.CategoryID != nil {
+			categoryId = row.CategoryID.String()
+		}
+
+		writingError := writer.Write([]string{
+			row.Title,
+			row.Description,
+			row.Icon,
+			categoryId,
+			strconv.FormatInt(int64(row.Position), 10),
+		})
+		if writingError != nil {
+			return nil, writingError
+		}
+	}
+
+	writer.Flush()
+	return buffer.Bytes(), writer.Error()
+}
@@ -23,6 +23,8 @@ func (claims Claims[T]) appendMetadataToClaims(
 		expireTimeoutString = cfg.ExpireTimeoutRefresh
 	case AccessToken:
 		expireTimeoutString = cfg.ExpireTimeoutAccess
+	case ImpersonationToken:
+		expireTimeoutString = cfg.ExpireTimeoutImpersonation
 	}
 
 	expireTimeout, durationParseErr := time.ParseDuration(expireTimeoutString)
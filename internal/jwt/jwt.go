@@ -6,6 +6,7 @@ import (
 
 type IHandler[T interface{}] interface {
 	GenerateJwtPair(data T) (accessToken *ValidToken[T], refreshToken *ValidToken[T], err error)
+	GenerateImpersonationToken(data T) (*ValidToken[T], error)
 	getConfig() *JwtConfig
 	generateSingleToken(claims *Claims[T], tokenType TokenType) (*ValidToken[T], error)
 }
@@ -20,7 +21,7 @@ func (handler *Handler[T]) generateSingleToken(claims *Claims[T], tokenType Toke
 	switch tokenType {
 	case RefreshToken:
 		secret = []byte(handler.cfg.RefreshSecret)
-	case AccessToken:
+	case AccessToken, ImpersonationToken:
 		secret = []byte(handler.cfg.AccessSecret)
 	}
 
@@ -52,6 +53,13 @@ func (handler *Handler[T]) GenerateJwtPair(data T) (*ValidToken[T], *ValidToken[
 	return accessToken, refreshToken, nil
 }
 
+// GenerateImpersonationToken issues a single access token without the refresh one, so the impersonation
+// ends once the token expires.
+func (handler *Handler[T]) GenerateImpersonationToken(data T) (*ValidToken[T], error) {
+	claims := CreateClaimsFromData(data)
+	return handler.generateSingleToken(&claims, ImpersonationToken)
+}
+
 func CreateJwtHandler[T interface{}](config *JwtConfig) (handler *Handler[T], error error) {
 	handler = &Handler[T]{}
 	handler.cfg = config
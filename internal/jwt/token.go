@@ -14,7 +14,7 @@ type Token[T interface{}] struct {
 func (token *Token[T]) Validate() (*ValidToken[T], error) {
 	parsedToken, tokenParsingError := jwt.ParseWithClaims(token.token, &Claims[T]{}, func(t *jwt.Token) (any, error) {
 		switch token.tokenType {
-		case AccessToken:
+		case AccessToken, ImpersonationToken:
 			return []byte(token.cfg.AccessSecret), nil
 		case RefreshToken:
 			return []byte(token.cfg.RefreshSecret), nil
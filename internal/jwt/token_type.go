@@ -5,4 +5,7 @@ type TokenType int
 const (
 	AccessToken TokenType = iota
 	RefreshToken
+	// ImpersonationToken is a short-lived access token, it is signed with the access secret, so it is
+	// accepted wherever access tokens are
+	ImpersonationToken
 )
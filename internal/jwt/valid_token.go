@@ -19,7 +19,7 @@ func (token *ValidToken[T]) RefreshRelatedAccessToken(handler IHandler[T]) (*Val
 		appendMetadataToClaims(handler.getConfig(), AccessToken)
 
 	switch token.tokenType {
-	case AccessToken:
+	case AccessToken, ImpersonationToken:
 		panic("can't refresh access token using access token")
 	case RefreshToken:
 		return handler.generateSingleToken(&claims, AccessToken)
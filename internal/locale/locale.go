@@ -0,0 +1,93 @@
+package locale
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// Normalize lowercases the locale and replaces underscores with dashes, so "pt_BR" and "pt-br" are the
+// same locale.
+func Normalize(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// IsValid checks that the normalized locale looks like a language tag, e.g. "en" or "pt-br".
+func IsValid(locale string) bool {
+	return localePattern.MatchString(Normalize(locale))
+}
+
+// ParseAcceptLanguage returns the normalized locales of the Accept-Language header from the most to the
+// least preferred. The wildcard, the invalid entries and the ones with zero quality are skipped.
+func ParseAcceptLanguage(header string) []string {
+	type weightedLocale struct {
+		locale  string
+		quality float64
+	}
+
+	weighted := make([]weightedLocale, 0)
+	for _, entry := range strings.Split(header, ",") {
+		parts := strings.Split(entry, ";")
+		locale := Normalize(parts[0])
+		if !IsValid(locale) {
+			continue
+		}
+
+		quality := 1.0
+		for _, parameter := range parts[1:] {
+			name, value, found := strings.Cut(strings.TrimSpace(parameter), "=")
+			if !found || name != "q" {
+				continue
+			}
+
+			parsedQuality, parsingError := strconv.ParseFloat(value, 64)
+			if parsingError != nil {
+				parsedQuality = 0
+			}
+			quality = parsedQuality
+		}
+
+		if quality <= 0 {
+			continue
+		}
+
+		weighted = append(weighted, weightedLocale{locale: locale, quality: quality})
+	}
+
+	// the order of the header is kept for the locales with the same quality
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].quality > weighted[j].quality
+	})
+
+	locales := make([]string, len(weighted))
+	for idx, entry := range weighted {
+		locales[idx] = entry.locale
+	}
+
+	return locales
+}
+
+// Resolve picks the first preferred locale, which is available, falling back from a regional locale to
+// its language, e.g. "en-gb" is served with "en". The default locale is returned when nothing matches.
+func Resolve(preferred []string, available []string, defaultLocale string) string {
+	availableSet := make(map[string]struct{}, len(available))
+	for _, locale := range available {
+		availableSet[Normalize(locale)] = struct{}{}
+	}
+
+	for _, locale := range preferred {
+		if _, found := availableSet[locale]; found {
+			return locale
+		}
+
+		language, _, _ := strings.Cut(locale, "-")
+		if _, found := availableSet[language]; found {
+			return language
+		}
+	}
+
+	return Normalize(defaultLocale)
+}
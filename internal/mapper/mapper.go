@@ -66,6 +66,15 @@ func mapStruct(destVal reflect.Value, srcVals ...reflect.Value) error {
 
 	for fieldIdx := range destVal.NumField() {
 		destField := destVal.Field(fieldIdx)
+
+		// excluded dest fields are left as they are, so they don't have to exist in any src
+		if tag, ok := destVal.Type().Field(fieldIdx).Tag.Lookup(MapperTag); ok {
+			if tag != ExcludeTagValue {
+				panic(fmt.Sprintf("malformed mapper tag on field with name %s (expected %s, but got %s)", destVal.Type().Field(fieldIdx).Name, ExcludeTagValue, tag))
+			}
+			continue
+		}
+
 		srcField, err := findValue(destVal.Type().Field(fieldIdx), srcVals...)
 
 		if err != nil {
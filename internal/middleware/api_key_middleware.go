@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"chat_app_backend/internal/api_keys"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+
+	"github.com/gin-gonic/gin"
+)
+
+const ServiceAccountKey = "ServiceAccount"
+const ApiKeyHeader = "X-Api-Key"
+
+// ApiKeyMiddleware authenticates the service account by the api key, the requests with a key which can't
+// be verified are rejected. It is installed only on the routes of the service accounts, so the other
+// routes don't depend on the header.
+func ApiKeyMiddleware(services service_wrapper.IServiceWrapper) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key := ctx.GetHeader(ApiKeyHeader)
+		if key == "" {
+			ctx.Next()
+			return
+		}
+
+		prefix, parsingError := api_keys.ParseKey(key)
+		if parsingError != nil {
+			abortWithError(
+				ctx,
+				common_exceptions.UnauthorizedException{
+					BaseRestException: exceptions.BaseRestException{
+						ITrackableException: exceptions.WrapErrorWithTrackableException(parsingError),
+						Message:             "",
+					},
+				},
+			)
+			return
+		}
+
+		queries := services.GetDbConnection().GetQueries()
+
+		row, keyLookupError := queries.GetActiveApiKeyByPrefix(ctx, prefix)
+		if keyLookupError != nil || !api_keys.VerifyKey(key, row.ApiKey.KeyHash) {
+			abortWithError(
+				ctx,
+				common_exceptions.UnauthorizedException{
+					BaseRestException: exceptions.BaseRestException{
+						ITrackableException: exceptions.CreateTrackableExceptionFromStringF(
+							"api key with prefix %s does not exist or was revoked",
+							prefix,
+						),
+						Message: "",
+					},
+				},
+			)
+			return
+		}
+
+		if touchError := queries.TouchApiKey(ctx, row.ApiKey.ID); touchError != nil {
+			abortWithError(ctx, exceptions.WrapErrorWithTrackableException(touchError))
+			return
+		}
+
+		ctx.Set(
+			ServiceAccountKey,
+			&request_env.ServiceAccountPrincipal{
+				Account:  &row.ServiceAccount,
+				ApiKeyID: row.ApiKey.ID,
+				Scopes:   row.ApiKey.Scopes,
+			},
+		)
+		ctx.Next()
+	}
+}
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"chat_app_backend/internal/authentication"
+	"chat_app_backend/internal/request_env"
+
+	"github.com/gin-gonic/gin"
+)
+
+const ClaimsKey = "Claims"
+const ImpersonatorKey = "Impersonator"
+
+// AuthenticationMiddleware rejects the requests of the unauthenticated users and stores the authenticated
+// user in the request environment, so it has to run after the environment is created.
+func AuthenticationMiddleware(authenticator authentication.IAuthenticator) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		identity, authenticationError := authenticator.Authenticate(ctx)
+		if authenticationError != nil {
+			_ = ctx.Error(authenticationError)
+			ctx.Abort()
+			return
+		}
+
+		env := request_env.FromContext(ctx)
+		env.User = identity.User
+		env.Impersonator = identity.Impersonator
+
+		ctx.Set(ClaimsKey, identity.User)
+		if identity.Impersonator != nil {
+			ctx.Set(ImpersonatorKey, identity.Impersonator)
+		}
+
+		ctx.Next()
+	}
+}
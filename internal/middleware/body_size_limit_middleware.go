@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BodySizeLimitMiddleware rejects the requests declaring a body larger than the limit and stops reading
+// the others at the limit, so the binding fails instead of buffering the whole body.
+func BodySizeLimitMiddleware(limit int64) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if ctx.Request.ContentLength > limit {
+			_ = ctx.Error(
+				common_exceptions.InvalidBodyException{
+					BaseRestException: exceptions.BaseRestException{
+						ITrackableException: exceptions.CreateTrackableExceptionFromStringF(
+							"request body of %d bytes exceeds the limit of %d bytes",
+							ctx.Request.ContentLength,
+							limit,
+						),
+						Message: "request body is too large",
+					},
+				},
+			)
+			ctx.Abort()
+			return
+		}
+
+		ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, limit)
+		ctx.Next()
+	}
+}
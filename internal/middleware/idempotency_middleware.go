@@ -0,0 +1,275 @@
+package middleware
+
+import (
+	"bytes"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/idempotency"
+	"chat_app_backend/internal/request_env"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const IdempotencyKeyHeader = "Idempotency-Key"
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// the larger responses are not stored, so their duplicates are handled again
+const maxIdempotentResponseSize = 1 << 20
+
+var idempotencyKeyRegexp = regexp.MustCompile(`^[\x21-\x7E]{1,255}$`)
+
+// idempotencyRecorder keeps a copy of the response, so it can be stored for the duplicates.
+type idempotencyRecorder struct {
+	gin.ResponseWriter
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (r *idempotencyRecorder) Write(data []byte) (int, error) {
+	r.record(data)
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *idempotencyRecorder) WriteString(data string) (int, error) {
+	r.record([]byte(data))
+	return r.ResponseWriter.WriteString(data)
+}
+
+func (r *idempotencyRecorder) record(data []byte) {
+	if r.overflow || r.body.Len()+len(data) > maxIdempotentResponseSize {
+		r.overflow = true
+		return
+	}
+
+	r.body.Write(data)
+}
+
+// IdempotencyMiddleware handles the requests with the Idempotency-Key header once per user, route and
+// key. The duplicates of a completed request get its response replayed, the duplicates of a request in
+// flight are rejected with 409 and the reuse of the key for another request with 422. The failed
+// requests are not stored, so they can be retried. The anonymous requests are handled as usual, as
+// there is nobody to scope the key to.
+func IdempotencyMiddleware(store idempotency.IStore) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key := ctx.GetHeader(IdempotencyKeyHeader)
+		principal := idempotencyPrincipal(request_env.FromContext(ctx))
+
+		if key == "" || principal == "" {
+			ctx.Next()
+			return
+		}
+
+		if !idempotencyKeyRegexp.MatchString(key) {
+			abortWithError(
+				ctx,
+				common_exceptions.InvalidBodyException{
+					BaseRestException: exceptions.BaseRestException{
+						ITrackableException: exceptions.CreateTrackableExceptionFromStringF("invalid idempotency key %q", key),
+						Message:             "idempotency key has to be up to 255 printable ascii characters",
+					},
+				},
+			)
+			return
+		}
+
+		fingerprint, fingerprintError := fingerprintRequest(ctx)
+		if fingerprintError != nil {
+			abortWithError(
+				ctx,
+				common_exceptions.InvalidBodyException{
+					BaseRestException: exceptions.BaseRestException{
+						ITrackableException: exceptions.WrapErrorWithTrackableException(fingerprintError),
+						Message:             "can't read request body",
+					},
+				},
+			)
+			return
+		}
+
+		storeKey := principal + ":" + ctx.Request.Method + " " + ctx.FullPath() + ":" + key
+
+		reserved, reservationError := store.Reserve(ctx, storeKey, fingerprint)
+		if reservationError != nil {
+			abortWithError(ctx, exceptions.WrapErrorWithTrackableException(reservationError))
+			return
+		}
+
+		if !reserved {
+			replayResponse(ctx, store, storeKey, fingerprint)
+			return
+		}
+
+		recorder := &idempotencyRecorder{ResponseWriter: ctx.Writer}
+		ctx.Writer = recorder
+		ctx.Next()
+		ctx.Writer = recorder.ResponseWriter
+
+		// the response is stored even if the client is gone, so its retry gets the response it missed
+		storeCtx := context.WithoutCancel(ctx.Request.Context())
+
+		// the errors are written by the error handling middleware after this one, so they aren't recorded
+		if len(ctx.Errors) > 0 || recorder.Status() >= http.StatusInternalServerError || recorder.overflow {
+			// the record expires anyway, so the failure only delays the retries
+			_ = store.Release(storeCtx, storeKey)
+			return
+		}
+
+		header := recorder.Header().Clone()
+		header.Del(RequestIdHeader)
+		header.Del("Content-Length")
+
+		_ = store.Complete(
+			storeCtx,
+			storeKey,
+			&idempotency.Record{
+				Fingerprint: fingerprint,
+				Completed:   true,
+				Status:      recorder.Status(),
+				Header:      header,
+				Body:        recorder.body.Bytes(),
+			},
+		)
+	}
+}
+
+func replayResponse(ctx *gin.Context, store idempotency.IStore, storeKey string, fingerprint string) {
+	record, readingError := store.Get(ctx, storeKey)
+	switch {
+	case readingError != nil:
+		abortWithError(ctx, exceptions.WrapErrorWithTrackableException(readingError))
+	case record != nil && record.Fingerprint != fingerprint:
+		abortWithError(
+			ctx,
+			common_exceptions.UnprocessableEntityException{
+				BaseRestException: exceptions.BaseRestException{
+					ITrackableException: exceptions.CreateTrackableExceptionFromStringF("idempotency key %s reused", storeKey),
+					Message:             "idempotency key was already used for another request",
+				},
+			},
+		)
+	// the record is missing when the request which reserved it has just failed, the client can retry then
+	case record == nil || !record.Completed:
+		abortWithError(
+			ctx,
+			common_exceptions.ConflictException{
+				BaseRestException: exceptions.BaseRestException{
+					ITrackableException: exceptions.CreateTrackableExceptionFromStringF("request with idempotency key %s is in flight", storeKey),
+					Message:             "request with this idempotency key is still in progress",
+				},
+			},
+		)
+	default:
+		for name, values := range record.Header {
+			ctx.Writer.Header()[name] = values
+		}
+		ctx.Header(IdempotentReplayedHeader, "true")
+		ctx.Status(record.Status)
+		_, _ = ctx.Writer.Write(record.Body)
+		ctx.Abort()
+	}
+}
+
+// idempotencyPrincipal returns whom the keys are scoped to, it is empty for the anonymous requests.
+func idempotencyPrincipal(env *request_env.RequestEnv) string {
+	switch {
+	case env == nil:
+		return ""
+	case env.User != nil:
+		return "user:" + env.User.ID.String()
+	case env.ServiceAccount != nil:
+		return "service_account:" + env.ServiceAccount.Account.ID.String()
+	default:
+		return ""
+	}
+}
+
+// fingerprintRequest hashes the path, the query, the media type and the body, the body is restored for
+// the binding. The multipart bodies are hashed by their fields and files, as the boundary differs between
+// the retries of the same upload.
+func fingerprintRequest(ctx *gin.Context) (string, error) {
+	mediaType, params, _ := mime.ParseMediaType(ctx.GetHeader("Content-Type"))
+
+	hash := sha256.New()
+	hash.Write([]byte(ctx.Request.Method + " " + ctx.Request.URL.RequestURI() + "\n"))
+	hash.Write([]byte(mediaType + "\n"))
+
+	if ctx.Request.Body == nil {
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+
+	body, readingError := io.ReadAll(ctx.Request.Body)
+	if readingError != nil {
+		return "", readingError
+	}
+
+	ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	if strings.HasPrefix(mediaType, "multipart/") && params["boundary"] != "" {
+		if hashingError := hashMultipart(hash, body, params["boundary"]); hashingError != nil {
+			return "", hashingError
+		}
+	} else {
+		hash.Write(body)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// hashMultipart hashes the parts sorted by their names, with the file names and the contents, so the
+// order of the parts doesn't matter either.
+func hashMultipart(hash io.Writer, body []byte, boundary string) error {
+	type part struct {
+		name     string
+		fileName string
+		digest   []byte
+	}
+
+	parts := make([]part, 0)
+	reader := multipart.NewReader(bytes.NewReader(body), boundary)
+
+	for {
+		nextPart, partError := reader.NextPart()
+		if errors.Is(partError, io.EOF) {
+			break
+		}
+		if partError != nil {
+			return partError
+		}
+
+		partHash := sha256.New()
+		if _, copyingError := io.Copy(partHash, nextPart); copyingError != nil {
+			return copyingError
+		}
+
+		parts = append(parts, part{name: nextPart.FormName(), fileName: nextPart.FileName(), digest: partHash.Sum(nil)})
+	}
+
+	slices.SortStableFunc(parts, func(first part, second part) int {
+		return strings.Compare(first.name, second.name)
+	})
+
+	for _, sortedPart := range parts {
+		hash.Write([]byte(strconv.Quote(sortedPart.name) + " " + strconv.Quote(sortedPart.fileName) + " "))
+		hash.Write(sortedPart.digest)
+		hash.Write([]byte("\n"))
+	}
+
+	return nil
+}
+
+func abortWithError(ctx *gin.Context, err exceptions.ITrackableException) {
+	_ = ctx.Error(err)
+	ctx.Abort()
+}
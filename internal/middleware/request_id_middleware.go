@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const RequestIdKey = "RequestId"
+const RequestIdHeader = "X-Request-ID"
+
+var requestIdRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]{1,128}$`)
+
+// RequestIdMiddleware reuses the request id sent by the client or a proxy, when it is safe to log,
+// and generates a new one otherwise. The id is returned in the response, so the client can report it.
+func RequestIdMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestId := ctx.GetHeader(RequestIdHeader)
+		if !requestIdRegexp.MatchString(requestId) {
+			requestId = uuid.NewString()
+		}
+
+		ctx.Set(RequestIdKey, requestId)
+		ctx.Header(RequestIdHeader, requestId)
+		ctx.Next()
+	}
+}
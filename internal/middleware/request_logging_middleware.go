@@ -3,6 +3,7 @@ package middleware
 import (
 	"bytes"
 	"chat_app_backend/internal/logger"
+	"chat_app_backend/internal/sqlc/db_queries"
 	"github.com/gin-gonic/gin"
 	"io"
 	"time"
@@ -24,10 +25,12 @@ func RequestLoggingMiddleware(logger logger.ILogger) gin.HandlerFunc {
 		logger.
 			CreateInfoMessageF(
 				`REQUEST [%s] %s
+RequestId: %s
 Headers: %v
 RequestBody: %v`,
 				incomingRequest.Method,
 				incomingRequest.URL,
+				ctx.GetString(RequestIdKey),
 				incomingRequest.Header,
 				body,
 			).Log()
@@ -36,13 +39,23 @@ RequestBody: %v`,
 		ctx.Next()
 		duration := time.Since(start)
 
+		// the impersonator is known only after the authorization, which runs later in the chain
+		impersonatedBy := "NONE"
+		if impersonatorAny, impersonated := ctx.Get(ImpersonatorKey); impersonated {
+			impersonatedBy = impersonatorAny.(*db_queries.User).ID.String()
+		}
+
 		logger.
 			CreateInfoMessageF(
 				`RESPONSE [%s] %s 
+RequestId: %s
+ImpersonatedBy: %s
 Status: %d
 Time taken: %d ms`,
 				incomingRequest.Method,
 				incomingRequest.URL,
+				ctx.GetString(RequestIdKey),
+				impersonatedBy,
 				ctx.Writer.Status(),
 				duration.Milliseconds(),
 			).Log()
@@ -0,0 +1,37 @@
+package moderation
+
+import (
+	"chat_app_backend/internal/sqlc/db_queries"
+	"fmt"
+	"time"
+)
+
+// IsSuspended tells if the suspension of the user is still active, suspensions end on their own
+// once the expiry passes.
+func IsSuspended(user *db_queries.User, now time.Time) bool {
+	return user.SuspendedUntil != nil && user.SuspendedUntil.After(now)
+}
+
+// SuspensionMessage is shown to the suspended user, so it contains the expiry and the reason.
+func SuspensionMessage(user *db_queries.User) string {
+	if user.SuspendedUntil == nil {
+		return "account is not suspended"
+	}
+
+	message := fmt.Sprintf("account is suspended until %s", user.SuspendedUntil.UTC().Format(time.RFC3339))
+	if user.SuspensionReason != nil && *user.SuspensionReason != "" {
+		message = fmt.Sprintf("%s: %s", message, *user.SuspensionReason)
+	}
+
+	return message
+}
+
+// IsKnownRole is used to validate roles received from admins.
+func IsKnownRole(role db_queries.RoleType) bool {
+	switch role {
+	case db_queries.RoleTypeUSER, db_queries.RoleTypeADMIN:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,194 @@
+package openapi
+
+import (
+	validator "chat_app_backend/internal/validator/utils"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	jsonTag = "json"
+	formTag = "form"
+	uriTag  = "uri"
+)
+
+const defaultOption = "default="
+
+type field struct {
+	reflect.StructField
+	Name      string
+	OmitEmpty bool
+	Default   *string
+}
+
+// collectFields lists the fields bound with the tag the same way the encoders do: the fields of the
+// embedded structs are promoted and the untagged fields use the field name when useFieldName is set.
+func collectFields(t reflect.Type, tag string, useFieldName bool) []field {
+	fields := make([]field, 0)
+
+	for index := 0; index < t.NumField(); index++ {
+		structField := t.Field(index)
+		tagValue, tagged := structField.Tag.Lookup(tag)
+
+		if structField.Anonymous && !tagged {
+			embeddedType := structField.Type
+			if embeddedType.Kind() == reflect.Pointer {
+				embeddedType = embeddedType.Elem()
+			}
+
+			if embeddedType.Kind() == reflect.Struct {
+				fields = append(fields, collectFields(embeddedType, tag, useFieldName)...)
+				continue
+			}
+		}
+
+		if !structField.IsExported() || tagValue == "-" || (!tagged && !useFieldName) {
+			continue
+		}
+
+		options := strings.Split(tagValue, ",")
+		collected := field{StructField: structField, Name: options[0]}
+		if collected.Name == "" {
+			collected.Name = structField.Name
+		}
+
+		for _, option := range options[1:] {
+			switch {
+			case option == "omitempty":
+				collected.OmitEmpty = true
+			case strings.HasPrefix(option, defaultOption):
+				defaultValue := strings.TrimPrefix(option, defaultOption)
+				collected.Default = &defaultValue
+			}
+		}
+
+		fields = append(fields, collected)
+	}
+
+	return fields
+}
+
+// applyValidations converts the validator tag of the field into the schema keywords and reports
+// whether the field is required.
+func applyValidations(schema *Schema, structField reflect.StructField) bool {
+	tag, parseError := validator.ParseTag(structField)
+	if parseError != nil || tag == nil {
+		return false
+	}
+
+	fieldType := structField.Type
+	isPointer := fieldType.Kind() == reflect.Pointer
+	if isPointer {
+		fieldType = fieldType.Elem()
+	}
+
+	required := false
+	for _, validation := range tag.Validations {
+		switch validation.ValidationType {
+		case validator.NotEmpty:
+			required = true
+			applyLength(schema, fieldType, validator.GreaterOrEqual, 1)
+		case validator.Greater, validator.GreaterOrEqual, validator.Less, validator.LessOrEqual:
+			if !isNumber(fieldType) {
+				continue
+			}
+			applyComparison(schema, validation.ValidationType, validation.Arguments[0].Interface())
+		case validator.Equal:
+			schema.Const = validation.Arguments[0].Interface()
+		case validator.OneOf:
+			for _, argument := range validation.Arguments {
+				schema.Enum = append(schema.Enum, argument.Interface())
+			}
+			if isPointer {
+				schema.Enum = append(schema.Enum, nil)
+			}
+		case validator.Length:
+			applyLength(
+				schema,
+				fieldType,
+				validator.ValidationTypes(validation.Arguments[0].String()),
+				validation.Arguments[1].Interface().(int),
+			)
+		}
+	}
+
+	return required
+}
+
+func applyComparison(schema *Schema, comparison validator.ValidationTypes, value interface{}) {
+	switch comparison {
+	case validator.Greater:
+		schema.ExclusiveMinimum = value
+	case validator.GreaterOrEqual:
+		schema.Minimum = value
+	case validator.Less:
+		schema.ExclusiveMaximum = value
+	case validator.LessOrEqual:
+		schema.Maximum = value
+	}
+}
+
+func applyLength(schema *Schema, t reflect.Type, comparison validator.ValidationTypes, length int) {
+	var minimum, maximum **int
+
+	switch t.Kind() {
+	case reflect.String:
+		minimum, maximum = &schema.MinLength, &schema.MaxLength
+	case reflect.Slice, reflect.Array, reflect.Map:
+		minimum, maximum = &schema.MinItems, &schema.MaxItems
+	default:
+		return
+	}
+
+	switch comparison {
+	case validator.Greater:
+		*minimum = intPointer(length + 1)
+	case validator.GreaterOrEqual:
+		*minimum = intPointer(length)
+	case validator.Less:
+		*maximum = intPointer(length - 1)
+	case validator.LessOrEqual:
+		*maximum = intPointer(length)
+	case validator.Equal:
+		*minimum, *maximum = intPointer(length), intPointer(length)
+	}
+}
+
+// parseDefault converts the default value of the form binding into the type of the field.
+func parseDefault(value string, t reflect.Type) interface{} {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t.Kind() == reflect.Bool:
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+	case isNumber(t):
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			if t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64 {
+				return parsed
+			}
+			return int64(parsed)
+		}
+	}
+
+	return value
+}
+
+func isNumber(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func intPointer(value int) *int {
+	return &value
+}
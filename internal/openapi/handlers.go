@@ -0,0 +1,68 @@
+package openapi
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"html/template"
+	"io/fs"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//go:embed swagger_ui.html
+var swaggerUiPage string
+
+var swaggerUiTemplate = template.Must(template.New("swagger_ui").Parse(swaggerUiPage))
+
+// swaggerUiAssets are the files of swagger-ui-dist 5.18.2, they are served by the api itself, so the page
+// doesn't depend on a third party cdn.
+//
+//go:embed swagger_ui/*.js swagger_ui/*.css
+var swaggerUiAssets embed.FS
+
+// DocumentHandler serves the document. It is serialized once, as the routes do not change after the start.
+func DocumentHandler(document *Document) (gin.HandlerFunc, error) {
+	serializedDocument, serializationError := json.Marshal(document)
+	if serializationError != nil {
+		return nil, serializationError
+	}
+
+	return func(ctx *gin.Context) {
+		ctx.Data(http.StatusOK, "application/json; charset=utf-8", serializedDocument)
+	}, nil
+}
+
+// SwaggerUiHandler serves the Swagger UI page which loads the document from specificationUrl and the
+// assets served by SwaggerUiAssets from assetsUrl.
+func SwaggerUiHandler(specificationUrl string, assetsUrl string) (gin.HandlerFunc, error) {
+	var page bytes.Buffer
+	renderError := swaggerUiTemplate.Execute(
+		&page,
+		struct {
+			SpecificationUrl string
+			AssetsUrl        string
+		}{
+			SpecificationUrl: specificationUrl,
+			AssetsUrl:        assetsUrl,
+		},
+	)
+	if renderError != nil {
+		return nil, renderError
+	}
+
+	return func(ctx *gin.Context) {
+		ctx.Data(http.StatusOK, "text/html; charset=utf-8", page.Bytes())
+	}, nil
+}
+
+// SwaggerUiAssets returns the scripts and the styles of the Swagger UI page.
+func SwaggerUiAssets() (http.FileSystem, error) {
+	assets, subError := fs.Sub(swaggerUiAssets, "swagger_ui")
+	if subError != nil {
+		return nil, subError
+	}
+
+	return http.FS(assets), nil
+}
@@ -0,0 +1,216 @@
+package openapi
+
+import (
+	"chat_app_backend/internal/exceptions"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	jsonContentType      = "application/json"
+	multipartContentType = "multipart/form-data"
+)
+
+var pathParameterRegexp = regexp.MustCompile(`[:*]([^/]+)`)
+
+var fileHeaderType = reflect.TypeFor[multipart.FileHeader]()
+
+// ContentFormat is how the successful response is written in a media type.
+type ContentFormat int
+
+const (
+	// StructuredContent is the response dto encoded in the media type, e.g. json
+	StructuredContent ContentFormat = iota
+	TextContent
+	BinaryContent
+)
+
+// Route is the metadata of a registered route. Path uses the gin syntax for the path parameters.
+// ResponseContent maps the media types of the successful response to their format, the response is
+// json when it is empty and has no body when Response is nil.
+type Route struct {
+	Method          string
+	Path            string
+	Tag             string
+	Status          int
+	Request         reflect.Type
+	Response        reflect.Type
+	ResponseContent map[string]ContentFormat
+	Security        []SecurityRequirement
+}
+
+// AddRoute describes the route with the bindings of its request dto: the uri fields are the path
+// parameters, the form fields are the query parameters or the multipart body when the dto accepts
+// files and the rest of the fields is the json body. Gin binds GET requests only from the query, so
+// there every field is a query parameter.
+func (document *Document) AddRoute(route Route) {
+	operation := &Operation{
+		OperationID: document.operationId(route),
+		Parameters:  make([]Parameter, 0),
+		Responses: map[string]Response{
+			strconv.Itoa(route.Status): {
+				Description: http.StatusText(route.Status),
+				Content:     document.responseContent(route),
+			},
+			"default": {
+				Description: "Error",
+				Content: map[string]MediaType{
+					jsonContentType: {Schema: document.schemaFor(reflect.TypeFor[exceptions.Response]())},
+				},
+			},
+		},
+		Security: route.Security,
+	}
+
+	if route.Tag != "" {
+		operation.Tags = []string{route.Tag}
+	}
+
+	for _, field := range collectFields(route.Request, uriTag, false) {
+		schema := document.schemaFor(field.Type)
+		applyValidations(schema, field.StructField)
+
+		operation.Parameters = append(
+			operation.Parameters,
+			Parameter{Name: field.Name, In: "path", Required: true, Schema: schema},
+		)
+	}
+
+	formFields := collectFields(route.Request, formTag, route.Method == http.MethodGet)
+	if route.Method == http.MethodGet {
+		formFields = excludeFields(formFields, uriTag)
+	}
+
+	if hasFiles(formFields) {
+		operation.RequestBody = document.requestBody(multipartContentType, formFields)
+	} else {
+		for _, field := range formFields {
+			schema := document.schemaFor(field.Type)
+			required := applyValidations(schema, field.StructField)
+			if field.Default != nil {
+				schema.Default = parseDefault(*field.Default, field.Type)
+			}
+
+			operation.Parameters = append(
+				operation.Parameters,
+				Parameter{Name: field.Name, In: "query", Required: required, Schema: schema},
+			)
+		}
+	}
+
+	if route.Method != http.MethodGet && operation.RequestBody == nil {
+		jsonFields := excludeFields(collectFields(route.Request, jsonTag, true), uriTag, formTag)
+		if len(jsonFields) > 0 {
+			operation.RequestBody = document.requestBody(jsonContentType, jsonFields)
+		}
+	}
+
+	path := pathParameterRegexp.ReplaceAllString(route.Path, "{$1}")
+	if _, exists := document.Paths[path]; !exists {
+		document.Paths[path] = map[string]*Operation{}
+	}
+	document.Paths[path][strings.ToLower(route.Method)] = operation
+}
+
+func (document *Document) responseContent(route Route) map[string]MediaType {
+	if route.Response == nil {
+		return nil
+	}
+
+	contentFormats := route.ResponseContent
+	if len(contentFormats) == 0 {
+		contentFormats = map[string]ContentFormat{jsonContentType: StructuredContent}
+	}
+
+	content := make(map[string]MediaType, len(contentFormats))
+	for contentType, format := range contentFormats {
+		switch format {
+		case TextContent:
+			content[contentType] = MediaType{Schema: &Schema{Type: "string"}}
+		case BinaryContent:
+			content[contentType] = MediaType{Schema: &Schema{Type: "string", Format: "binary"}}
+		default:
+			content[contentType] = MediaType{Schema: document.schemaFor(route.Response)}
+		}
+	}
+
+	return content
+}
+
+func (document *Document) requestBody(contentType string, fields []field) *RequestBody {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+
+	for _, field := range fields {
+		fieldSchema := document.schemaFor(field.Type)
+		if applyValidations(fieldSchema, field.StructField) {
+			schema.Required = append(schema.Required, field.Name)
+		}
+
+		schema.Properties[field.Name] = fieldSchema
+	}
+
+	return &RequestBody{
+		Required: len(schema.Required) > 0,
+		Content:  map[string]MediaType{contentType: {Schema: schema}},
+	}
+}
+
+// operationId names the operation after its request dto, so the generated clients get readable methods.
+func (document *Document) operationId(route Route) string {
+	name := strings.TrimSuffix(strings.TrimSuffix(route.Request.Name(), "Dto"), "Request")
+	if name == "" {
+		name = strings.ToLower(route.Method) + invalidComponentCharacters.ReplaceAllString(route.Path, "_")
+	}
+
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	name = string(runes)
+
+	uniqueName := name
+	for index := 2; ; index++ {
+		if _, taken := document.operationIds[uniqueName]; !taken {
+			document.operationIds[uniqueName] = struct{}{}
+			return uniqueName
+		}
+		uniqueName = name + strconv.Itoa(index)
+	}
+}
+
+func excludeFields(fields []field, tags ...string) []field {
+	filtered := make([]field, 0, len(fields))
+
+	for _, field := range fields {
+		excluded := false
+		for _, tag := range tags {
+			if _, tagged := field.Tag.Lookup(tag); tagged {
+				excluded = true
+			}
+		}
+
+		if !excluded {
+			filtered = append(filtered, field)
+		}
+	}
+
+	return filtered
+}
+
+func hasFiles(fields []field) bool {
+	for _, field := range fields {
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer || fieldType.Kind() == reflect.Slice {
+			fieldType = fieldType.Elem()
+		}
+
+		if fieldType == fileHeaderType {
+			return true
+		}
+	}
+
+	return false
+}
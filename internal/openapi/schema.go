@@ -0,0 +1,164 @@
+package openapi
+
+import (
+	"chat_app_backend/internal/extensions"
+	"encoding/json"
+	"mime/multipart"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const componentsPrefix = "#/components/schemas/"
+
+var invalidComponentCharacters = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 interface{}        `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Const                interface{}        `json:"const,omitempty"`
+	Default              interface{}        `json:"default,omitempty"`
+	Minimum              interface{}        `json:"minimum,omitempty"`
+	ExclusiveMinimum     interface{}        `json:"exclusiveMinimum,omitempty"`
+	Maximum              interface{}        `json:"maximum,omitempty"`
+	ExclusiveMaximum     interface{}        `json:"exclusiveMaximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+}
+
+// knownSchemas describes the types which are serialized differently from their go structure.
+var knownSchemas = map[reflect.Type]func() *Schema{
+	reflect.TypeFor[time.Time](): func() *Schema {
+		return &Schema{Type: "string", Format: "date-time"}
+	},
+	reflect.TypeFor[extensions.UUID](): func() *Schema {
+		return &Schema{Type: "string", Format: "uuid"}
+	},
+	reflect.TypeFor[multipart.FileHeader](): func() *Schema {
+		return &Schema{Type: "string", Format: "binary"}
+	},
+	reflect.TypeFor[json.RawMessage](): func() *Schema {
+		return &Schema{}
+	},
+}
+
+var jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+
+func (document *Document) schemaFor(t reflect.Type) *Schema {
+	if t.Kind() == reflect.Pointer {
+		return nullable(document.schemaFor(t.Elem()))
+	}
+
+	if createSchema, known := knownSchemas[t]; known {
+		return createSchema()
+	}
+
+	// the structure of the types with custom serialization is unknown
+	if t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) {
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Uint, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer"}
+	case reflect.Int32, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", ContentEncoding: "base64"}
+		}
+		return &Schema{Type: "array", Items: document.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: document.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return document.objectSchema(t)
+		}
+		return document.componentRef(t)
+	default:
+		return &Schema{}
+	}
+}
+
+// componentRef stores the named struct in the components once and returns the reference to it.
+func (document *Document) componentRef(t reflect.Type) *Schema {
+	name, exists := document.schemaNames[t]
+	if !exists {
+		name = document.componentName(t)
+		document.schemaNames[t] = name
+
+		// the placeholder stops the recursion for the self referencing types
+		document.Components.Schemas[name] = &Schema{}
+		document.Components.Schemas[name] = document.objectSchema(t)
+	}
+
+	return &Schema{Ref: componentsPrefix + name}
+}
+
+func (document *Document) componentName(t reflect.Type) string {
+	packagePath := strings.Split(t.PkgPath(), "/")
+	name := invalidComponentCharacters.ReplaceAllString(packagePath[len(packagePath)-1]+"."+t.Name(), "_")
+
+	uniqueName := name
+	for index := 2; ; index++ {
+		if _, taken := document.Components.Schemas[uniqueName]; !taken {
+			return uniqueName
+		}
+		uniqueName = name + "_" + strconv.Itoa(index)
+	}
+}
+
+func (document *Document) objectSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+
+	for _, field := range collectFields(t, jsonTag, true) {
+		fieldSchema := document.schemaFor(field.Type)
+		required := applyValidations(fieldSchema, field.StructField)
+
+		schema.Properties[field.Name] = fieldSchema
+		if required || !field.OmitEmpty {
+			schema.Required = append(schema.Required, field.Name)
+		}
+	}
+
+	return schema
+}
+
+func nullable(schema *Schema) *Schema {
+	switch schemaType := schema.Type.(type) {
+	case string:
+		schema.Type = []string{schemaType, "null"}
+		return schema
+	case []string:
+		return schema
+	}
+
+	if schema.Ref == "" && schema.AnyOf == nil {
+		// an empty schema already accepts null
+		return schema
+	}
+
+	return &Schema{AnyOf: []*Schema{schema, {Type: "null"}}}
+}
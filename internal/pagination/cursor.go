@@ -0,0 +1,50 @@
+package pagination
+
+import (
+	"chat_app_backend/internal/extensions"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// Cursor points to the last item of a page. The sort it was created for is kept inside, so a cursor
+// can't be reused with another sort, where it would point to a different place.
+// Secondary is the value of the second sort key, for the sorts which have one.
+type Cursor struct {
+	SortBy     string          `json:"s"`
+	Descending bool            `json:"d"`
+	Value      string          `json:"v"`
+	Secondary  string          `json:"v2,omitempty"`
+	ID         extensions.UUID `json:"id"`
+}
+
+var ErrCursorSortMismatch = errors.New("cursor was created for another sort")
+
+// Encode returns an opaque url safe representation of the cursor.
+func (c Cursor) Encode() (string, error) {
+	serialized, serializationError := json.Marshal(c)
+	if serializationError != nil {
+		return "", serializationError
+	}
+
+	return base64.RawURLEncoding.EncodeToString(serialized), nil
+}
+
+// DecodeCursor parses the cursor and checks it was created for the same sort.
+func DecodeCursor(encoded string, sortBy string, descending bool) (*Cursor, error) {
+	serialized, decodingError := base64.RawURLEncoding.DecodeString(encoded)
+	if decodingError != nil {
+		return nil, decodingError
+	}
+
+	var cursor Cursor
+	if deserializationError := json.Unmarshal(serialized, &cursor); deserializationError != nil {
+		return nil, deserializationError
+	}
+
+	if cursor.SortBy != sortBy || cursor.Descending != descending {
+		return nil, ErrCursorSortMismatch
+	}
+
+	return &cursor, nil
+}
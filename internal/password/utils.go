@@ -4,23 +4,19 @@ import (
 	"crypto/rand"
 	"crypto/subtle"
 	"encoding/base64"
+	"errors"
 	"fmt"
-	"golang.org/x/crypto/argon2"
 	"strings"
+
+	"golang.org/x/crypto/argon2"
 )
 
 const KBytesInMBytes = 1024
 const Separator = "$"
+const Algorithm = "argon2id"
 
-type HashPasswordConfig struct {
-	SaltSize   uint32
-	Iterations uint32
-	Memory     uint32
-	Threads    uint8
-	KeyLength  uint32
-}
-
-var options = HashPasswordConfig{
+// legacyOptions are the parameters every hash in the old "salt$hash" format was created with.
+var legacyOptions = HashPasswordConfig{
 	SaltSize:   16,
 	Iterations: 3,
 	Memory:     64 * KBytesInMBytes,
@@ -28,6 +24,23 @@ var options = HashPasswordConfig{
 	KeyLength:  32,
 }
 
+type IHasher interface {
+	HashPassword(password string) []byte
+	ComparePassword(rawPassword string, encodedPassword []byte) (bool, error)
+	NeedsRehash(encodedPassword []byte) bool
+}
+
+type Hasher struct {
+	cfg *HashPasswordConfig
+}
+
+type encodedHash struct {
+	options HashPasswordConfig
+	salt    []byte
+	hash    []byte
+	legacy  bool
+}
+
 func generateRandomSalt(saltSize uint32) []byte {
 	bytes := make([]byte, saltSize)
 	_, _ = rand.Read(bytes)
@@ -46,37 +59,132 @@ func hashWithSalt(password string, salt []byte, opts *HashPasswordConfig) []byte
 	return hashedPassword
 }
 
-func hashPassword(password string, opts *HashPasswordConfig) (salt []byte, hashedPassword []byte) {
-	salt = generateRandomSalt(opts.SaltSize)
-	hashedPassword = hashWithSalt(password, salt, opts)
-	return salt, hashedPassword
-}
+func (h *Hasher) HashPassword(password string) []byte {
+	salt := generateRandomSalt(h.cfg.SaltSize)
+	hashedPassword := hashWithSalt(password, salt, h.cfg)
 
-func HashPassword(password string) []byte {
-	salt, hashedPassword := hashPassword(password, &options)
 	return []byte(
 		fmt.Sprintf(
-			"%s%s%s",
+			"$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+			Algorithm,
+			argon2.Version,
+			h.cfg.Memory,
+			h.cfg.Iterations,
+			h.cfg.Threads,
 			base64.RawStdEncoding.EncodeToString(salt),
-			Separator,
 			base64.RawStdEncoding.EncodeToString(hashedPassword),
 		),
 	)
 }
 
-func ComparePassword(rawPassword string, encodedPassword []byte) bool {
-	salt, storedHashed := decodePasswordAndSalt(encodedPassword)
-	rawHashed := hashWithSalt(rawPassword, salt, &options)
+func (h *Hasher) ComparePassword(rawPassword string, encodedPassword []byte) (bool, error) {
+	decoded, decodingError := decodePasswordAndSalt(encodedPassword)
+	if decodingError != nil {
+		return false, decodingError
+	}
+
+	rawHashed := hashWithSalt(rawPassword, decoded.salt, &decoded.options)
+
+	return subtle.ConstantTimeCompare(rawHashed, decoded.hash) == 1, nil
+}
+
+func (h *Hasher) NeedsRehash(encodedPassword []byte) bool {
+	decoded, decodingError := decodePasswordAndSalt(encodedPassword)
+	if decodingError != nil {
+		return true
+	}
+
+	return decoded.legacy ||
+		decoded.options.Iterations != h.cfg.Iterations ||
+		decoded.options.Memory != h.cfg.Memory ||
+		decoded.options.Threads != h.cfg.Threads ||
+		decoded.options.KeyLength != h.cfg.KeyLength ||
+		decoded.options.SaltSize != h.cfg.SaltSize
+}
+
+func decodePasswordAndSalt(encodedPassword []byte) (*encodedHash, error) {
+	split := strings.Split(string(encodedPassword), Separator)
+
+	switch len(split) {
+	case 2:
+		return decodeLegacyHash(split)
+	case 6:
+		return decodePhcHash(split)
+	default:
+		return nil, errors.New("password hash has unknown format")
+	}
+}
+
+func decodeLegacyHash(split []string) (*encodedHash, error) {
+	salt, saltDecodingError := base64.RawStdEncoding.DecodeString(split[0])
+	if saltDecodingError != nil {
+		return nil, fmt.Errorf("can't decode legacy password salt: %w", saltDecodingError)
+	}
+
+	hash, hashDecodingError := base64.RawStdEncoding.DecodeString(split[1])
+	if hashDecodingError != nil {
+		return nil, fmt.Errorf("can't decode legacy password hash: %w", hashDecodingError)
+	}
+
+	return &encodedHash{
+		options: legacyOptions,
+		salt:    salt,
+		hash:    hash,
+		legacy:  true,
+	}, nil
+}
+
+func decodePhcHash(split []string) (*encodedHash, error) {
+	if split[0] != "" || split[1] != Algorithm {
+		return nil, fmt.Errorf("unsupported password hash algorithm %s", split[1])
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(split[2], "v=%d", &version); err != nil {
+		return nil, fmt.Errorf("can't parse password hash version: %w", err)
+	}
+
+	if version != argon2.Version {
+		return nil, fmt.Errorf("unsupported argon2 version %d", version)
+	}
+
+	var options HashPasswordConfig
+	if _, err := fmt.Sscanf(split[3], "m=%d,t=%d,p=%d", &options.Memory, &options.Iterations, &options.Threads); err != nil {
+		return nil, fmt.Errorf("can't parse password hash parameters: %w", err)
+	}
+
+	if options.Memory == 0 || options.Iterations == 0 || options.Threads == 0 {
+		return nil, errors.New("password hash parameters should be positive")
+	}
+
+	salt, saltDecodingError := base64.RawStdEncoding.DecodeString(split[4])
+	if saltDecodingError != nil {
+		return nil, fmt.Errorf("can't decode password salt: %w", saltDecodingError)
+	}
+
+	hash, hashDecodingError := base64.RawStdEncoding.DecodeString(split[5])
+	if hashDecodingError != nil {
+		return nil, fmt.Errorf("can't decode password hash: %w", hashDecodingError)
+	}
+
+	if len(hash) == 0 {
+		return nil, errors.New("password hash is empty")
+	}
+
+	options.SaltSize = uint32(len(salt))
+	options.KeyLength = uint32(len(hash))
 
-	return subtle.ConstantTimeCompare(rawHashed, storedHashed) == 1
+	return &encodedHash{
+		options: options,
+		salt:    salt,
+		hash:    hash,
+	}, nil
 }
 
-func decodePasswordAndSalt(encodedPassword []byte) (salt []byte, password []byte) {
-	str := string(encodedPassword)
-	split := strings.Split(str, Separator)
-	b64Salt, b64Password := split[0], split[1]
-	salt, _ = base64.RawStdEncoding.DecodeString(b64Salt)
-	password, _ = base64.RawStdEncoding.DecodeString(b64Password)
+func CreateHasher(cfg *HashPasswordConfig) (*Hasher, error) {
+	if cfg.SaltSize == 0 || cfg.Iterations == 0 || cfg.Memory == 0 || cfg.Threads == 0 || cfg.KeyLength == 0 {
+		return nil, errors.New("password hashing parameters should be positive")
+	}
 
-	return salt, password
+	return &Hasher{cfg: cfg}, nil
 }
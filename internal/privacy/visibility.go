@@ -0,0 +1,41 @@
+package privacy
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+)
+
+// Permits tells if a user with the given relation to the owner passes the owner's visibility level.
+func Permits(level db_queries.VisibilityLevel, areContacts bool) bool {
+	switch level {
+	case db_queries.VisibilityLevelEVERYONE:
+		return true
+	case db_queries.VisibilityLevelCONTACTS:
+		return areContacts
+	default:
+		return false
+	}
+}
+
+// IsVisibleTo is Permits for reading the owner's data, the owner and admins can always see it.
+func IsVisibleTo(
+	level db_queries.VisibilityLevel,
+	viewer *db_queries.User,
+	ownerId extensions.UUID,
+	areContacts bool,
+) bool {
+	if viewer != nil && (viewer.ID == ownerId || viewer.Role == db_queries.RoleTypeADMIN) {
+		return true
+	}
+
+	return Permits(level, areContacts)
+}
+
+func IsKnownVisibilityLevel(level db_queries.VisibilityLevel) bool {
+	switch level {
+	case db_queries.VisibilityLevelEVERYONE, db_queries.VisibilityLevelCONTACTS, db_queries.VisibilityLevelNOBODY:
+		return true
+	default:
+		return false
+	}
+}
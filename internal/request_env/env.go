@@ -1,7 +1,61 @@
 package request_env
 
-import "chat_app_backend/internal/sqlc/db_queries"
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+	"slices"
+	"time"
+)
 
+// Key stores the environment in the keys of the gin context.
+const Key = "RequestEnv"
+
+type contextKey struct{}
+
+// ServiceAccountPrincipal is set instead of the user when the request is authenticated with an api key.
+type ServiceAccountPrincipal struct {
+	Account  *db_queries.ServiceAccount
+	ApiKeyID extensions.UUID
+	Scopes   []string
+}
+
+func (p *ServiceAccountPrincipal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+// RequestEnv holds the authenticated principal and the details of a single request, it is created for
+// every request and is never shared between them. During impersonation User is the impersonated user and
+// Impersonator is the admin acting as them. Locales are the locales accepted by the client, the most
+// preferred first, and Deadline is zero when the request has no deadline.
 type RequestEnv struct {
-	User *db_queries.User
+	User           *db_queries.User
+	Impersonator   *db_queries.User
+	ServiceAccount *ServiceAccountPrincipal
+	RequestId      string
+	Locales        []string
+	Deadline       time.Time
+}
+
+// NewContext returns a copy of ctx carrying the environment.
+func NewContext(ctx context.Context, env *RequestEnv) context.Context {
+	return context.WithValue(ctx, contextKey{}, env)
+}
+
+// FromContext returns the environment carried by ctx or nil. The gin context is supported as well, its
+// keys are checked when the value is not found.
+func FromContext(ctx context.Context) *RequestEnv {
+	if env, exists := ctx.Value(contextKey{}).(*RequestEnv); exists {
+		return env
+	}
+
+	if env, exists := ctx.Value(Key).(*RequestEnv); exists {
+		return env
+	}
+
+	return nil
+}
+
+func (env *RequestEnv) IsImpersonated() bool {
+	return env.Impersonator != nil
 }
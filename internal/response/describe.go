@@ -0,0 +1,33 @@
+package response
+
+import (
+	"chat_app_backend/internal/openapi"
+	"net/http"
+	"reflect"
+	"slices"
+)
+
+var fileResponseTypes = []reflect.Type{
+	reflect.TypeFor[Bytes](),
+	reflect.TypeFor[File](),
+	reflect.TypeFor[Stream](),
+}
+
+// Describe sets the content of the successful response from the type the handler of the route returns.
+func Describe(route *openapi.Route) {
+	switch {
+	case route.Response == reflect.TypeFor[Redirect]():
+		route.Status = http.StatusFound
+		route.Response = nil
+	case slices.Contains(fileResponseTypes, route.Response):
+		route.ResponseContent = map[string]openapi.ContentFormat{BinaryContentType: openapi.BinaryContent}
+	default:
+		route.ResponseContent = make(map[string]openapi.ContentFormat)
+		for _, contentType := range ContentTypes(route.Response) {
+			route.ResponseContent[contentType] = openapi.StructuredContent
+			if contentType == CsvContentType {
+				route.ResponseContent[contentType] = openapi.TextContent
+			}
+		}
+	}
+}
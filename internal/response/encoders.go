@@ -0,0 +1,238 @@
+package response
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/render"
+)
+
+const (
+	JsonContentType          = "application/json"
+	MsgPackContentType       = "application/msgpack"
+	legacyMsgPackContentType = "application/x-msgpack"
+	CsvContentType           = "text/csv"
+	BinaryContentType        = "application/octet-stream"
+)
+
+// rowsTag marks the slice field of a list response, which becomes the rows of the csv response.
+const rowsTag = "csv"
+
+type encoder func(ctx *gin.Context, status int, response interface{}) error
+
+// negotiate picks the encoder for the first accepted format. The csv is offered only for the list
+// responses, as the other responses have no rows.
+func negotiate(ctx *gin.Context, responseType reflect.Type) encoder {
+	offered := []string{JsonContentType, MsgPackContentType, legacyMsgPackContentType}
+	if _, isList := rowsField(responseType); isList {
+		offered = append(offered, CsvContentType)
+	}
+
+	switch ctx.NegotiateFormat(offered...) {
+	case JsonContentType:
+		return encodeJson
+	case MsgPackContentType, legacyMsgPackContentType:
+		return encodeMsgPack
+	case CsvContentType:
+		return encodeCsv
+	default:
+		return nil
+	}
+}
+
+// ContentTypes returns the formats the response of the type can be encoded in.
+func ContentTypes(responseType reflect.Type) []string {
+	contentTypes := []string{JsonContentType, MsgPackContentType}
+	if _, isList := rowsField(responseType); isList {
+		contentTypes = append(contentTypes, CsvContentType)
+	}
+
+	return contentTypes
+}
+
+func encodeJson(ctx *gin.Context, status int, response interface{}) error {
+	ctx.JSON(status, response)
+	return nil
+}
+
+// encodeMsgPack encodes the json representation of the response, so the field names and the values,
+// e.g. of the ids and the timestamps, are the same in both formats.
+func encodeMsgPack(ctx *gin.Context, status int, response interface{}) error {
+	tree, conversionError := jsonTree(response)
+	if conversionError != nil {
+		return conversionError
+	}
+
+	ctx.Render(status, render.MsgPack{Data: normalizeNumbers(tree)})
+	return nil
+}
+
+// encodeCsv writes a row per item of the list, the columns are the json fields of the item. The nested
+// objects and arrays are written as json.
+func encodeCsv(ctx *gin.Context, status int, response interface{}) error {
+	fieldIndex, _ := rowsField(reflect.TypeOf(response))
+	rows := reflect.Indirect(reflect.ValueOf(response)).Field(fieldIndex)
+	columns := columnNames(rows.Type().Elem())
+
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if writingError := writer.Write(columns); writingError != nil {
+		return writingError
+	}
+
+	for idx := 0; idx < rows.Len(); idx++ {
+		tree, conversionError := jsonTree(rows.Index(idx).Interface())
+		if conversionError != nil {
+			return conversionError
+		}
+
+		object, isObject := tree.(map[string]interface{})
+		if !isObject {
+			return fmt.Errorf("row %d is not an object", idx)
+		}
+
+		record := make([]string, len(columns))
+		for column, name := range columns {
+			cell, formattingError := formatCell(object[name])
+			if formattingError != nil {
+				return formattingError
+			}
+			record[column] = cell
+		}
+
+		if writingError := writer.Write(record); writingError != nil {
+			return writingError
+		}
+	}
+
+	writer.Flush()
+	if flushingError := writer.Error(); flushingError != nil {
+		return flushingError
+	}
+
+	ctx.Data(status, CsvContentType+"; charset=utf-8", buffer.Bytes())
+	return nil
+}
+
+// rowsField returns the index of the field tagged with csv:"rows", which has to be a slice of structs.
+func rowsField(responseType reflect.Type) (int, bool) {
+	for responseType != nil && responseType.Kind() == reflect.Pointer {
+		responseType = responseType.Elem()
+	}
+
+	if responseType == nil || responseType.Kind() != reflect.Struct {
+		return 0, false
+	}
+
+	for idx := 0; idx < responseType.NumField(); idx++ {
+		field := responseType.Field(idx)
+		if field.Tag.Get(rowsTag) != "rows" || field.Type.Kind() != reflect.Slice {
+			continue
+		}
+
+		itemType := field.Type.Elem()
+		if itemType.Kind() == reflect.Pointer {
+			itemType = itemType.Elem()
+		}
+
+		if itemType.Kind() == reflect.Struct {
+			return idx, true
+		}
+	}
+
+	return 0, false
+}
+
+// columnNames returns the json names of the fields in the order of declaration, the fields of the
+// embedded structs are flattened the same way encoding/json does it.
+func columnNames(itemType reflect.Type) []string {
+	if itemType.Kind() == reflect.Pointer {
+		itemType = itemType.Elem()
+	}
+
+	names := make([]string, 0, itemType.NumField())
+	for idx := 0; idx < itemType.NumField(); idx++ {
+		field := itemType.Field(idx)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		embeddedType := field.Type
+		if embeddedType.Kind() == reflect.Pointer {
+			embeddedType = embeddedType.Elem()
+		}
+
+		switch {
+		case name == "-":
+		case field.Anonymous && name == "" && embeddedType.Kind() == reflect.Struct:
+			names = append(names, columnNames(field.Type)...)
+		case !field.IsExported():
+		case name == "":
+			names = append(names, field.Name)
+		default:
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+func formatCell(value interface{}) (string, error) {
+	switch typedValue := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return typedValue, nil
+	case json.Number:
+		return typedValue.String(), nil
+	case bool:
+		return strconv.FormatBool(typedValue), nil
+	default:
+		serialized, serializationError := json.Marshal(typedValue)
+		return string(serialized), serializationError
+	}
+}
+
+// jsonTree returns the value as the generic json, keeping the numbers as json.Number.
+func jsonTree(value interface{}) (interface{}, error) {
+	serialized, serializationError := json.Marshal(value)
+	if serializationError != nil {
+		return nil, serializationError
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(serialized))
+	decoder.UseNumber()
+
+	var tree interface{}
+	if decodingError := decoder.Decode(&tree); decodingError != nil {
+		return nil, decodingError
+	}
+
+	return tree, nil
+}
+
+// normalizeNumbers replaces json.Number with the integers or the floats, so they aren't encoded as strings.
+func normalizeNumbers(tree interface{}) interface{} {
+	switch typedTree := tree.(type) {
+	case map[string]interface{}:
+		for key, value := range typedTree {
+			typedTree[key] = normalizeNumbers(value)
+		}
+	case []interface{}:
+		for idx, value := range typedTree {
+			typedTree[idx] = normalizeNumbers(value)
+		}
+	case json.Number:
+		if integer, conversionError := typedTree.Int64(); conversionError == nil {
+			return integer
+		}
+		float, _ := typedTree.Float64()
+		return float
+	}
+
+	return tree
+}
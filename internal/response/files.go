@@ -0,0 +1,77 @@
+package response
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Bytes is written as the body as it is. The response with FileName is downloaded as an attachment.
+type Bytes struct {
+	ContentType string
+	FileName    string
+	Data        []byte
+}
+
+func (b Bytes) Write(ctx *gin.Context, status int) error {
+	writeHeader(ctx, status, b.ContentType, b.FileName)
+	_, writingError := ctx.Writer.Write(b.Data)
+	return writingError
+}
+
+// File copies the reader to the body and closes it when it is an io.Closer. Size is sent as the
+// Content-Length, the body is chunked when the size is unknown and Size is -1.
+type File struct {
+	ContentType string
+	FileName    string
+	Size        int64
+	Reader      io.Reader
+}
+
+func (f File) Write(ctx *gin.Context, status int) error {
+	if closer, isCloser := f.Reader.(io.Closer); isCloser {
+		defer func(closer io.Closer) {
+			_ = closer.Close()
+		}(closer)
+	}
+
+	if f.Size >= 0 {
+		ctx.Header("Content-Length", strconv.FormatInt(f.Size, 10))
+	}
+
+	writeHeader(ctx, status, f.ContentType, f.FileName)
+	_, copyingError := io.Copy(ctx.Writer, f.Reader)
+	return copyingError
+}
+
+// Stream writes the body progressively, e.g. batch by batch. The status and the headers are sent before
+// WriteBody is called, so its errors can only be logged.
+type Stream struct {
+	ContentType string
+	FileName    string
+	WriteBody   func(writer io.Writer) error
+}
+
+func (s Stream) Write(ctx *gin.Context, status int) error {
+	writeHeader(ctx, status, s.ContentType, s.FileName)
+	return s.WriteBody(ctx.Writer)
+}
+
+// Redirect sends the client to Location, e.g. to the presigned url of a file. Status is 302 by default,
+// the status of the route is not a redirection, so it is never used.
+type Redirect struct {
+	Location string
+	Status   int
+}
+
+func (r Redirect) Write(ctx *gin.Context, _ int) error {
+	status := r.Status
+	if status == 0 {
+		status = http.StatusFound
+	}
+
+	ctx.Redirect(status, r.Location)
+	return nil
+}
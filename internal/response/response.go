@@ -0,0 +1,172 @@
+package response
+
+import (
+	"bytes"
+	"chat_app_backend/internal/etag"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"mime"
+	"net/http"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+)
+
+const StatusKey = "ResponseStatus"
+const BodyTagKey = "ResponseBodyTag"
+
+// the headers the tagged representations depend on besides the resource
+const taggedBodyVary = "Accept, Accept-Language, Authorization"
+
+// IResponse is the response which writes itself, e.g. a file or a redirect, instead of being encoded in
+// the format negotiated with the client.
+type IResponse interface {
+	Write(ctx *gin.Context, status int) error
+}
+
+// SetStatus replaces the status the route responds with by default, e.g. with 202 for the accepted jobs.
+func SetStatus(ctx *gin.Context, status int) {
+	ctx.Set(StatusKey, status)
+}
+
+// TagBody tags the response by its encoded body instead of the version of the record, for the
+// representations which depend on who reads them, on the locale or on the links which expire.
+func TagBody(ctx *gin.Context) {
+	ctx.Set(BodyTagKey, true)
+}
+
+// Status returns the status set by the handler or the preferred status of the route.
+func Status(ctx *gin.Context, preferredStatus int) int {
+	if status, set := ctx.Get(StatusKey); set {
+		return status.(int)
+	}
+
+	return preferredStatus
+}
+
+// Write writes the response returned by the handler. The responses which don't write themselves are
+// encoded in the format from the Accept header, the request is rejected with 406 when none of the
+// formats is accepted. The reads of the tagged resources get 304 without the body, when the client has
+// the same version or representation in If-None-Match.
+func Write(ctx *gin.Context, preferredStatus int, response interface{}) exceptions.ITrackableException {
+	status := Status(ctx, preferredStatus)
+
+	if isRead(ctx) && etag.IsNotModified(ctx) {
+		ctx.Status(http.StatusNotModified)
+		ctx.Writer.WriteHeaderNow()
+		return nil
+	}
+
+	if selfWritten, isSelfWritten := response.(IResponse); isSelfWritten {
+		if writingError := selfWritten.Write(ctx, status); writingError != nil {
+			return exceptions.WrapErrorWithTrackableException(writingError)
+		}
+		return nil
+	}
+
+	encoder := negotiate(ctx, reflect.TypeOf(response))
+	if encoder == nil {
+		return common_exceptions.NotAcceptableException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF(
+					"none of the formats is accepted by %s",
+					ctx.GetHeader("Accept"),
+				),
+				Message: "the response can't be written in any of the accepted formats",
+			},
+		}
+	}
+
+	if ctx.GetBool(BodyTagKey) {
+		return writeTagged(ctx, status, response, encoder)
+	}
+
+	if encodingError := encoder(ctx, status, response); encodingError != nil {
+		return exceptions.WrapErrorWithTrackableException(encodingError)
+	}
+
+	return nil
+}
+
+// bufferedWriter keeps the encoded body and its status, so the body can be tagged before it is sent.
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedWriter) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *bufferedWriter) WriteHeaderNow() {}
+
+func (b *bufferedWriter) Write(data []byte) (int, error) {
+	return b.body.Write(data)
+}
+
+func (b *bufferedWriter) WriteString(data string) (int, error) {
+	return b.body.WriteString(data)
+}
+
+func (b *bufferedWriter) Status() int {
+	return b.status
+}
+
+func (b *bufferedWriter) Written() bool {
+	return false
+}
+
+// writeTagged encodes the response into the buffer, tags it and sends either the body or 304, when the
+// client already has the same representation.
+func writeTagged(ctx *gin.Context, status int, response interface{}, encoder encoder) exceptions.ITrackableException {
+	buffer := &bufferedWriter{ResponseWriter: ctx.Writer, status: status}
+	ctx.Writer = buffer
+	encodingError := encoder(ctx, status, response)
+	ctx.Writer = buffer.ResponseWriter
+
+	if encodingError != nil {
+		return exceptions.WrapErrorWithTrackableException(encodingError)
+	}
+
+	ctx.Header(etag.Header, etag.FromBody(buffer.body.Bytes()))
+	ctx.Header("Vary", taggedBodyVary)
+
+	if isRead(ctx) && etag.IsNotModified(ctx) {
+		ctx.Writer.Header().Del("Content-Type")
+		ctx.Status(http.StatusNotModified)
+		ctx.Writer.WriteHeaderNow()
+		return nil
+	}
+
+	ctx.Status(buffer.status)
+	if _, writingError := ctx.Writer.Write(buffer.body.Bytes()); writingError != nil {
+		return exceptions.WrapErrorWithTrackableException(writingError)
+	}
+
+	return nil
+}
+
+func isRead(ctx *gin.Context) bool {
+	return ctx.Request.Method == http.MethodGet || ctx.Request.Method == http.MethodHead
+}
+
+// setAttachment makes the client save the body as the file, the name is escaped when it isn't ascii.
+func setAttachment(ctx *gin.Context, fileName string) {
+	if fileName == "" {
+		return
+	}
+
+	ctx.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+}
+
+func writeHeader(ctx *gin.Context, status int, contentType string, fileName string) {
+	if contentType == "" {
+		contentType = BinaryContentType
+	}
+
+	ctx.Header("Content-Type", contentType)
+	setAttachment(ctx, fileName)
+	ctx.Status(status)
+	ctx.Writer.WriteHeaderNow()
+}
@@ -2,14 +2,19 @@ package router
 
 import (
 	"chat_app_backend/internal/middleware"
-	"chat_app_backend/internal/request_env"
-	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/openapi"
+	"chat_app_backend/internal/service_wrapper"
 
 	"github.com/gin-gonic/gin"
 )
 
+// AuthorizedRoute accepts only requests of the authenticated users which meet every requirement from
+// Requirements. Anonymous requests are rejected with 401 and the unmet requirements with 403. The users
+// scheduled for deletion are rejected as well, unless AllowDeletedUsers is set, e.g. to restore them.
 type AuthorizedRoute[TRequest interface{}, TResponse interface{}] struct {
-	Route IRoute
+	Route             IRoute
+	Requirements      []Requirement
+	AllowDeletedUsers bool
 }
 
 func (a *AuthorizedRoute[TRequest, TResponse]) getMethod() HttpMethod {
@@ -20,16 +25,38 @@ func (a *AuthorizedRoute[TRequest, TResponse]) getPath() string {
 	return a.Route.getPath()
 }
 
-func (a *AuthorizedRoute[TRequest, TResponse]) getEndpointHandler(preferredResponseStatus int, env *request_env.RequestEnv) func(ctx *gin.Context) {
-	return func(ctx *gin.Context) {
-		userAny, exists := ctx.Get(middleware.ClaimsKey)
-		if !exists {
-			return
-		}
+func (a *AuthorizedRoute[TRequest, TResponse]) getServices() service_wrapper.IServiceWrapper {
+	return a.Route.getServices()
+}
 
-		user := userAny.(*db_queries.User)
-		env.User = user
+func (a *AuthorizedRoute[TRequest, TResponse]) declaresAccess() bool {
+	return true
+}
 
-		a.Route.getEndpointHandler(preferredResponseStatus, env)(ctx)
+func (a *AuthorizedRoute[TRequest, TResponse]) describe() openapi.Route {
+	description := a.Route.describe()
+	description.Security = append(description.Security, openapi.SecurityRequirement{openapi.BearerAuth: {}})
+	return description
+}
+
+func (a *AuthorizedRoute[TRequest, TResponse]) getMiddleware() []gin.HandlerFunc {
+	return append(
+		[]gin.HandlerFunc{
+			middleware.AuthenticationMiddleware(a.getServices().GetAuthenticator()),
+			requirementsMiddleware(a.requirements()),
+		},
+		a.Route.getMiddleware()...,
+	)
+}
+
+func (a *AuthorizedRoute[TRequest, TResponse]) getEndpointHandler(preferredResponseStatus int) func(ctx *gin.Context) {
+	return a.Route.getEndpointHandler(preferredResponseStatus)
+}
+
+func (a *AuthorizedRoute[TRequest, TResponse]) requirements() []Requirement {
+	if a.AllowDeletedUsers {
+		return a.Requirements
 	}
+
+	return append([]Requirement{activeUserRequirement{}}, a.Requirements...)
 }
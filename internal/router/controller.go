@@ -1,29 +1,74 @@
 package router
 
 import (
+	"chat_app_backend/internal/openapi"
+	"path"
+	"slices"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 )
 
 type IController interface {
 	ConfigureGroup()
+	Describe(document *openapi.Document)
 }
 
 type Controller struct {
 	router         *gin.Engine
-	routes         []IRoute
+	version        string
 	controllerPath string
+	group          Group
 }
 
 func (controller Controller) ConfigureGroup() {
-	group := controller.router.Group(controller.controllerPath)
-	for _, route := range controller.routes {
-		RegisterRoute(group, route)
+	controller.group.register(controller.router.Group(controller.versionPath()), nil)
+}
+
+// Describe adds the routes of the controller to the document, tagged with the controller path.
+func (controller Controller) Describe(document *openapi.Document) {
+	controller.group.describe(document, controller.versionPath(), strings.Trim(controller.controllerPath, "/"))
+}
+
+// Use adds the middleware running before every route of the controller.
+func (controller Controller) Use(middleware ...gin.HandlerFunc) Controller {
+	controller.group.Middleware = slices.Concat(controller.group.Middleware, middleware)
+	return controller
+}
+
+// WithGroups adds the groups nested in the controller path.
+func (controller Controller) WithGroups(groups ...Group) Controller {
+	controller.group.Groups = slices.Concat(controller.group.Groups, groups)
+	return controller
+}
+
+// Version mounts the controller under the version prefix, e.g. /v2/users. Controllers with the same path
+// and different versions serve side by side, so the clients can migrate one endpoint at a time.
+func (controller Controller) Version(version string) Controller {
+	controller.version = strings.Trim(version, "/")
+	return controller
+}
+
+func (controller Controller) versionPath() string {
+	return "/" + controller.version
+}
+
+// joinPaths joins the paths the same way the gin groups do, keeping the trailing slash of the route.
+func joinPaths(groupPath string, routePath string) string {
+	joined := path.Join(groupPath, routePath)
+	if strings.HasSuffix(routePath, "/") && !strings.HasSuffix(joined, "/") {
+		joined += "/"
 	}
+
+	return joined
 }
 
 func CreateController(router *gin.Engine, controllerPath string, routes []IRoute) (controller Controller) {
 	controller.router = router
 	controller.controllerPath = controllerPath
-	controller.routes = routes
+	controller.group = Group{
+		Path:   controllerPath,
+		Routes: routes,
+	}
 	return controller
 }
@@ -0,0 +1,56 @@
+package router
+
+import (
+	"chat_app_backend/internal/openapi"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DestructiveRoute rejects impersonated requests. It relies on the environment filled by AuthorizedRoute,
+// so it has to be wrapped in one.
+type DestructiveRoute[TRequest interface{}, TResponse interface{}] struct {
+	Route IRoute
+}
+
+func (d *DestructiveRoute[TRequest, TResponse]) getMethod() HttpMethod {
+	return d.Route.getMethod()
+}
+
+func (d *DestructiveRoute[TRequest, TResponse]) getPath() string {
+	return d.Route.getPath()
+}
+
+func (d *DestructiveRoute[TRequest, TResponse]) getServices() service_wrapper.IServiceWrapper {
+	return d.Route.getServices()
+}
+
+func (d *DestructiveRoute[TRequest, TResponse]) declaresAccess() bool {
+	return d.Route.declaresAccess()
+}
+
+func (d *DestructiveRoute[TRequest, TResponse]) describe() openapi.Route {
+	return d.Route.describe()
+}
+
+func (d *DestructiveRoute[TRequest, TResponse]) getMiddleware() []gin.HandlerFunc {
+	return append(
+		[]gin.HandlerFunc{
+			func(ctx *gin.Context) {
+				if request_env.FromContext(ctx).IsImpersonated() {
+					_ = ctx.Error(forbidden("the action is not allowed during impersonation"))
+					ctx.Abort()
+					return
+				}
+
+				ctx.Next()
+			},
+		},
+		d.Route.getMiddleware()...,
+	)
+}
+
+func (d *DestructiveRoute[TRequest, TResponse]) getEndpointHandler(preferredResponseStatus int) func(ctx *gin.Context) {
+	return d.Route.getEndpointHandler(preferredResponseStatus)
+}
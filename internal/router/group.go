@@ -0,0 +1,46 @@
+package router
+
+import (
+	"chat_app_backend/internal/openapi"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Group is a set of routes sharing the path prefix and the middleware. The middleware of the group runs
+// after the middleware of the enclosing groups and before the middleware of the routes.
+type Group struct {
+	Path       string
+	Middleware []gin.HandlerFunc
+	Routes     []IRoute
+	Groups     []Group
+}
+
+func (g Group) register(parent gin.IRouter, inheritedMiddleware []gin.HandlerFunc) {
+	router := parent.Group(g.Path)
+	middleware := slices.Concat(inheritedMiddleware, g.Middleware)
+
+	for _, route := range g.Routes {
+		registerRoute(router, route, middleware)
+	}
+
+	for _, group := range g.Groups {
+		group.register(router, middleware)
+	}
+}
+
+func (g Group) describe(document *openapi.Document, parentPath string, tag string) {
+	groupPath := joinPaths(parentPath, g.Path)
+
+	for _, route := range g.Routes {
+		description := route.describe()
+		description.Path = joinPaths(groupPath, description.Path)
+		description.Tag = tag
+
+		document.AddRoute(description)
+	}
+
+	for _, group := range g.Groups {
+		group.describe(document, groupPath, tag)
+	}
+}
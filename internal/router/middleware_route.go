@@ -0,0 +1,44 @@
+package router
+
+import (
+	"chat_app_backend/internal/openapi"
+	"chat_app_backend/internal/service_wrapper"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MiddlewareRoute runs Middleware before the wrapped route. Wrapped in AuthorizedRoute the middleware
+// runs after the authentication, so it can rely on the user from the environment.
+type MiddlewareRoute[TRequest interface{}, TResponse interface{}] struct {
+	Route      IRoute
+	Middleware []gin.HandlerFunc
+}
+
+func (m *MiddlewareRoute[TRequest, TResponse]) getMethod() HttpMethod {
+	return m.Route.getMethod()
+}
+
+func (m *MiddlewareRoute[TRequest, TResponse]) getPath() string {
+	return m.Route.getPath()
+}
+
+func (m *MiddlewareRoute[TRequest, TResponse]) getServices() service_wrapper.IServiceWrapper {
+	return m.Route.getServices()
+}
+
+func (m *MiddlewareRoute[TRequest, TResponse]) declaresAccess() bool {
+	return m.Route.declaresAccess()
+}
+
+func (m *MiddlewareRoute[TRequest, TResponse]) describe() openapi.Route {
+	return m.Route.describe()
+}
+
+func (m *MiddlewareRoute[TRequest, TResponse]) getMiddleware() []gin.HandlerFunc {
+	return slices.Concat(m.Middleware, m.Route.getMiddleware())
+}
+
+func (m *MiddlewareRoute[TRequest, TResponse]) getEndpointHandler(preferredResponseStatus int) func(ctx *gin.Context) {
+	return m.Route.getEndpointHandler(preferredResponseStatus)
+}
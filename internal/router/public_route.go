@@ -0,0 +1,42 @@
+package router
+
+import (
+	"chat_app_backend/internal/openapi"
+	"chat_app_backend/internal/service_wrapper"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PublicRoute accepts anonymous requests. Every route has to declare its access, so the routes are not
+// made public by mistake.
+type PublicRoute[TRequest interface{}, TResponse interface{}] struct {
+	Route IRoute
+}
+
+func (p *PublicRoute[TRequest, TResponse]) getMethod() HttpMethod {
+	return p.Route.getMethod()
+}
+
+func (p *PublicRoute[TRequest, TResponse]) getPath() string {
+	return p.Route.getPath()
+}
+
+func (p *PublicRoute[TRequest, TResponse]) getServices() service_wrapper.IServiceWrapper {
+	return p.Route.getServices()
+}
+
+func (p *PublicRoute[TRequest, TResponse]) declaresAccess() bool {
+	return true
+}
+
+func (p *PublicRoute[TRequest, TResponse]) describe() openapi.Route {
+	return p.Route.describe()
+}
+
+func (p *PublicRoute[TRequest, TResponse]) getMiddleware() []gin.HandlerFunc {
+	return p.Route.getMiddleware()
+}
+
+func (p *PublicRoute[TRequest, TResponse]) getEndpointHandler(preferredResponseStatus int) func(ctx *gin.Context) {
+	return p.Route.getEndpointHandler(preferredResponseStatus)
+}
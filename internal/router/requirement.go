@@ -0,0 +1,100 @@
+package router
+
+import (
+	"chat_app_backend/internal/access"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Requirement is checked for the authenticated user before the request is bound and validated.
+type Requirement interface {
+	Check(env *request_env.RequestEnv) exceptions.ITrackableException
+}
+
+type roleRequirement struct {
+	roles []db_queries.RoleType
+}
+
+func (r roleRequirement) Check(env *request_env.RequestEnv) exceptions.ITrackableException {
+	if slices.Contains(r.roles, env.User.Role) {
+		return nil
+	}
+
+	return forbidden("the role of the user does not allow the action")
+}
+
+type permissionRequirement struct {
+	permission access.Permission
+}
+
+func (p permissionRequirement) Check(env *request_env.RequestEnv) exceptions.ITrackableException {
+	if access.HasPermission(env.User.Role, p.permission) {
+		return nil
+	}
+
+	return forbidden("the " + string(p.permission) + " permission is required")
+}
+
+type verifiedEmailRequirement struct{}
+
+func (v verifiedEmailRequirement) Check(env *request_env.RequestEnv) exceptions.ITrackableException {
+	if env.User.EmailVerified {
+		return nil
+	}
+
+	return forbidden("the email has to be verified")
+}
+
+// activeUserRequirement rejects the users scheduled for deletion, the account has to be restored first.
+type activeUserRequirement struct{}
+
+func (a activeUserRequirement) Check(env *request_env.RequestEnv) exceptions.ITrackableException {
+	if env.User.DeletedAt == nil {
+		return nil
+	}
+
+	return forbidden("the account is scheduled for deletion")
+}
+
+// RequireRole allows the users having any of the roles.
+func RequireRole(roles ...db_queries.RoleType) Requirement {
+	return roleRequirement{roles: roles}
+}
+
+func RequirePermission(permission access.Permission) Requirement {
+	return permissionRequirement{permission: permission}
+}
+
+func RequireVerifiedEmail() Requirement {
+	return verifiedEmailRequirement{}
+}
+
+func requirementsMiddleware(requirements []Requirement) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		env := request_env.FromContext(ctx)
+
+		for _, requirement := range requirements {
+			if requirementError := requirement.Check(env); requirementError != nil {
+				_ = ctx.Error(requirementError)
+				ctx.Abort()
+				return
+			}
+		}
+
+		ctx.Next()
+	}
+}
+
+func forbidden(message string) exceptions.ITrackableException {
+	return common_exceptions.ForbiddenException{
+		BaseRestException: exceptions.BaseRestException{
+			ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+			Message:             message,
+		},
+	}
+}
@@ -4,11 +4,18 @@ import (
 	"chat_app_backend/internal/exceptions"
 	"chat_app_backend/internal/exceptions/common_exceptions"
 	"chat_app_backend/internal/handler"
+	"chat_app_backend/internal/locale"
+	"chat_app_backend/internal/middleware"
+	"chat_app_backend/internal/openapi"
 	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/response"
 	"chat_app_backend/internal/service_wrapper"
 	"chat_app_backend/internal/validator"
 	"errors"
+	"fmt"
 	"net/http"
+	"reflect"
+	"slices"
 
 	"github.com/gin-gonic/gin"
 )
@@ -29,9 +36,36 @@ func (r *BaseRoute[TRequest, TResponse]) getPath() string {
 	return r.path
 }
 
-func (r *BaseRoute[TRequest, TResponse]) getEndpointHandler(preferredResponseStatus int, env *request_env.RequestEnv) func(ctx *gin.Context) {
+func (r *BaseRoute[TRequest, TResponse]) getServices() service_wrapper.IServiceWrapper {
+	return r.wrapper
+}
+
+func (r *BaseRoute[TRequest, TResponse]) declaresAccess() bool {
+	return false
+}
+
+func (r *BaseRoute[TRequest, TResponse]) getMiddleware() []gin.HandlerFunc {
+	return nil
+}
+
+func (r *BaseRoute[TRequest, TResponse]) describe() openapi.Route {
+	description := openapi.Route{
+		Method:   httpMethodNames[r.method],
+		Path:     r.path,
+		Status:   responseStatus(r.method),
+		Request:  reflect.TypeFor[TRequest](),
+		Response: reflect.TypeFor[TResponse](),
+	}
+	response.Describe(&description)
+
+	return description
+}
+
+func (r *BaseRoute[TRequest, TResponse]) getEndpointHandler(preferredResponseStatus int) func(ctx *gin.Context) {
 	return r.wrapper.WrapRoute(
 		func(serviceWrapper service_wrapper.IServiceWrapper, ctx *gin.Context) {
+			env := request_env.FromContext(ctx)
+
 			var requestDto TRequest
 
 			if err := ctx.ShouldBind(&requestDto); err != nil {
@@ -88,40 +122,91 @@ func (r *BaseRoute[TRequest, TResponse]) getEndpointHandler(preferredResponseSta
 				return
 			}
 
-			response, handlerError := r.handler(&requestDto, serviceWrapper, ctx, env)
+			responseDto, handlerError := r.handler(&requestDto, serviceWrapper, ctx, env)
 			if handlerError != nil {
 				_ = ctx.Error(handlerError)
 				return
 			}
 
-			if response == nil {
-				ctx.Status(preferredResponseStatus)
+			// handlers streaming the response write it themselves and return nothing
+			if responseDto == nil {
+				if !ctx.Writer.Written() {
+					ctx.Status(response.Status(ctx, preferredResponseStatus))
+				}
 				return
 			}
 
-			ctx.JSON(preferredResponseStatus, response)
+			if writingError := response.Write(ctx, preferredResponseStatus, responseDto); writingError != nil {
+				if !ctx.Writer.Written() {
+					_ = ctx.Error(writingError)
+					return
+				}
+
+				// the response is already partially sent, so the error can only be logged
+				serviceWrapper.GetLogger().CreateErrorMessage(writingError).Log()
+			}
 		},
 	)
 }
 
-func RegisterRoute(router *gin.RouterGroup, route IRoute) {
-	switch route.getMethod() {
-	case POST:
-		router.POST(route.getPath(), route.getEndpointHandler(http.StatusCreated, &request_env.RequestEnv{}))
-		break
-	case GET:
-		router.GET(route.getPath(), route.getEndpointHandler(http.StatusOK, &request_env.RequestEnv{}))
-		break
-	case PUT:
-		router.PUT(route.getPath(), route.getEndpointHandler(http.StatusOK, &request_env.RequestEnv{}))
-		break
-	case PATCH:
-		router.PATCH(route.getPath(), route.getEndpointHandler(http.StatusOK, &request_env.RequestEnv{}))
-		break
-	case DELETE:
-		router.DELETE(route.getPath(), route.getEndpointHandler(http.StatusOK, &request_env.RequestEnv{}))
-		break
+// responseStatus is the status of the successful response, the handlers creating resources respond with 201.
+func responseStatus(method HttpMethod) int {
+	if method == POST {
+		return http.StatusCreated
 	}
+
+	return http.StatusOK
+}
+
+// isRetriedByClients reports whether the requests of the method aren't idempotent on their own, so the
+// retries of the clients need the Idempotency-Key.
+func isRetriedByClients(method HttpMethod) bool {
+	return method == POST || method == PATCH
+}
+
+// createRequestEnv creates the environment of the request before the route handles it. The environment is
+// stored in the gin context and in the request context, so it reaches the code which has only one of them.
+func createRequestEnv(ctx *gin.Context) {
+	env := &request_env.RequestEnv{
+		RequestId: ctx.GetString(middleware.RequestIdKey),
+		Locales:   locale.ParseAcceptLanguage(ctx.GetHeader("Accept-Language")),
+	}
+
+	if deadline, hasDeadline := ctx.Request.Context().Deadline(); hasDeadline {
+		env.Deadline = deadline
+	}
+
+	ctx.Set(request_env.Key, env)
+	ctx.Request = ctx.Request.WithContext(request_env.NewContext(ctx.Request.Context(), env))
+}
+
+// RegisterRoute installs the middleware of the route only for the route itself, so the anonymous routes
+// never run the authentication. It panics when the route does not declare its access.
+func RegisterRoute(router gin.IRoutes, route IRoute) {
+	registerRoute(router, route, nil)
+}
+
+// registerRoute runs the middleware of the enclosing groups after the environment is created and before
+// the middleware of the route.
+func registerRoute(router gin.IRoutes, route IRoute, groupMiddleware []gin.HandlerFunc) {
+	if !route.declaresAccess() {
+		panic(fmt.Sprintf("route %s %s does not declare its access", httpMethodNames[route.getMethod()], route.getPath()))
+	}
+
+	handlers := slices.Concat(
+		[]gin.HandlerFunc{createRequestEnv},
+		groupMiddleware,
+		route.getMiddleware(),
+	)
+
+	// the keys are scoped to the user, so the check runs after the authentication of the route
+	if store := route.getServices().GetIdempotencyStore(); store != nil && isRetriedByClients(route.getMethod()) {
+		handlers = append(handlers, middleware.IdempotencyMiddleware(store))
+	}
+
+	handlers = append(handlers, route.getEndpointHandler(responseStatus(route.getMethod())))
+
+	router.Handle(httpMethodNames[route.getMethod()], route.getPath(), handlers...)
 }
 
 func CreateBaseRoute[TRequest interface{}, TResponse interface{}](
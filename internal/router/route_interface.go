@@ -1,7 +1,10 @@
 package router
 
 import (
-	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/openapi"
+	"chat_app_backend/internal/service_wrapper"
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 )
 
@@ -15,8 +18,22 @@ const (
 	PATCH
 )
 
+var httpMethodNames = map[HttpMethod]string{
+	GET:    http.MethodGet,
+	POST:   http.MethodPost,
+	PUT:    http.MethodPut,
+	DELETE: http.MethodDelete,
+	PATCH:  http.MethodPatch,
+}
+
 type IRoute interface {
 	getMethod() HttpMethod
 	getPath() string
-	getEndpointHandler(preferredResponseStatus int, env *request_env.RequestEnv) func(ctx *gin.Context)
+	getServices() service_wrapper.IServiceWrapper
+	// declaresAccess reports whether the route is wrapped in a route declaring who may call it
+	declaresAccess() bool
+	// getMiddleware returns the handlers which run before the endpoint handler, in the order of wrapping
+	getMiddleware() []gin.HandlerFunc
+	getEndpointHandler(preferredResponseStatus int) func(ctx *gin.Context)
+	describe() openapi.Route
 }
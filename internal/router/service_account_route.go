@@ -0,0 +1,83 @@
+package router
+
+import (
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/middleware"
+	"chat_app_backend/internal/openapi"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/service_wrapper"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ServiceAccountRoute accepts only requests authenticated with an api key which has every scope from Scopes.
+type ServiceAccountRoute[TRequest interface{}, TResponse interface{}] struct {
+	Route  IRoute
+	Scopes []string
+}
+
+func (s *ServiceAccountRoute[TRequest, TResponse]) getMethod() HttpMethod {
+	return s.Route.getMethod()
+}
+
+func (s *ServiceAccountRoute[TRequest, TResponse]) getPath() string {
+	return s.Route.getPath()
+}
+
+func (s *ServiceAccountRoute[TRequest, TResponse]) getServices() service_wrapper.IServiceWrapper {
+	return s.Route.getServices()
+}
+
+func (s *ServiceAccountRoute[TRequest, TResponse]) declaresAccess() bool {
+	return true
+}
+
+func (s *ServiceAccountRoute[TRequest, TResponse]) describe() openapi.Route {
+	description := s.Route.describe()
+	description.Security = append(description.Security, openapi.SecurityRequirement{openapi.ApiKeyAuth: s.Scopes})
+	return description
+}
+
+func (s *ServiceAccountRoute[TRequest, TResponse]) getMiddleware() []gin.HandlerFunc {
+	return append(
+		[]gin.HandlerFunc{
+			middleware.ApiKeyMiddleware(s.getServices()),
+			func(ctx *gin.Context) {
+				principalAny, exists := ctx.Get(middleware.ServiceAccountKey)
+				if !exists {
+					message := "api key is required"
+					_ = ctx.Error(
+						common_exceptions.UnauthorizedException{
+							BaseRestException: exceptions.BaseRestException{
+								ITrackableException: exceptions.CreateTrackableExceptionFromStringF("%s", message),
+								Message:             message,
+							},
+						},
+					)
+					ctx.Abort()
+					return
+				}
+
+				principal := principalAny.(*request_env.ServiceAccountPrincipal)
+
+				for _, scope := range s.Scopes {
+					if !principal.HasScope(scope) {
+						_ = ctx.Error(forbidden("api key does not have the " + scope + " scope"))
+						ctx.Abort()
+						return
+					}
+				}
+
+				request_env.FromContext(ctx).ServiceAccount = principal
+
+				ctx.Next()
+			},
+		},
+		s.Route.getMiddleware()...,
+	)
+}
+
+func (s *ServiceAccountRoute[TRequest, TResponse]) getEndpointHandler(preferredResponseStatus int) func(ctx *gin.Context) {
+	return s.Route.getEndpointHandler(preferredResponseStatus)
+}
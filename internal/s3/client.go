@@ -1,9 +1,11 @@
 package s3
 
 import (
+	"bytes"
 	"chat_app_backend/internal/extensions"
 	"context"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/url"
 	"regexp"
@@ -29,6 +31,8 @@ type Buckets = string
 const (
 	AvatarsBucket       Buckets = "avatars"
 	InterestsIconBucket         = "interests"
+	AttachmentsBucket           = "attachments"
+	ExportsBucket               = "exports"
 )
 
 type FileType = string
@@ -37,11 +41,14 @@ const (
 	Png  FileType = "png"
 	Jpeg          = "jpeg"
 	Svg           = "svg"
+	Zip           = "zip"
 )
 
 type IClient interface {
 	GetDownloadUrl(ctx context.Context, filename string, bucketName Buckets) (string, error)
 	UploadFile(ctx context.Context, fileHeader *multipart.FileHeader, filename string, bucketName Buckets) (string, error)
+	UploadBytes(ctx context.Context, data []byte, contentType string, filename string, bucketName Buckets) (string, error)
+	GetFile(ctx context.Context, filename string, bucketName Buckets) (io.ReadCloser, error)
 	DeleteFile(ctx context.Context, filename string, bucketName Buckets) error
 	ModifyFileContents(ctx context.Context, fileHeader *multipart.FileHeader, filename, newFileName string, bucketName Buckets) (string, error)
 	CreateBucket(ctx context.Context, bucketName Buckets) error
@@ -127,6 +134,47 @@ func (c *Client) UploadFile(ctx context.Context, fileHeader *multipart.FileHeade
 	return c.GetDownloadUrl(ctx, filename, bucketName)
 }
 
+func (c *Client) UploadBytes(ctx context.Context, data []byte, contentType string, filename string, bucketName Buckets) (string, error) {
+	exists, err := c.FileExists(ctx, filename, bucketName)
+	if err != nil {
+		return "", err
+	}
+
+	if exists {
+		return "", fmt.Errorf("file %s already exists in bucket %s", filename, bucketName)
+	}
+
+	_, uploadError := c.client.PutObject(
+		ctx,
+		bucketName,
+		filename,
+		bytes.NewReader(data),
+		int64(len(data)),
+		minio.PutObjectOptions{
+			ContentType: contentType,
+		},
+	)
+
+	if uploadError != nil {
+		return "", uploadError
+	}
+
+	return c.GetDownloadUrl(ctx, filename, bucketName)
+}
+
+func (c *Client) GetFile(ctx context.Context, filename string, bucketName Buckets) (io.ReadCloser, error) {
+	exists, err := c.FileExists(ctx, filename, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("file %s does not exist in bucket %s", filename, bucketName)
+	}
+
+	return c.client.GetObject(ctx, bucketName, filename, minio.GetObjectOptions{})
+}
+
 func (c *Client) DeleteFile(ctx context.Context, filename string, bucketName Buckets) error {
 	exists, err := c.FileExists(ctx, filename, bucketName)
 	if err != nil {
@@ -234,6 +282,8 @@ func DeconstructFileName(fileName string) (string, FileType, error) {
 		return matches[indexFileName], Jpeg, nil
 	case "svg":
 		return matches[indexFileName], Svg, nil
+	case "zip":
+		return matches[indexFileName], Zip, nil
 	default:
 		return matches[indexFileName], Png, fmt.Errorf("unknown file type %s", matches[indexFileType])
 	}
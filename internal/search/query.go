@@ -0,0 +1,35 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// PrefixQuery converts user input to a tsquery, which matches the documents containing every word of
+// the input as a prefix, so the results narrow down while the user types. Everything except letters and
+// digits is treated as a separator, so the input can't inject tsquery operators. Nil is returned when
+// the input has no words.
+func PrefixQuery(input string) *string {
+	words := strings.FieldsFunc(input, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return nil
+	}
+
+	terms := make([]string, len(words))
+	for idx, word := range words {
+		terms[idx] = strings.ToLower(word) + ":*"
+	}
+
+	query := strings.Join(terms, " & ")
+	return &query
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// EscapeLike escapes the wildcards of LIKE patterns in user input, so it is matched literally. The
+// patterns have to declare the backslash with ESCAPE '\'.
+func EscapeLike(input string) string {
+	return likeEscaper.Replace(input)
+}
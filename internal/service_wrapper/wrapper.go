@@ -2,8 +2,14 @@ package service_wrapper
 
 import (
 	"chat_app_backend/application/models/jwt_claims"
+	"chat_app_backend/internal/authentication"
+	"chat_app_backend/internal/breached_passwords"
+	"chat_app_backend/internal/configuration"
+	"chat_app_backend/internal/idempotency"
+	"chat_app_backend/internal/images"
 	"chat_app_backend/internal/jwt"
 	"chat_app_backend/internal/logger"
+	"chat_app_backend/internal/password"
 	"chat_app_backend/internal/redis"
 	"chat_app_backend/internal/s3"
 	"chat_app_backend/internal/sqlc/db"
@@ -20,21 +26,57 @@ type IServiceWrapper interface {
 	GetLogger() logger.ILogger
 	GetRedisClient() *redis.Client
 	GetS3Client() s3.IClient
+	GetConfiguration() configuration.IConfiguration
+	GetPasswordHasher() password.IHasher
+	GetBreachedPasswordsFilter() breached_passwords.IFilter
+	GetImageProcessor() images.IProcessor
+	GetAuthenticator() authentication.IAuthenticator
+	GetIdempotencyStore() idempotency.IStore
 	Close() error
 }
 
 type ServiceWrapper struct {
-	db          db.IDbConnection
-	jwtHandler  jwt.IHandler[jwt_claims.UserClaims]
-	logger      logger.ILogger
-	redisClient *redis.Client
-	s3Client    s3.IClient
+	db             db.IDbConnection
+	jwtHandler     jwt.IHandler[jwt_claims.UserClaims]
+	logger         logger.ILogger
+	redisClient    *redis.Client
+	s3Client       s3.IClient
+	configuration  configuration.IConfiguration
+	passwordHasher password.IHasher
+	breachedFilter breached_passwords.IFilter
+	imageProcessor images.IProcessor
+	authenticator  authentication.IAuthenticator
+	idempotency    idempotency.IStore
 }
 
 func (wrapper *ServiceWrapper) GetS3Client() s3.IClient {
 	return wrapper.s3Client
 }
 
+func (wrapper *ServiceWrapper) GetConfiguration() configuration.IConfiguration {
+	return wrapper.configuration
+}
+
+func (wrapper *ServiceWrapper) GetPasswordHasher() password.IHasher {
+	return wrapper.passwordHasher
+}
+
+func (wrapper *ServiceWrapper) GetBreachedPasswordsFilter() breached_passwords.IFilter {
+	return wrapper.breachedFilter
+}
+
+func (wrapper *ServiceWrapper) GetImageProcessor() images.IProcessor {
+	return wrapper.imageProcessor
+}
+
+func (wrapper *ServiceWrapper) GetAuthenticator() authentication.IAuthenticator {
+	return wrapper.authenticator
+}
+
+func (wrapper *ServiceWrapper) GetIdempotencyStore() idempotency.IStore {
+	return wrapper.idempotency
+}
+
 func (wrapper *ServiceWrapper) GetRedisClient() *redis.Client {
 	return wrapper.redisClient
 }
@@ -69,6 +111,12 @@ func CreateWrapper(
 	logger logger.ILogger,
 	redisClient *redis.Client,
 	s3Client s3.IClient,
+	configuration configuration.IConfiguration,
+	passwordHasher password.IHasher,
+	breachedFilter breached_passwords.IFilter,
+	imageProcessor images.IProcessor,
+	authenticator authentication.IAuthenticator,
+	idempotencyStore idempotency.IStore,
 ) IServiceWrapper {
 	sw := &ServiceWrapper{}
 	sw.db = db
@@ -76,5 +124,11 @@ func CreateWrapper(
 	sw.logger = logger
 	sw.redisClient = redisClient
 	sw.s3Client = s3Client
+	sw.configuration = configuration
+	sw.passwordHasher = passwordHasher
+	sw.breachedFilter = breachedFilter
+	sw.imageProcessor = imageProcessor
+	sw.authenticator = authenticator
+	sw.idempotency = idempotencyStore
 	return sw
 }
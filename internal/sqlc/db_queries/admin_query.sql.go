@@ -0,0 +1,327 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: admin_query.sql
+
+package db_queries
+
+import (
+	"context"
+	"time"
+
+	"chat_app_backend/internal/extensions"
+)
+
+const countSearchedUsers = `-- name: CountSearchedUsers :one
+SELECT COUNT(*)
+FROM users
+WHERE
+    ($1::role_type IS NULL OR users.role = $1::role_type)
+  AND
+    ($2::bool IS NULL OR users.email_verified = $2::bool)
+  AND
+    ($3::bool IS NULL OR users.online = $3::bool)
+  AND
+    ($4::timestamptz IS NULL OR users.created_at >= $4::timestamptz)
+  AND
+    ($5::timestamptz IS NULL OR users.created_at < $5::timestamptz)
+  AND
+    (
+        $6::text IS NULL
+        OR users.full_name ILIKE '%' || $6::text || '%' ESCAPE '\'
+        OR users.email ILIKE '%' || $6::text || '%' ESCAPE '\'
+    )
+`
+
+type CountSearchedUsersParams struct {
+	Role          NullRoleType
+	EmailVerified *bool
+	Online        *bool
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	Search        *string
+}
+
+func (q *Queries) CountSearchedUsers(ctx context.Context, arg CountSearchedUsersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSearchedUsers,
+		arg.Role,
+		arg.EmailVerified,
+		arg.Online,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.Search,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const incrementSessionVersion = `-- name: IncrementSessionVersion :one
+UPDATE users
+SET
+    session_version = session_version + 1,
+    online = false,
+    updated_at = now()
+WHERE users.id = $1
+RETURNING id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role, deleted_at, purge_after, profile_visibility, presence_visibility, private_chat_permission, avatar_generated, suspended_until, suspension_reason, session_version
+`
+
+func (q *Queries) IncrementSessionVersion(ctx context.Context, id extensions.UUID) (User, error) {
+	row := q.db.QueryRow(ctx, incrementSessionVersion, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.Birthday,
+		&i.Gender,
+		&i.Email,
+		&i.Password,
+		&i.AvatarFileName,
+		&i.Online,
+		&i.EmailVerified,
+		&i.LastSeen,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DeletedAt,
+		&i.PurgeAfter,
+		&i.ProfileVisibility,
+		&i.PresenceVisibility,
+		&i.PrivateChatPermission,
+		&i.AvatarGenerated,
+		&i.SuspendedUntil,
+		&i.SuspensionReason,
+		&i.SessionVersion,
+	)
+	return i, err
+}
+
+const liftUserSuspension = `-- name: LiftUserSuspension :one
+UPDATE users
+SET
+    suspended_until = null,
+    suspension_reason = null,
+    updated_at = now()
+WHERE users.id = $1
+RETURNING id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role, deleted_at, purge_after, profile_visibility, presence_visibility, private_chat_permission, avatar_generated, suspended_until, suspension_reason, session_version
+`
+
+func (q *Queries) LiftUserSuspension(ctx context.Context, id extensions.UUID) (User, error) {
+	row := q.db.QueryRow(ctx, liftUserSuspension, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.Birthday,
+		&i.Gender,
+		&i.Email,
+		&i.Password,
+		&i.AvatarFileName,
+		&i.Online,
+		&i.EmailVerified,
+		&i.LastSeen,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DeletedAt,
+		&i.PurgeAfter,
+		&i.ProfileVisibility,
+		&i.PresenceVisibility,
+		&i.PrivateChatPermission,
+		&i.AvatarGenerated,
+		&i.SuspendedUntil,
+		&i.SuspensionReason,
+		&i.SessionVersion,
+	)
+	return i, err
+}
+
+const searchUsers = `-- name: SearchUsers :many
+SELECT id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role, deleted_at, purge_after, profile_visibility, presence_visibility, private_chat_permission, avatar_generated, suspended_until, suspension_reason, session_version
+FROM users
+WHERE
+    ($1::role_type IS NULL OR users.role = $1::role_type)
+  AND
+    ($2::bool IS NULL OR users.email_verified = $2::bool)
+  AND
+    ($3::bool IS NULL OR users.online = $3::bool)
+  AND
+    ($4::timestamptz IS NULL OR users.created_at >= $4::timestamptz)
+  AND
+    ($5::timestamptz IS NULL OR users.created_at < $5::timestamptz)
+  AND
+    (
+        $6::text IS NULL
+        OR users.full_name ILIKE '%' || $6::text || '%' ESCAPE '\'
+        OR users.email ILIKE '%' || $6::text || '%' ESCAPE '\'
+    )
+ORDER BY
+    CASE WHEN $7::text = 'full_name' AND NOT $8::bool THEN users.full_name END,
+    CASE WHEN $7::text = 'full_name' AND $8::bool THEN users.full_name END DESC,
+    CASE WHEN $7::text = 'email' AND NOT $8::bool THEN users.email END,
+    CASE WHEN $7::text = 'email' AND $8::bool THEN users.email END DESC,
+    CASE WHEN $7::text = 'last_seen' AND NOT $8::bool THEN users.last_seen END,
+    CASE WHEN $7::text = 'last_seen' AND $8::bool THEN users.last_seen END DESC,
+    CASE WHEN $7::text = 'created_at' AND NOT $8::bool THEN users.created_at END,
+    CASE WHEN $7::text = 'created_at' AND $8::bool THEN users.created_at END DESC,
+    users.id
+LIMIT $10 OFFSET $9
+`
+
+type SearchUsersParams struct {
+	Role          NullRoleType
+	EmailVerified *bool
+	Online        *bool
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	Search        *string
+	SortBy        string
+	Descending    bool
+	SkipCount     int32
+	MaxCount      int32
+}
+
+func (q *Queries) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, searchUsers,
+		arg.Role,
+		arg.EmailVerified,
+		arg.Online,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.Search,
+		arg.SortBy,
+		arg.Descending,
+		arg.SkipCount,
+		arg.MaxCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Birthday,
+			&i.Gender,
+			&i.Email,
+			&i.Password,
+			&i.AvatarFileName,
+			&i.Online,
+			&i.EmailVerified,
+			&i.LastSeen,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Role,
+			&i.DeletedAt,
+			&i.PurgeAfter,
+			&i.ProfileVisibility,
+			&i.PresenceVisibility,
+			&i.PrivateChatPermission,
+			&i.AvatarGenerated,
+			&i.SuspendedUntil,
+			&i.SuspensionReason,
+			&i.SessionVersion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setUserRole = `-- name: SetUserRole :one
+UPDATE users
+SET
+    role = $1,
+    updated_at = now()
+WHERE users.id = $2
+RETURNING id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role, deleted_at, purge_after, profile_visibility, presence_visibility, private_chat_permission, avatar_generated, suspended_until, suspension_reason, session_version
+`
+
+type SetUserRoleParams struct {
+	Role RoleType
+	ID   extensions.UUID
+}
+
+func (q *Queries) SetUserRole(ctx context.Context, arg SetUserRoleParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserRole, arg.Role, arg.ID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.Birthday,
+		&i.Gender,
+		&i.Email,
+		&i.Password,
+		&i.AvatarFileName,
+		&i.Online,
+		&i.EmailVerified,
+		&i.LastSeen,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DeletedAt,
+		&i.PurgeAfter,
+		&i.ProfileVisibility,
+		&i.PresenceVisibility,
+		&i.PrivateChatPermission,
+		&i.AvatarGenerated,
+		&i.SuspendedUntil,
+		&i.SuspensionReason,
+		&i.SessionVersion,
+	)
+	return i, err
+}
+
+const suspendUser = `-- name: SuspendUser :one
+UPDATE users
+SET
+    suspended_until = $1,
+    suspension_reason = $2,
+    online = false,
+    updated_at = now()
+WHERE users.id = $3
+RETURNING id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role, deleted_at, purge_after, profile_visibility, presence_visibility, private_chat_permission, avatar_generated, suspended_until, suspension_reason, session_version
+`
+
+type SuspendUserParams struct {
+	SuspendedUntil   *time.Time
+	SuspensionReason *string
+	ID               extensions.UUID
+}
+
+func (q *Queries) SuspendUser(ctx context.Context, arg SuspendUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, suspendUser, arg.SuspendedUntil, arg.SuspensionReason, arg.ID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.Birthday,
+		&i.Gender,
+		&i.Email,
+		&i.Password,
+		&i.AvatarFileName,
+		&i.Online,
+		&i.EmailVerified,
+		&i.LastSeen,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DeletedAt,
+		&i.PurgeAfter,
+		&i.ProfileVisibility,
+		&i.PresenceVisibility,
+		&i.PrivateChatPermission,
+		&i.AvatarGenerated,
+		&i.SuspendedUntil,
+		&i.SuspensionReason,
+		&i.SessionVersion,
+	)
+	return i, err
+}
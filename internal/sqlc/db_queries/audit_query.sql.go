@@ -0,0 +1,200 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: audit_query.sql
+
+package db_queries
+
+import (
+	"context"
+	"time"
+
+	"chat_app_backend/internal/extensions"
+)
+
+const createAuditLogEntry = `-- name: CreateAuditLogEntry :exec
+INSERT INTO audit_log
+(actor_type, actor_id, action, target_type, target_id, changes, ip, request_id)
+VALUES
+($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateAuditLogEntryParams struct {
+	ActorType  AuditActorType
+	ActorID    *extensions.UUID
+	Action     string
+	TargetType string
+	TargetID   string
+	Changes    []byte
+	Ip         *string
+	RequestID  *string
+}
+
+func (q *Queries) CreateAuditLogEntry(ctx context.Context, arg CreateAuditLogEntryParams) error {
+	_, err := q.db.Exec(ctx, createAuditLogEntry,
+		arg.ActorType,
+		arg.ActorID,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.Changes,
+		arg.Ip,
+		arg.RequestID,
+	)
+	return err
+}
+
+const getAuditLogEntries = `-- name: GetAuditLogEntries :many
+SELECT id, actor_type, actor_id, action, target_type, target_id, changes, ip, request_id, created_at
+FROM audit_log
+WHERE
+    ($1::uuid IS NULL OR audit_log.actor_id = $1::uuid)
+  AND
+    ($2::text IS NULL OR audit_log.action = $2::text)
+  AND
+    ($3::text IS NULL OR audit_log.target_type = $3::text)
+  AND
+    ($4::text IS NULL OR audit_log.target_id = $4::text)
+  AND
+    ($5::text IS NULL OR audit_log.request_id = $5::text)
+  AND
+    ($6::timestamptz IS NULL OR audit_log.created_at >= $6::timestamptz)
+  AND
+    ($7::timestamptz IS NULL OR audit_log.created_at < $7::timestamptz)
+ORDER BY audit_log.created_at DESC, audit_log.id DESC
+LIMIT $9 OFFSET $8
+`
+
+type GetAuditLogEntriesParams struct {
+	ActorID     *extensions.UUID
+	Action      *string
+	TargetType  *string
+	TargetID    *string
+	RequestID   *string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	SkipCount   int32
+	MaxCount    int32
+}
+
+func (q *Queries) GetAuditLogEntries(ctx context.Context, arg GetAuditLogEntriesParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, getAuditLogEntries,
+		arg.ActorID,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.RequestID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.SkipCount,
+		arg.MaxCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditLog{}
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorType,
+			&i.ActorID,
+			&i.Action,
+			&i.TargetType,
+			&i.TargetID,
+			&i.Changes,
+			&i.Ip,
+			&i.RequestID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuditLogEntriesAfter = `-- name: GetAuditLogEntriesAfter :many
+SELECT id, actor_type, actor_id, action, target_type, target_id, changes, ip, request_id, created_at
+FROM audit_log
+WHERE
+    ($1::uuid IS NULL OR audit_log.actor_id = $1::uuid)
+  AND
+    ($2::text IS NULL OR audit_log.action = $2::text)
+  AND
+    ($3::text IS NULL OR audit_log.target_type = $3::text)
+  AND
+    ($4::text IS NULL OR audit_log.target_id = $4::text)
+  AND
+    ($5::text IS NULL OR audit_log.request_id = $5::text)
+  AND
+    ($6::timestamptz IS NULL OR audit_log.created_at >= $6::timestamptz)
+  AND
+    ($7::timestamptz IS NULL OR audit_log.created_at < $7::timestamptz)
+  AND
+    (
+        $8::timestamptz IS NULL
+        OR (audit_log.created_at, audit_log.id) > ($8::timestamptz, $9::uuid)
+    )
+ORDER BY audit_log.created_at, audit_log.id
+LIMIT $10
+`
+
+type GetAuditLogEntriesAfterParams struct {
+	ActorID        *extensions.UUID
+	Action         *string
+	TargetType     *string
+	TargetID       *string
+	RequestID      *string
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time
+	AfterCreatedAt *time.Time
+	AfterID        *extensions.UUID
+	MaxCount       int32
+}
+
+func (q *Queries) GetAuditLogEntriesAfter(ctx context.Context, arg GetAuditLogEntriesAfterParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, getAuditLogEntriesAfter,
+		arg.ActorID,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.RequestID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.MaxCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditLog{}
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorType,
+			&i.ActorID,
+			&i.Action,
+			&i.TargetType,
+			&i.TargetID,
+			&i.Changes,
+			&i.Ip,
+			&i.RequestID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
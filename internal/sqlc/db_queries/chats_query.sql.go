@@ -0,0 +1,301 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: chats_query.sql
+
+package db_queries
+
+import (
+	"context"
+
+	"chat_app_backend/internal/extensions"
+)
+
+const addUserToChat = `-- name: AddUserToChat :exec
+INSERT INTO user_chats
+(user_id, chat_id)
+VALUES
+($1, $2)
+`
+
+type AddUserToChatParams struct {
+	UserID extensions.UUID
+	ChatID extensions.UUID
+}
+
+func (q *Queries) AddUserToChat(ctx context.Context, arg AddUserToChatParams) error {
+	_, err := q.db.Exec(ctx, addUserToChat, arg.UserID, arg.ChatID)
+	return err
+}
+
+const anonymizeUserMessages = `-- name: AnonymizeUserMessages :exec
+UPDATE messages
+SET
+    sender_id = null,
+    updated_at = now()
+WHERE messages.sender_id = $1::uuid
+`
+
+func (q *Queries) AnonymizeUserMessages(ctx context.Context, senderID extensions.UUID) error {
+	_, err := q.db.Exec(ctx, anonymizeUserMessages, senderID)
+	return err
+}
+
+const createChat = `-- name: CreateChat :one
+INSERT INTO chats
+(title, c_type)
+VALUES
+($1, $2)
+RETURNING id, title, c_type, created_at, updated_at
+`
+
+type CreateChatParams struct {
+	Title *string
+	CType ChatType
+}
+
+func (q *Queries) CreateChat(ctx context.Context, arg CreateChatParams) (Chat, error) {
+	row := q.db.QueryRow(ctx, createChat, arg.Title, arg.CType)
+	var i Chat
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.CType,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPrivateChatBetween = `-- name: GetPrivateChatBetween :one
+SELECT chats.id, chats.title, chats.c_type, chats.created_at, chats.updated_at
+FROM chats
+JOIN user_chats AS first_member ON chats.id = first_member.chat_id
+JOIN user_chats AS second_member ON chats.id = second_member.chat_id
+WHERE
+    chats.c_type = 'PRIVATE_CHAT'::chat_type
+  AND
+    first_member.user_id = $1
+  AND
+    second_member.user_id = $2
+LIMIT 1
+`
+
+type GetPrivateChatBetweenParams struct {
+	FirstUserID  extensions.UUID
+	SecondUserID extensions.UUID
+}
+
+func (q *Queries) GetPrivateChatBetween(ctx context.Context, arg GetPrivateChatBetweenParams) (Chat, error) {
+	row := q.db.QueryRow(ctx, getPrivateChatBetween, arg.FirstUserID, arg.SecondUserID)
+	var i Chat
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.CType,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getUserAttachments = `-- name: GetUserAttachments :many
+SELECT attachments.id, attachments.message_id, attachments.filename, attachments.created_at, attachments.updated_at
+FROM attachments
+JOIN messages ON attachments.message_id = messages.id
+WHERE messages.sender_id = $1::uuid
+`
+
+func (q *Queries) GetUserAttachments(ctx context.Context, senderID extensions.UUID) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, getUserAttachments, senderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Attachment{}
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.MessageID,
+			&i.Filename,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserChats = `-- name: GetUserChats :many
+SELECT chats.id, chats.title, chats.c_type, chats.created_at, chats.updated_at
+FROM chats
+JOIN user_chats ON chats.id = user_chats.chat_id
+WHERE user_chats.user_id = $1
+`
+
+func (q *Queries) GetUserChats(ctx context.Context, userID extensions.UUID) ([]Chat, error) {
+	rows, err := q.db.Query(ctx, getUserChats, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Chat{}
+	for rows.Next() {
+		var i Chat
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.CType,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserMessages = `-- name: GetUserMessages :many
+SELECT id, chat_id, sender_id, raw_text, edited, message_reference_id, created_at, updated_at, sender_service_account_id
+FROM messages
+WHERE messages.sender_id = $1::uuid
+ORDER BY messages.created_at
+`
+
+func (q *Queries) GetUserMessages(ctx context.Context, senderID extensions.UUID) ([]Message, error) {
+	rows, err := q.db.Query(ctx, getUserMessages, senderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Message{}
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChatID,
+			&i.SenderID,
+			&i.RawText,
+			&i.Edited,
+			&i.MessageReferenceID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SenderServiceAccountID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserOwnedChatAttachments = `-- name: GetUserOwnedChatAttachments :many
+SELECT attachments.id, attachments.message_id, attachments.filename, attachments.created_at, attachments.updated_at
+FROM attachments
+JOIN messages ON attachments.message_id = messages.id
+JOIN user_chats ON messages.chat_id = user_chats.chat_id
+WHERE
+    user_chats.user_id = $1
+  AND
+    NOT EXISTS (
+        SELECT 1
+        FROM user_chats AS members
+        WHERE members.chat_id = user_chats.chat_id AND members.user_id <> $1
+    )
+`
+
+func (q *Queries) GetUserOwnedChatAttachments(ctx context.Context, userID extensions.UUID) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, getUserOwnedChatAttachments, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Attachment{}
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.MessageID,
+			&i.Filename,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const isUserInChat = `-- name: IsUserInChat :one
+SELECT COUNT(*) > 0
+FROM user_chats
+WHERE user_chats.user_id = $1 AND user_chats.chat_id = $2
+`
+
+type IsUserInChatParams struct {
+	UserID extensions.UUID
+	ChatID extensions.UUID
+}
+
+func (q *Queries) IsUserInChat(ctx context.Context, arg IsUserInChatParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isUserInChat, arg.UserID, arg.ChatID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const messageExistsInChat = `-- name: MessageExistsInChat :one
+SELECT COUNT(id) > 0
+FROM messages
+WHERE messages.id = $1 AND messages.chat_id = $2
+`
+
+type MessageExistsInChatParams struct {
+	ID     extensions.UUID
+	ChatID extensions.UUID
+}
+
+func (q *Queries) MessageExistsInChat(ctx context.Context, arg MessageExistsInChatParams) (bool, error) {
+	row := q.db.QueryRow(ctx, messageExistsInChat, arg.ID, arg.ChatID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const removeUserOwnedChats = `-- name: RemoveUserOwnedChats :exec
+DELETE FROM chats
+WHERE
+    chats.id IN (
+        SELECT user_chats.chat_id
+        FROM user_chats
+        WHERE user_chats.user_id = $1
+    )
+  AND
+    NOT EXISTS (
+        SELECT 1
+        FROM user_chats AS members
+        WHERE members.chat_id = chats.id AND members.user_id <> $1
+    )
+`
+
+func (q *Queries) RemoveUserOwnedChats(ctx context.Context, userID extensions.UUID) error {
+	_, err := q.db.Exec(ctx, removeUserOwnedChats, userID)
+	return err
+}
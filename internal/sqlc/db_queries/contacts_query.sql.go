@@ -0,0 +1,389 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: contacts_query.sql
+
+package db_queries
+
+import (
+	"context"
+	"time"
+
+	"chat_app_backend/internal/extensions"
+)
+
+const addContact = `-- name: AddContact :exec
+INSERT INTO contacts
+(user_id, contact_id)
+VALUES
+($1, $2),
+($2, $1)
+ON CONFLICT DO NOTHING
+`
+
+type AddContactParams struct {
+	UserID    extensions.UUID
+	ContactID extensions.UUID
+}
+
+func (q *Queries) AddContact(ctx context.Context, arg AddContactParams) error {
+	_, err := q.db.Exec(ctx, addContact, arg.UserID, arg.ContactID)
+	return err
+}
+
+const areContacts = `-- name: AreContacts :one
+SELECT COUNT(*) > 0
+FROM contacts
+WHERE contacts.user_id = $1 AND contacts.contact_id = $2
+`
+
+type AreContactsParams struct {
+	UserID    extensions.UUID
+	ContactID extensions.UUID
+}
+
+func (q *Queries) AreContacts(ctx context.Context, arg AreContactsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, areContacts, arg.UserID, arg.ContactID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countUserContacts = `-- name: CountUserContacts :one
+SELECT COUNT(*)
+FROM contacts
+JOIN users ON contacts.contact_id = users.id
+WHERE contacts.user_id = $1 AND users.deleted_at IS NULL
+`
+
+func (q *Queries) CountUserContacts(ctx context.Context, userID extensions.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserContacts, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createContactRequest = `-- name: CreateContactRequest :one
+INSERT INTO contact_requests
+(sender_id, receiver_id)
+VALUES
+($1, $2)
+RETURNING id, sender_id, receiver_id, status, created_at, updated_at
+`
+
+type CreateContactRequestParams struct {
+	SenderID   extensions.UUID
+	ReceiverID extensions.UUID
+}
+
+func (q *Queries) CreateContactRequest(ctx context.Context, arg CreateContactRequestParams) (ContactRequest, error) {
+	row := q.db.QueryRow(ctx, createContactRequest, arg.SenderID, arg.ReceiverID)
+	var i ContactRequest
+	err := row.Scan(
+		&i.ID,
+		&i.SenderID,
+		&i.ReceiverID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getContactRequestById = `-- name: GetContactRequestById :one
+SELECT id, sender_id, receiver_id, status, created_at, updated_at
+FROM contact_requests
+WHERE contact_requests.id = $1
+`
+
+func (q *Queries) GetContactRequestById(ctx context.Context, id extensions.UUID) (ContactRequest, error) {
+	row := q.db.QueryRow(ctx, getContactRequestById, id)
+	var i ContactRequest
+	err := row.Scan(
+		&i.ID,
+		&i.SenderID,
+		&i.ReceiverID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getIncomingContactRequests = `-- name: GetIncomingContactRequests :many
+SELECT id, sender_id, receiver_id, status, created_at, updated_at
+FROM contact_requests
+WHERE
+    contact_requests.receiver_id = $1
+  AND
+    contact_requests.status = 'PENDING'::contact_request_status
+  AND
+    -- the users scheduled for deletion are hidden from the others
+    NOT EXISTS (SELECT 1 FROM users WHERE users.id = contact_requests.sender_id AND users.deleted_at IS NOT NULL)
+ORDER BY contact_requests.created_at DESC
+LIMIT $3 OFFSET $2
+`
+
+type GetIncomingContactRequestsParams struct {
+	UserID    extensions.UUID
+	SkipCount int32
+	MaxCount  int32
+}
+
+func (q *Queries) GetIncomingContactRequests(ctx context.Context, arg GetIncomingContactRequestsParams) ([]ContactRequest, error) {
+	rows, err := q.db.Query(ctx, getIncomingContactRequests, arg.UserID, arg.SkipCount, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ContactRequest{}
+	for rows.Next() {
+		var i ContactRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.SenderID,
+			&i.ReceiverID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOutgoingContactRequests = `-- name: GetOutgoingContactRequests :many
+SELECT id, sender_id, receiver_id, status, created_at, updated_at
+FROM contact_requests
+WHERE
+    contact_requests.sender_id = $1
+  AND
+    contact_requests.status = 'PENDING'::contact_request_status
+  AND
+    -- the users scheduled for deletion are hidden from the others
+    NOT EXISTS (SELECT 1 FROM users WHERE users.id = contact_requests.receiver_id AND users.deleted_at IS NOT NULL)
+ORDER BY contact_requests.created_at DESC
+LIMIT $3 OFFSET $2
+`
+
+type GetOutgoingContactRequestsParams struct {
+	UserID    extensions.UUID
+	SkipCount int32
+	MaxCount  int32
+}
+
+func (q *Queries) GetOutgoingContactRequests(ctx context.Context, arg GetOutgoingContactRequestsParams) ([]ContactRequest, error) {
+	rows, err := q.db.Query(ctx, getOutgoingContactRequests, arg.UserID, arg.SkipCount, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ContactRequest{}
+	for rows.Next() {
+		var i ContactRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.SenderID,
+			&i.ReceiverID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserContacts = `-- name: GetUserContacts :many
+SELECT
+    users.id,
+    users.full_name,
+    users.online,
+    users.last_seen,
+    users.presence_visibility,
+    contacts.created_at AS contact_since
+FROM contacts
+JOIN users ON contacts.contact_id = users.id
+WHERE contacts.user_id = $1 AND users.deleted_at IS NULL
+ORDER BY users.full_name
+LIMIT $3 OFFSET $2
+`
+
+type GetUserContactsParams struct {
+	UserID    extensions.UUID
+	SkipCount int32
+	MaxCount  int32
+}
+
+type GetUserContactsRow struct {
+	ID                 extensions.UUID
+	FullName           string
+	Online             bool
+	LastSeen           time.Time
+	PresenceVisibility VisibilityLevel
+	ContactSince       time.Time
+}
+
+func (q *Queries) GetUserContacts(ctx context.Context, arg GetUserContactsParams) ([]GetUserContactsRow, error) {
+	rows, err := q.db.Query(ctx, getUserContacts, arg.UserID, arg.SkipCount, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetUserContactsRow{}
+	for rows.Next() {
+		var i GetUserContactsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Online,
+			&i.LastSeen,
+			&i.PresenceVisibility,
+			&i.ContactSince,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const pendingContactRequestExists = `-- name: PendingContactRequestExists :one
+SELECT COUNT(id) > 0
+FROM contact_requests
+WHERE
+    status = 'PENDING'::contact_request_status
+  AND
+    (
+        (sender_id = $1 AND receiver_id = $2)
+        OR
+        (sender_id = $2 AND receiver_id = $1)
+    )
+`
+
+type PendingContactRequestExistsParams struct {
+	FirstUserID  extensions.UUID
+	SecondUserID extensions.UUID
+}
+
+func (q *Queries) PendingContactRequestExists(ctx context.Context, arg PendingContactRequestExistsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, pendingContactRequestExists, arg.FirstUserID, arg.SecondUserID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const removeContact = `-- name: RemoveContact :execrows
+DELETE FROM contacts
+WHERE
+    (contacts.user_id = $1 AND contacts.contact_id = $2)
+   OR
+    (contacts.user_id = $2 AND contacts.contact_id = $1)
+`
+
+type RemoveContactParams struct {
+	UserID    extensions.UUID
+	ContactID extensions.UUID
+}
+
+func (q *Queries) RemoveContact(ctx context.Context, arg RemoveContactParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeContact, arg.UserID, arg.ContactID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const resolveContactRequest = `-- name: ResolveContactRequest :one
+UPDATE contact_requests
+SET
+    status = $1::contact_request_status,
+    updated_at = now()
+WHERE contact_requests.id = $2 AND contact_requests.status = 'PENDING'::contact_request_status
+RETURNING id, sender_id, receiver_id, status, created_at, updated_at
+`
+
+type ResolveContactRequestParams struct {
+	Status ContactRequestStatus
+	ID     extensions.UUID
+}
+
+func (q *Queries) ResolveContactRequest(ctx context.Context, arg ResolveContactRequestParams) (ContactRequest, error) {
+	row := q.db.QueryRow(ctx, resolveContactRequest, arg.Status, arg.ID)
+	var i ContactRequest
+	err := row.Scan(
+		&i.ID,
+		&i.SenderID,
+		&i.ReceiverID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateUserPrivacySettings = `-- name: UpdateUserPrivacySettings :one
+UPDATE users
+SET
+    profile_visibility = coalesce($1, profile_visibility),
+    presence_visibility = coalesce($2, presence_visibility),
+    private_chat_permission = coalesce($3, private_chat_permission),
+    updated_at = now()
+WHERE users.id = $4
+RETURNING id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role, deleted_at, purge_after, profile_visibility, presence_visibility, private_chat_permission, avatar_generated, suspended_until, suspension_reason, session_version
+`
+
+type UpdateUserPrivacySettingsParams struct {
+	ProfileVisibility     NullVisibilityLevel
+	PresenceVisibility    NullVisibilityLevel
+	PrivateChatPermission NullVisibilityLevel
+	ID                    extensions.UUID
+}
+
+func (q *Queries) UpdateUserPrivacySettings(ctx context.Context, arg UpdateUserPrivacySettingsParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserPrivacySettings,
+		arg.ProfileVisibility,
+		arg.PresenceVisibility,
+		arg.PrivateChatPermission,
+		arg.ID,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.Birthday,
+		&i.Gender,
+		&i.Email,
+		&i.Password,
+		&i.AvatarFileName,
+		&i.Online,
+		&i.EmailVerified,
+		&i.LastSeen,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DeletedAt,
+		&i.PurgeAfter,
+		&i.ProfileVisibility,
+		&i.PresenceVisibility,
+		&i.PrivateChatPermission,
+		&i.AvatarGenerated,
+		&i.SuspendedUntil,
+		&i.SuspensionReason,
+		&i.SessionVersion,
+	)
+	return i, err
+}
@@ -0,0 +1,162 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: data_exports_query.sql
+
+package db_queries
+
+import (
+	"context"
+
+	"chat_app_backend/internal/extensions"
+)
+
+const claimPendingDataExports = `-- name: ClaimPendingDataExports :many
+UPDATE data_exports
+SET
+    status = 'PROCESSING'::data_export_status,
+    updated_at = now()
+WHERE data_exports.id IN (
+    SELECT pending.id
+    FROM data_exports AS pending
+    WHERE pending.status = 'PENDING'::data_export_status
+    ORDER BY pending.created_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, user_id, status, file_name, created_at, updated_at
+`
+
+func (q *Queries) ClaimPendingDataExports(ctx context.Context, maxCount int32) ([]DataExport, error) {
+	rows, err := q.db.Query(ctx, claimPendingDataExports, maxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DataExport{}
+	for rows.Next() {
+		var i DataExport
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Status,
+			&i.FileName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const completeDataExport = `-- name: CompleteDataExport :exec
+UPDATE data_exports
+SET
+    status = 'READY'::data_export_status,
+    file_name = $1,
+    updated_at = now()
+WHERE data_exports.id = $2
+`
+
+type CompleteDataExportParams struct {
+	FileName *string
+	ID       extensions.UUID
+}
+
+func (q *Queries) CompleteDataExport(ctx context.Context, arg CompleteDataExportParams) error {
+	_, err := q.db.Exec(ctx, completeDataExport, arg.FileName, arg.ID)
+	return err
+}
+
+const createDataExport = `-- name: CreateDataExport :one
+INSERT INTO data_exports
+(user_id)
+VALUES
+($1)
+RETURNING id, user_id, status, file_name, created_at, updated_at
+`
+
+func (q *Queries) CreateDataExport(ctx context.Context, userID extensions.UUID) (DataExport, error) {
+	row := q.db.QueryRow(ctx, createDataExport, userID)
+	var i DataExport
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.FileName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const failDataExport = `-- name: FailDataExport :exec
+UPDATE data_exports
+SET
+    status = 'FAILED'::data_export_status,
+    updated_at = now()
+WHERE data_exports.id = $1
+`
+
+func (q *Queries) FailDataExport(ctx context.Context, id extensions.UUID) error {
+	_, err := q.db.Exec(ctx, failDataExport, id)
+	return err
+}
+
+const getDataExportById = `-- name: GetDataExportById :one
+SELECT id, user_id, status, file_name, created_at, updated_at
+FROM data_exports
+WHERE data_exports.id = $1
+`
+
+func (q *Queries) GetDataExportById(ctx context.Context, id extensions.UUID) (DataExport, error) {
+	row := q.db.QueryRow(ctx, getDataExportById, id)
+	var i DataExport
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.FileName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getUserDataExports = `-- name: GetUserDataExports :many
+SELECT id, user_id, status, file_name, created_at, updated_at
+FROM data_exports
+WHERE data_exports.user_id = $1
+`
+
+func (q *Queries) GetUserDataExports(ctx context.Context, userID extensions.UUID) ([]DataExport, error) {
+	rows, err := q.db.Query(ctx, getUserDataExports, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DataExport{}
+	for rows.Next() {
+		var i DataExport
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Status,
+			&i.FileName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
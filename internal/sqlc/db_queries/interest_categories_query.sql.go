@@ -0,0 +1,212 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: interest_categories_query.sql
+
+package db_queries
+
+import (
+	"context"
+
+	"chat_app_backend/internal/extensions"
+)
+
+const countInterestSubcategories = `-- name: CountInterestSubcategories :one
+SELECT COUNT(id)
+FROM interest_categories
+WHERE parent_id = $1::uuid
+`
+
+func (q *Queries) CountInterestSubcategories(ctx context.Context, id extensions.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countInterestSubcategories, id)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createInterestCategory = `-- name: CreateInterestCategory :one
+INSERT INTO interest_categories
+(parent_id, title, position)
+VALUES
+($1::uuid, $2, $3)
+RETURNING id, parent_id, title, position, created_at, updated_at
+`
+
+type CreateInterestCategoryParams struct {
+	ParentID *extensions.UUID
+	Title    string
+	Position int32
+}
+
+func (q *Queries) CreateInterestCategory(ctx context.Context, arg CreateInterestCategoryParams) (InterestCategory, error) {
+	row := q.db.QueryRow(ctx, createInterestCategory, arg.ParentID, arg.Title, arg.Position)
+	var i InterestCategory
+	err := row.Scan(
+		&i.ID,
+		&i.ParentID,
+		&i.Title,
+		&i.Position,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteInterestCategory = `-- name: DeleteInterestCategory :exec
+DELETE FROM interest_categories
+WHERE id = $1
+`
+
+func (q *Queries) DeleteInterestCategory(ctx context.Context, id extensions.UUID) error {
+	_, err := q.db.Exec(ctx, deleteInterestCategory, id)
+	return err
+}
+
+const getInterestCategories = `-- name: GetInterestCategories :many
+SELECT id, parent_id, title, position, created_at, updated_at
+FROM interest_categories
+ORDER BY position, title
+`
+
+func (q *Queries) GetInterestCategories(ctx context.Context) ([]InterestCategory, error) {
+	rows, err := q.db.Query(ctx, getInterestCategories)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InterestCategory{}
+	for rows.Next() {
+		var i InterestCategory
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParentID,
+			&i.Title,
+			&i.Position,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInterestCategoryById = `-- name: GetInterestCategoryById :one
+SELECT id, parent_id, title, position, created_at, updated_at
+FROM interest_categories
+WHERE id = $1
+`
+
+func (q *Queries) GetInterestCategoryById(ctx context.Context, id extensions.UUID) (InterestCategory, error) {
+	row := q.db.QueryRow(ctx, getInterestCategoryById, id)
+	var i InterestCategory
+	err := row.Scan(
+		&i.ID,
+		&i.ParentID,
+		&i.Title,
+		&i.Position,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const interestCategoriesExistenceCheck = `-- name: InterestCategoriesExistenceCheck :one
+SELECT COUNT(id)
+FROM interest_categories
+WHERE id = ANY($1::uuid[])
+`
+
+func (q *Queries) InterestCategoriesExistenceCheck(ctx context.Context, ids []extensions.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, interestCategoriesExistenceCheck, ids)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const isInterestCategoryInSubtree = `-- name: IsInterestCategoryInSubtree :one
+WITH RECURSIVE subtree(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = $2
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN subtree ON interest_categories.parent_id = subtree.id
+)
+SELECT (COUNT(*) > 0)::bool AS in_subtree
+FROM subtree
+WHERE id = $1::uuid
+`
+
+type IsInterestCategoryInSubtreeParams struct {
+	ID     extensions.UUID
+	RootID extensions.UUID
+}
+
+func (q *Queries) IsInterestCategoryInSubtree(ctx context.Context, arg IsInterestCategoryInSubtreeParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isInterestCategoryInSubtree, arg.ID, arg.RootID)
+	var in_subtree bool
+	err := row.Scan(&in_subtree)
+	return in_subtree, err
+}
+
+const lockInterestCategories = `-- name: LockInterestCategories :exec
+SELECT id
+FROM interest_categories
+ORDER BY id
+FOR UPDATE
+`
+
+// the moves lock every category, otherwise the subtree checks of two concurrent moves could both pass and
+// the moves would form a cycle together
+func (q *Queries) LockInterestCategories(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockInterestCategories)
+	return err
+}
+
+const updateInterestCategory = `-- name: UpdateInterestCategory :one
+UPDATE interest_categories
+SET
+    title = COALESCE($1::varchar(255), title),
+    parent_id = CASE
+        WHEN $2::bool THEN NULL
+        ELSE COALESCE($3::uuid, parent_id)
+    END,
+    position = COALESCE($4::int, position),
+    updated_at = now()
+WHERE id = $5
+RETURNING id, parent_id, title, position, created_at, updated_at
+`
+
+type UpdateInterestCategoryParams struct {
+	Title       *string
+	ClearParent bool
+	ParentID    *extensions.UUID
+	Position    *int32
+	ID          extensions.UUID
+}
+
+func (q *Queries) UpdateInterestCategory(ctx context.Context, arg UpdateInterestCategoryParams) (InterestCategory, error) {
+	row := q.db.QueryRow(ctx, updateInterestCategory,
+		arg.Title,
+		arg.ClearParent,
+		arg.ParentID,
+		arg.Position,
+		arg.ID,
+	)
+	var i InterestCategory
+	err := row.Scan(
+		&i.ID,
+		&i.ParentID,
+		&i.Title,
+		&i.Position,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
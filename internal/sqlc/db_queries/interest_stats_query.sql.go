@@ -0,0 +1,137 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: interest_stats_query.sql
+
+package db_queries
+
+import (
+	"context"
+	"time"
+
+	"chat_app_backend/internal/extensions"
+)
+
+const getInterestStats = `-- name: GetInterestStats :many
+SELECT
+    interests.id,
+    interests.title,
+    COALESCE(interest_stats.users_count, 0)::bigint AS users_count,
+    COALESCE(window_stats.added, 0)::bigint AS added,
+    COALESCE(window_stats.removed, 0)::bigint AS removed
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+LEFT JOIN (
+    SELECT
+        interest_daily_stats.interest_id,
+        SUM(interest_daily_stats.added) AS added,
+        SUM(interest_daily_stats.removed) AS removed
+    FROM interest_daily_stats
+    WHERE interest_daily_stats.day >= $1::date
+    GROUP BY interest_daily_stats.interest_id
+) AS window_stats ON window_stats.interest_id = interests.id
+ORDER BY users_count DESC, interests.title, interests.id
+LIMIT $3::int OFFSET $2
+`
+
+type GetInterestStatsParams struct {
+	WindowStart time.Time
+	SkipCount   int32
+	MaxCount    *int32
+}
+
+type GetInterestStatsRow struct {
+	ID         extensions.UUID
+	Title      string
+	UsersCount int64
+	Added      int64
+	Removed    int64
+}
+
+func (q *Queries) GetInterestStats(ctx context.Context, arg GetInterestStatsParams) ([]GetInterestStatsRow, error) {
+	rows, err := q.db.Query(ctx, getInterestStats, arg.WindowStart, arg.SkipCount, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetInterestStatsRow{}
+	for rows.Next() {
+		var i GetInterestStatsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.UsersCount,
+			&i.Added,
+			&i.Removed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTrendingInterests = `-- name: GetTrendingInterests :many
+SELECT
+    interests.id,
+    interests.title,
+    COALESCE(interest_stats.users_count, 0)::bigint AS users_count,
+    window_stats.added::bigint AS added,
+    window_stats.removed::bigint AS removed
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+JOIN (
+    SELECT
+        interest_daily_stats.interest_id,
+        SUM(interest_daily_stats.added) AS added,
+        SUM(interest_daily_stats.removed) AS removed
+    FROM interest_daily_stats
+    WHERE interest_daily_stats.day >= $1::date
+    GROUP BY interest_daily_stats.interest_id
+) AS window_stats ON window_stats.interest_id = interests.id
+WHERE window_stats.added > window_stats.removed
+ORDER BY window_stats.added - window_stats.removed DESC, users_count DESC, interests.id
+LIMIT $2
+`
+
+type GetTrendingInterestsParams struct {
+	WindowStart time.Time
+	MaxCount    int32
+}
+
+type GetTrendingInterestsRow struct {
+	ID         extensions.UUID
+	Title      string
+	UsersCount int64
+	Added      int64
+	Removed    int64
+}
+
+func (q *Queries) GetTrendingInterests(ctx context.Context, arg GetTrendingInterestsParams) ([]GetTrendingInterestsRow, error) {
+	rows, err := q.db.Query(ctx, getTrendingInterests, arg.WindowStart, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTrendingInterestsRow{}
+	for rows.Next() {
+		var i GetTrendingInterestsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.UsersCount,
+			&i.Added,
+			&i.Removed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
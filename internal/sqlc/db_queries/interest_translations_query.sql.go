@@ -0,0 +1,134 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: interest_translations_query.sql
+
+package db_queries
+
+import (
+	"context"
+
+	"chat_app_backend/internal/extensions"
+)
+
+const deleteInterestTranslation = `-- name: DeleteInterestTranslation :one
+DELETE FROM interest_translations
+WHERE interest_id = $1 AND locale = $2
+RETURNING interest_id, locale, title, description, created_at, updated_at
+`
+
+type DeleteInterestTranslationParams struct {
+	InterestID extensions.UUID
+	Locale     string
+}
+
+func (q *Queries) DeleteInterestTranslation(ctx context.Context, arg DeleteInterestTranslationParams) (InterestTranslation, error) {
+	row := q.db.QueryRow(ctx, deleteInterestTranslation, arg.InterestID, arg.Locale)
+	var i InterestTranslation
+	err := row.Scan(
+		&i.InterestID,
+		&i.Locale,
+		&i.Title,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getInterestTranslation = `-- name: GetInterestTranslation :one
+SELECT interest_id, locale, title, description, created_at, updated_at
+FROM interest_translations
+WHERE interest_id = $1 AND locale = $2
+`
+
+type GetInterestTranslationParams struct {
+	InterestID extensions.UUID
+	Locale     string
+}
+
+func (q *Queries) GetInterestTranslation(ctx context.Context, arg GetInterestTranslationParams) (InterestTranslation, error) {
+	row := q.db.QueryRow(ctx, getInterestTranslation, arg.InterestID, arg.Locale)
+	var i InterestTranslation
+	err := row.Scan(
+		&i.InterestID,
+		&i.Locale,
+		&i.Title,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getInterestTranslations = `-- name: GetInterestTranslations :many
+SELECT interest_id, locale, title, description, created_at, updated_at
+FROM interest_translations
+WHERE interest_id = ANY($1::uuid[])
+ORDER BY interest_id, locale
+`
+
+func (q *Queries) GetInterestTranslations(ctx context.Context, interestIds []extensions.UUID) ([]InterestTranslation, error) {
+	rows, err := q.db.Query(ctx, getInterestTranslations, interestIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InterestTranslation{}
+	for rows.Next() {
+		var i InterestTranslation
+		if err := rows.Scan(
+			&i.InterestID,
+			&i.Locale,
+			&i.Title,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertInterestTranslation = `-- name: UpsertInterestTranslation :one
+INSERT INTO interest_translations
+(interest_id, locale, title, description)
+VALUES
+($1, $2, $3, $4)
+ON CONFLICT (interest_id, locale) DO UPDATE
+    SET title = EXCLUDED.title,
+        description = EXCLUDED.description,
+        updated_at = now()
+RETURNING interest_id, locale, title, description, created_at, updated_at
+`
+
+type UpsertInterestTranslationParams struct {
+	InterestID  extensions.UUID
+	Locale      string
+	Title       string
+	Description string
+}
+
+func (q *Queries) UpsertInterestTranslation(ctx context.Context, arg UpsertInterestTranslationParams) (InterestTranslation, error) {
+	row := q.db.QueryRow(ctx, upsertInterestTranslation,
+		arg.InterestID,
+		arg.Locale,
+		arg.Title,
+		arg.Description,
+	)
+	var i InterestTranslation
+	err := row.Scan(
+		&i.InterestID,
+		&i.Locale,
+		&i.Title,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -7,15 +7,34 @@ package db_queries
 
 import (
 	"context"
+	"time"
 
 	"chat_app_backend/internal/extensions"
 )
 
+const addUserInterests = `-- name: AddUserInterests :exec
+INSERT INTO user_interests
+(user_id, interest_id)
+SELECT $1::uuid, unnest($2::uuid[])
+ON CONFLICT DO NOTHING
+`
+
+type AddUserInterestsParams struct {
+	UserID      extensions.UUID
+	InterestIds []extensions.UUID
+}
+
+func (q *Queries) AddUserInterests(ctx context.Context, arg AddUserInterestsParams) error {
+	_, err := q.db.Exec(ctx, addUserInterests, arg.UserID, arg.InterestIds)
+	return err
+}
+
 const assignInterestsToUser = `-- name: AssignInterestsToUser :exec
 INSERT INTO user_interests
 (user_id, interest_id)
 VALUES
 ($1, unnest($2::uuid[]))
+ON CONFLICT DO NOTHING
 `
 
 type AssignInterestsToUserParams struct {
@@ -28,22 +47,45 @@ func (q *Queries) AssignInterestsToUser(ctx context.Context, arg AssignInterests
 	return err
 }
 
+const countUserInterests = `-- name: CountUserInterests :one
+SELECT COUNT(*)
+FROM user_interests
+WHERE user_id = $1
+`
+
+func (q *Queries) CountUserInterests(ctx context.Context, userID extensions.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserInterests, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createInterest = `-- name: CreateInterest :one
 INSERT INTO interests
-(title, icon_file_name, description)
+(title, icon_file_name, icon_thumbnail_sizes, description, category_id, position)
 VALUES
-($1, $2, $3)
-RETURNING id, title, icon_file_name, created_at, updated_at, description
+($1, $2, $3::int[], $4, $5::uuid, $6)
+RETURNING id, title, icon_file_name, created_at, updated_at, description, category_id, position, icon_thumbnail_sizes
 `
 
 type CreateInterestParams struct {
-	Title        string
-	IconFileName string
-	Description  string
+	Title              string
+	IconFileName       string
+	IconThumbnailSizes []int32
+	Description        string
+	CategoryID         *extensions.UUID
+	Position           int32
 }
 
 func (q *Queries) CreateInterest(ctx context.Context, arg CreateInterestParams) (Interest, error) {
-	row := q.db.QueryRow(ctx, createInterest, arg.Title, arg.IconFileName, arg.Description)
+	row := q.db.QueryRow(ctx, createInterest,
+		arg.Title,
+		arg.IconFileName,
+		arg.IconThumbnailSizes,
+		arg.Description,
+		arg.CategoryID,
+		arg.Position,
+	)
 	var i Interest
 	err := row.Scan(
 		&i.ID,
@@ -52,18 +94,32 @@ func (q *Queries) CreateInterest(ctx context.Context, arg CreateInterestParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Description,
+		&i.CategoryID,
+		&i.Position,
+		&i.IconThumbnailSizes,
 	)
 	return i, err
 }
 
-const deleteInterest = `-- name: DeleteInterest :exec
+const deleteInterest = `-- name: DeleteInterest :execrows
 DELETE FROM interests
-WHERE interests.id = $1
+WHERE
+    interests.id = $1
+  AND
+    ($2::timestamptz[] IS NULL OR interests.updated_at = ANY($2::timestamptz[]))
 `
 
-func (q *Queries) DeleteInterest(ctx context.Context, id extensions.UUID) error {
-	_, err := q.db.Exec(ctx, deleteInterest, id)
-	return err
+type DeleteInterestParams struct {
+	ID               extensions.UUID
+	ExpectedVersions []time.Time
+}
+
+func (q *Queries) DeleteInterest(ctx context.Context, arg DeleteInterestParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteInterest, arg.ID, arg.ExpectedVersions)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const existenceCheck = `-- name: ExistenceCheck :one
@@ -79,8 +135,44 @@ func (q *Queries) ExistenceCheck(ctx context.Context, ids []extensions.UUID) (in
 	return count, err
 }
 
+const getAllInterests = `-- name: GetAllInterests :many
+SELECT id, title, icon_file_name, created_at, updated_at, description, category_id, position, icon_thumbnail_sizes
+FROM interests
+ORDER BY title, id
+`
+
+func (q *Queries) GetAllInterests(ctx context.Context) ([]Interest, error) {
+	rows, err := q.db.Query(ctx, getAllInterests)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Interest{}
+	for rows.Next() {
+		var i Interest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.IconFileName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Description,
+			&i.CategoryID,
+			&i.Position,
+			&i.IconThumbnailSizes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getInterestById = `-- name: GetInterestById :one
-SELECT id, title, icon_file_name, created_at, updated_at, description
+SELECT id, title, icon_file_name, created_at, updated_at, description, category_id, position, icon_thumbnail_sizes
 FROM interests
 WHERE id = $1
 `
@@ -95,26 +187,188 @@ func (q *Queries) GetInterestById(ctx context.Context, id extensions.UUID) (Inte
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Description,
+		&i.CategoryID,
+		&i.Position,
+		&i.IconThumbnailSizes,
 	)
 	return i, err
 }
 
-const getManyInterestsByFilters = `-- name: GetManyInterestsByFilters :many
-SELECT id, title, icon_file_name, created_at, updated_at, description
+const getInterestsByCreatedAt = `-- name: GetInterestsByCreatedAt :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = $3::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE $7::bool
+)
+SELECT interests.id, interests.title, interests.icon_file_name, interests.created_at, interests.updated_at, interests.description, interests.category_id, interests.position, interests.icon_thumbnail_sizes, COALESCE(interest_stats.users_count, 0)::bigint AS popularity
 FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
 WHERE
     ($1::uuid[] IS NULL OR interests.id = ANY($1::uuid[]))
   AND
-    ($2::text IS NULL OR interests.title ILIKE $2::text || '%')
+    (
+        $2::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', $2::text)
+    )
+  AND
+    ($3::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    ($4::uuid IS NULL OR (interests.created_at, interests.id) > ($5::timestamptz, $4::uuid))
+ORDER BY interests.created_at, interests.id
+LIMIT $6
 `
 
-type GetManyInterestsByFiltersParams struct {
-	Ids  []extensions.UUID
-	Name *string
+type GetInterestsByCreatedAtParams struct {
+	Ids                  []extensions.UUID
+	Search               *string
+	CategoryID           *extensions.UUID
+	AfterID              *extensions.UUID
+	AfterCreatedAt       *time.Time
+	MaxCount             int32
+	IncludeSubcategories bool
+}
+
+type GetInterestsByCreatedAtRow struct {
+	Interest   Interest
+	Popularity int64
 }
 
-func (q *Queries) GetManyInterestsByFilters(ctx context.Context, arg GetManyInterestsByFiltersParams) ([]Interest, error) {
-	rows, err := q.db.Query(ctx, getManyInterestsByFilters, arg.Ids, arg.Name)
+func (q *Queries) GetInterestsByCreatedAt(ctx context.Context, arg GetInterestsByCreatedAtParams) ([]GetInterestsByCreatedAtRow, error) {
+	rows, err := q.db.Query(ctx, getInterestsByCreatedAt,
+		arg.Ids,
+		arg.Search,
+		arg.CategoryID,
+		arg.AfterID,
+		arg.AfterCreatedAt,
+		arg.MaxCount,
+		arg.IncludeSubcategories,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetInterestsByCreatedAtRow{}
+	for rows.Next() {
+		var i GetInterestsByCreatedAtRow
+		if err := rows.Scan(
+			&i.Interest.ID,
+			&i.Interest.Title,
+			&i.Interest.IconFileName,
+			&i.Interest.CreatedAt,
+			&i.Interest.UpdatedAt,
+			&i.Interest.Description,
+			&i.Interest.CategoryID,
+			&i.Interest.Position,
+			&i.Interest.IconThumbnailSizes,
+			&i.Popularity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInterestsByCreatedAtDesc = `-- name: GetInterestsByCreatedAtDesc :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = $3::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE $7::bool
+)
+SELECT interests.id, interests.title, interests.icon_file_name, interests.created_at, interests.updated_at, interests.description, interests.category_id, interests.position, interests.icon_thumbnail_sizes, COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    ($1::uuid[] IS NULL OR interests.id = ANY($1::uuid[]))
+  AND
+    (
+        $2::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', $2::text)
+    )
+  AND
+    ($3::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    ($4::uuid IS NULL OR (interests.created_at, interests.id) < ($5::timestamptz, $4::uuid))
+ORDER BY interests.created_at DESC, interests.id DESC
+LIMIT $6
+`
+
+type GetInterestsByCreatedAtDescParams struct {
+	Ids                  []extensions.UUID
+	Search               *string
+	CategoryID           *extensions.UUID
+	AfterID              *extensions.UUID
+	AfterCreatedAt       *time.Time
+	MaxCount             int32
+	IncludeSubcategories bool
+}
+
+type GetInterestsByCreatedAtDescRow struct {
+	Interest   Interest
+	Popularity int64
+}
+
+func (q *Queries) GetInterestsByCreatedAtDesc(ctx context.Context, arg GetInterestsByCreatedAtDescParams) ([]GetInterestsByCreatedAtDescRow, error) {
+	rows, err := q.db.Query(ctx, getInterestsByCreatedAtDesc,
+		arg.Ids,
+		arg.Search,
+		arg.CategoryID,
+		arg.AfterID,
+		arg.AfterCreatedAt,
+		arg.MaxCount,
+		arg.IncludeSubcategories,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetInterestsByCreatedAtDescRow{}
+	for rows.Next() {
+		var i GetInterestsByCreatedAtDescRow
+		if err := rows.Scan(
+			&i.Interest.ID,
+			&i.Interest.Title,
+			&i.Interest.IconFileName,
+			&i.Interest.CreatedAt,
+			&i.Interest.UpdatedAt,
+			&i.Interest.Description,
+			&i.Interest.CategoryID,
+			&i.Interest.Position,
+			&i.Interest.IconThumbnailSizes,
+			&i.Popularity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInterestsByIds = `-- name: GetInterestsByIds :many
+SELECT id, title, icon_file_name, created_at, updated_at, description, category_id, position, icon_thumbnail_sizes
+FROM interests
+WHERE id = ANY($1::uuid[])
+ORDER BY position, title
+`
+
+func (q *Queries) GetInterestsByIds(ctx context.Context, ids []extensions.UUID) ([]Interest, error) {
+	rows, err := q.db.Query(ctx, getInterestsByIds, ids)
 	if err != nil {
 		return nil, err
 	}
@@ -129,6 +383,550 @@ func (q *Queries) GetManyInterestsByFilters(ctx context.Context, arg GetManyInte
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Description,
+			&i.CategoryID,
+			&i.Position,
+			&i.IconThumbnailSizes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInterestsByPopularity = `-- name: GetInterestsByPopularity :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = $3::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE $7::bool
+)
+SELECT interests.id, interests.title, interests.icon_file_name, interests.created_at, interests.updated_at, interests.description, interests.category_id, interests.position, interests.icon_thumbnail_sizes, COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    ($1::uuid[] IS NULL OR interests.id = ANY($1::uuid[]))
+  AND
+    (
+        $2::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', $2::text)
+    )
+  AND
+    ($3::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    ($4::uuid IS NULL OR (COALESCE(interest_stats.users_count, 0), interests.id) > ($5::bigint, $4::uuid))
+ORDER BY popularity, interests.id
+LIMIT $6
+`
+
+type GetInterestsByPopularityParams struct {
+	Ids                  []extensions.UUID
+	Search               *string
+	CategoryID           *extensions.UUID
+	AfterID              *extensions.UUID
+	AfterPopularity      *int64
+	MaxCount             int32
+	IncludeSubcategories bool
+}
+
+type GetInterestsByPopularityRow struct {
+	Interest   Interest
+	Popularity int64
+}
+
+func (q *Queries) GetInterestsByPopularity(ctx context.Context, arg GetInterestsByPopularityParams) ([]GetInterestsByPopularityRow, error) {
+	rows, err := q.db.Query(ctx, getInterestsByPopularity,
+		arg.Ids,
+		arg.Search,
+		arg.CategoryID,
+		arg.AfterID,
+		arg.AfterPopularity,
+		arg.MaxCount,
+		arg.IncludeSubcategories,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetInterestsByPopularityRow{}
+	for rows.Next() {
+		var i GetInterestsByPopularityRow
+		if err := rows.Scan(
+			&i.Interest.ID,
+			&i.Interest.Title,
+			&i.Interest.IconFileName,
+			&i.Interest.CreatedAt,
+			&i.Interest.UpdatedAt,
+			&i.Interest.Description,
+			&i.Interest.CategoryID,
+			&i.Interest.Position,
+			&i.Interest.IconThumbnailSizes,
+			&i.Popularity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInterestsByPopularityDesc = `-- name: GetInterestsByPopularityDesc :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = $3::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE $7::bool
+)
+SELECT interests.id, interests.title, interests.icon_file_name, interests.created_at, interests.updated_at, interests.description, interests.category_id, interests.position, interests.icon_thumbnail_sizes, COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    ($1::uuid[] IS NULL OR interests.id = ANY($1::uuid[]))
+  AND
+    (
+        $2::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', $2::text)
+    )
+  AND
+    ($3::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    ($4::uuid IS NULL OR (COALESCE(interest_stats.users_count, 0), interests.id) < ($5::bigint, $4::uuid))
+ORDER BY popularity DESC, interests.id DESC
+LIMIT $6
+`
+
+type GetInterestsByPopularityDescParams struct {
+	Ids                  []extensions.UUID
+	Search               *string
+	CategoryID           *extensions.UUID
+	AfterID              *extensions.UUID
+	AfterPopularity      *int64
+	MaxCount             int32
+	IncludeSubcategories bool
+}
+
+type GetInterestsByPopularityDescRow struct {
+	Interest   Interest
+	Popularity int64
+}
+
+func (q *Queries) GetInterestsByPopularityDesc(ctx context.Context, arg GetInterestsByPopularityDescParams) ([]GetInterestsByPopularityDescRow, error) {
+	rows, err := q.db.Query(ctx, getInterestsByPopularityDesc,
+		arg.Ids,
+		arg.Search,
+		arg.CategoryID,
+		arg.AfterID,
+		arg.AfterPopularity,
+		arg.MaxCount,
+		arg.IncludeSubcategories,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetInterestsByPopularityDescRow{}
+	for rows.Next() {
+		var i GetInterestsByPopularityDescRow
+		if err := rows.Scan(
+			&i.Interest.ID,
+			&i.Interest.Title,
+			&i.Interest.IconFileName,
+			&i.Interest.CreatedAt,
+			&i.Interest.UpdatedAt,
+			&i.Interest.Description,
+			&i.Interest.CategoryID,
+			&i.Interest.Position,
+			&i.Interest.IconThumbnailSizes,
+			&i.Popularity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInterestsByPosition = `-- name: GetInterestsByPosition :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = $3::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE $8::bool
+)
+SELECT interests.id, interests.title, interests.icon_file_name, interests.created_at, interests.updated_at, interests.description, interests.category_id, interests.position, interests.icon_thumbnail_sizes, COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    ($1::uuid[] IS NULL OR interests.id = ANY($1::uuid[]))
+  AND
+    (
+        $2::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', $2::text)
+    )
+  AND
+    ($3::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    ($4::uuid IS NULL OR (interests.position, interests.title, interests.id) > ($5::int, $6::text, $4::uuid))
+ORDER BY interests.position, interests.title, interests.id
+LIMIT $7
+`
+
+type GetInterestsByPositionParams struct {
+	Ids                  []extensions.UUID
+	Search               *string
+	CategoryID           *extensions.UUID
+	AfterID              *extensions.UUID
+	AfterPosition        *int32
+	AfterTitle           *string
+	MaxCount             int32
+	IncludeSubcategories bool
+}
+
+type GetInterestsByPositionRow struct {
+	Interest   Interest
+	Popularity int64
+}
+
+func (q *Queries) GetInterestsByPosition(ctx context.Context, arg GetInterestsByPositionParams) ([]GetInterestsByPositionRow, error) {
+	rows, err := q.db.Query(ctx, getInterestsByPosition,
+		arg.Ids,
+		arg.Search,
+		arg.CategoryID,
+		arg.AfterID,
+		arg.AfterPosition,
+		arg.AfterTitle,
+		arg.MaxCount,
+		arg.IncludeSubcategories,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetInterestsByPositionRow{}
+	for rows.Next() {
+		var i GetInterestsByPositionRow
+		if err := rows.Scan(
+			&i.Interest.ID,
+			&i.Interest.Title,
+			&i.Interest.IconFileName,
+			&i.Interest.CreatedAt,
+			&i.Interest.UpdatedAt,
+			&i.Interest.Description,
+			&i.Interest.CategoryID,
+			&i.Interest.Position,
+			&i.Interest.IconThumbnailSizes,
+			&i.Popularity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInterestsByPositionDesc = `-- name: GetInterestsByPositionDesc :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = $3::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE $8::bool
+)
+SELECT interests.id, interests.title, interests.icon_file_name, interests.created_at, interests.updated_at, interests.description, interests.category_id, interests.position, interests.icon_thumbnail_sizes, COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    ($1::uuid[] IS NULL OR interests.id = ANY($1::uuid[]))
+  AND
+    (
+        $2::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', $2::text)
+    )
+  AND
+    ($3::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    ($4::uuid IS NULL OR (interests.position, interests.title, interests.id) < ($5::int, $6::text, $4::uuid))
+ORDER BY interests.position DESC, interests.title DESC, interests.id DESC
+LIMIT $7
+`
+
+type GetInterestsByPositionDescParams struct {
+	Ids                  []extensions.UUID
+	Search               *string
+	CategoryID           *extensions.UUID
+	AfterID              *extensions.UUID
+	AfterPosition        *int32
+	AfterTitle           *string
+	MaxCount             int32
+	IncludeSubcategories bool
+}
+
+type GetInterestsByPositionDescRow struct {
+	Interest   Interest
+	Popularity int64
+}
+
+func (q *Queries) GetInterestsByPositionDesc(ctx context.Context, arg GetInterestsByPositionDescParams) ([]GetInterestsByPositionDescRow, error) {
+	rows, err := q.db.Query(ctx, getInterestsByPositionDesc,
+		arg.Ids,
+		arg.Search,
+		arg.CategoryID,
+		arg.AfterID,
+		arg.AfterPosition,
+		arg.AfterTitle,
+		arg.MaxCount,
+		arg.IncludeSubcategories,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetInterestsByPositionDescRow{}
+	for rows.Next() {
+		var i GetInterestsByPositionDescRow
+		if err := rows.Scan(
+			&i.Interest.ID,
+			&i.Interest.Title,
+			&i.Interest.IconFileName,
+			&i.Interest.CreatedAt,
+			&i.Interest.UpdatedAt,
+			&i.Interest.Description,
+			&i.Interest.CategoryID,
+			&i.Interest.Position,
+			&i.Interest.IconThumbnailSizes,
+			&i.Popularity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInterestsByTitle = `-- name: GetInterestsByTitle :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = $3::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE $7::bool
+)
+SELECT interests.id, interests.title, interests.icon_file_name, interests.created_at, interests.updated_at, interests.description, interests.category_id, interests.position, interests.icon_thumbnail_sizes, COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    ($1::uuid[] IS NULL OR interests.id = ANY($1::uuid[]))
+  AND
+    (
+        $2::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', $2::text)
+    )
+  AND
+    ($3::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    ($4::uuid IS NULL OR (interests.title, interests.id) > ($5::text, $4::uuid))
+ORDER BY interests.title, interests.id
+LIMIT $6
+`
+
+type GetInterestsByTitleParams struct {
+	Ids                  []extensions.UUID
+	Search               *string
+	CategoryID           *extensions.UUID
+	AfterID              *extensions.UUID
+	AfterTitle           *string
+	MaxCount             int32
+	IncludeSubcategories bool
+}
+
+type GetInterestsByTitleRow struct {
+	Interest   Interest
+	Popularity int64
+}
+
+// the listing has a query per sort, so the order is static and the planner can read the pages from the
+// indexes instead of sorting every matching interest
+func (q *Queries) GetInterestsByTitle(ctx context.Context, arg GetInterestsByTitleParams) ([]GetInterestsByTitleRow, error) {
+	rows, err := q.db.Query(ctx, getInterestsByTitle,
+		arg.Ids,
+		arg.Search,
+		arg.CategoryID,
+		arg.AfterID,
+		arg.AfterTitle,
+		arg.MaxCount,
+		arg.IncludeSubcategories,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetInterestsByTitleRow{}
+	for rows.Next() {
+		var i GetInterestsByTitleRow
+		if err := rows.Scan(
+			&i.Interest.ID,
+			&i.Interest.Title,
+			&i.Interest.IconFileName,
+			&i.Interest.CreatedAt,
+			&i.Interest.UpdatedAt,
+			&i.Interest.Description,
+			&i.Interest.CategoryID,
+			&i.Interest.Position,
+			&i.Interest.IconThumbnailSizes,
+			&i.Popularity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInterestsByTitleDesc = `-- name: GetInterestsByTitleDesc :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = $3::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE $7::bool
+)
+SELECT interests.id, interests.title, interests.icon_file_name, interests.created_at, interests.updated_at, interests.description, interests.category_id, interests.position, interests.icon_thumbnail_sizes, COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    ($1::uuid[] IS NULL OR interests.id = ANY($1::uuid[]))
+  AND
+    (
+        $2::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', $2::text)
+    )
+  AND
+    ($3::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    ($4::uuid IS NULL OR (interests.title, interests.id) < ($5::text, $4::uuid))
+ORDER BY interests.title DESC, interests.id DESC
+LIMIT $6
+`
+
+type GetInterestsByTitleDescParams struct {
+	Ids                  []extensions.UUID
+	Search               *string
+	CategoryID           *extensions.UUID
+	AfterID              *extensions.UUID
+	AfterTitle           *string
+	MaxCount             int32
+	IncludeSubcategories bool
+}
+
+type GetInterestsByTitleDescRow struct {
+	Interest   Interest
+	Popularity int64
+}
+
+func (q *Queries) GetInterestsByTitleDesc(ctx context.Context, arg GetInterestsByTitleDescParams) ([]GetInterestsByTitleDescRow, error) {
+	rows, err := q.db.Query(ctx, getInterestsByTitleDesc,
+		arg.Ids,
+		arg.Search,
+		arg.CategoryID,
+		arg.AfterID,
+		arg.AfterTitle,
+		arg.MaxCount,
+		arg.IncludeSubcategories,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetInterestsByTitleDescRow{}
+	for rows.Next() {
+		var i GetInterestsByTitleDescRow
+		if err := rows.Scan(
+			&i.Interest.ID,
+			&i.Interest.Title,
+			&i.Interest.IconFileName,
+			&i.Interest.CreatedAt,
+			&i.Interest.UpdatedAt,
+			&i.Interest.Description,
+			&i.Interest.CategoryID,
+			&i.Interest.Position,
+			&i.Interest.IconThumbnailSizes,
+			&i.Popularity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInterestsByTitles = `-- name: GetInterestsByTitles :many
+SELECT id, title, icon_file_name, created_at, updated_at, description, category_id, position, icon_thumbnail_sizes
+FROM interests
+WHERE title = ANY($1::text[])
+ORDER BY created_at, id
+`
+
+func (q *Queries) GetInterestsByTitles(ctx context.Context, titles []string) ([]Interest, error) {
+	rows, err := q.db.Query(ctx, getInterestsByTitles, titles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Interest{}
+	for rows.Next() {
+		var i Interest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.IconFileName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Description,
+			&i.CategoryID,
+			&i.Position,
+			&i.IconThumbnailSizes,
 		); err != nil {
 			return nil, err
 		}
@@ -141,16 +939,11 @@ func (q *Queries) GetManyInterestsByFilters(ctx context.Context, arg GetManyInte
 }
 
 const getUserInterests = `-- name: GetUserInterests :many
-SELECT 
-    interests.id,
-    interests.title,
-    interests.icon_file_name,
-    interests.created_at,
-    interests.updated_at,
-    interests.description
+SELECT interests.id, interests.title, interests.icon_file_name, interests.created_at, interests.updated_at, interests.description, interests.category_id, interests.position, interests.icon_thumbnail_sizes
 FROM interests
 JOIN user_interests on interests.id = user_interests.interest_id
 WHERE user_interests.user_id = $1
+ORDER BY interests.position, interests.title, interests.id
 `
 
 func (q *Queries) GetUserInterests(ctx context.Context, id extensions.UUID) ([]Interest, error) {
@@ -169,6 +962,9 @@ func (q *Queries) GetUserInterests(ctx context.Context, id extensions.UUID) ([]I
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Description,
+			&i.CategoryID,
+			&i.Position,
+			&i.IconThumbnailSizes,
 		); err != nil {
 			return nil, err
 		}
@@ -180,6 +976,36 @@ func (q *Queries) GetUserInterests(ctx context.Context, id extensions.UUID) ([]I
 	return items, nil
 }
 
+const lockUserInterests = `-- name: LockUserInterests :exec
+UPDATE users
+SET updated_at = now()
+WHERE id = $1
+`
+
+// the changes of the interests of a user are serialized by the lock of the user row, the key isn't
+// locked, so it doesn't block the inserts referencing the user. The interests are a part of the user,
+// so the row is touched to change its version as well
+func (q *Queries) LockUserInterests(ctx context.Context, userID extensions.UUID) error {
+	_, err := q.db.Exec(ctx, lockUserInterests, userID)
+	return err
+}
+
+const removeSelectedUserInterests = `-- name: RemoveSelectedUserInterests :exec
+DELETE FROM user_interests
+WHERE
+    user_id = $1 AND interest_id = ANY($2::uuid[])
+`
+
+type RemoveSelectedUserInterestsParams struct {
+	UserID      extensions.UUID
+	InterestIds []extensions.UUID
+}
+
+func (q *Queries) RemoveSelectedUserInterests(ctx context.Context, arg RemoveSelectedUserInterestsParams) error {
+	_, err := q.db.Exec(ctx, removeSelectedUserInterests, arg.UserID, arg.InterestIds)
+	return err
+}
+
 const removeUserInterests = `-- name: RemoveUserInterests :exec
 DELETE FROM user_interests
 WHERE
@@ -194,21 +1020,44 @@ func (q *Queries) RemoveUserInterests(ctx context.Context, userID extensions.UUI
 const updateInterest = `-- name: UpdateInterest :one
 UPDATE interests
 SET
-    description = $1::text,
-    icon_file_name = $2::varchar(255)
+    description = COALESCE($1::text, description),
+    icon_file_name = $2::varchar(255),
+    icon_thumbnail_sizes = COALESCE($3::int[], icon_thumbnail_sizes),
+    category_id = CASE
+        WHEN $4::bool THEN NULL
+        ELSE COALESCE($5::uuid, category_id)
+    END,
+    position = COALESCE($6::int, position),
+    updated_at = now()
 WHERE
-    id = $3
-RETURNING id, title, icon_file_name, created_at, updated_at, description
+    id = $7
+  AND
+    ($8::timestamptz[] IS NULL OR updated_at = ANY($8::timestamptz[]))
+RETURNING id, title, icon_file_name, created_at, updated_at, description, category_id, position, icon_thumbnail_sizes
 `
 
 type UpdateInterestParams struct {
-	Description  *string
-	IconFileName *string
-	ID           extensions.UUID
+	Description        *string
+	IconFileName       *string
+	IconThumbnailSizes []int32
+	ClearCategory      bool
+	CategoryID         *extensions.UUID
+	Position           *int32
+	ID                 extensions.UUID
+	ExpectedVersions   []time.Time
 }
 
 func (q *Queries) UpdateInterest(ctx context.Context, arg UpdateInterestParams) (Interest, error) {
-	row := q.db.QueryRow(ctx, updateInterest, arg.Description, arg.IconFileName, arg.ID)
+	row := q.db.QueryRow(ctx, updateInterest,
+		arg.Description,
+		arg.IconFileName,
+		arg.IconThumbnailSizes,
+		arg.ClearCategory,
+		arg.CategoryID,
+		arg.Position,
+		arg.ID,
+		arg.ExpectedVersions,
+	)
 	var i Interest
 	err := row.Scan(
 		&i.ID,
@@ -217,6 +1066,9 @@ func (q *Queries) UpdateInterest(ctx context.Context, arg UpdateInterestParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Description,
+		&i.CategoryID,
+		&i.Position,
+		&i.IconThumbnailSizes,
 	)
 	return i, err
 }
@@ -12,6 +12,50 @@ import (
 	"chat_app_backend/internal/extensions"
 )
 
+type AuditActorType string
+
+const (
+	AuditActorTypeUSER           AuditActorType = "USER"
+	AuditActorTypeSERVICEACCOUNT AuditActorType = "SERVICE_ACCOUNT"
+	AuditActorTypeSYSTEM         AuditActorType = "SYSTEM"
+	AuditActorTypeANONYMOUS      AuditActorType = "ANONYMOUS"
+)
+
+func (e *AuditActorType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AuditActorType(s)
+	case string:
+		*e = AuditActorType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AuditActorType: %T", src)
+	}
+	return nil
+}
+
+type NullAuditActorType struct {
+	AuditActorType AuditActorType
+	Valid          bool // Valid is true if AuditActorType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAuditActorType) Scan(value interface{}) error {
+	if value == nil {
+		ns.AuditActorType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AuditActorType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAuditActorType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AuditActorType), nil
+}
+
 type ChatType string
 
 const (
@@ -54,6 +98,94 @@ func (ns NullChatType) Value() (driver.Value, error) {
 	return string(ns.ChatType), nil
 }
 
+type ContactRequestStatus string
+
+const (
+	ContactRequestStatusPENDING   ContactRequestStatus = "PENDING"
+	ContactRequestStatusACCEPTED  ContactRequestStatus = "ACCEPTED"
+	ContactRequestStatusDECLINED  ContactRequestStatus = "DECLINED"
+	ContactRequestStatusCANCELLED ContactRequestStatus = "CANCELLED"
+)
+
+func (e *ContactRequestStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ContactRequestStatus(s)
+	case string:
+		*e = ContactRequestStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ContactRequestStatus: %T", src)
+	}
+	return nil
+}
+
+type NullContactRequestStatus struct {
+	ContactRequestStatus ContactRequestStatus
+	Valid                bool // Valid is true if ContactRequestStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullContactRequestStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ContactRequestStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ContactRequestStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullContactRequestStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ContactRequestStatus), nil
+}
+
+type DataExportStatus string
+
+const (
+	DataExportStatusPENDING    DataExportStatus = "PENDING"
+	DataExportStatusPROCESSING DataExportStatus = "PROCESSING"
+	DataExportStatusREADY      DataExportStatus = "READY"
+	DataExportStatusFAILED     DataExportStatus = "FAILED"
+)
+
+func (e *DataExportStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DataExportStatus(s)
+	case string:
+		*e = DataExportStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DataExportStatus: %T", src)
+	}
+	return nil
+}
+
+type NullDataExportStatus struct {
+	DataExportStatus DataExportStatus
+	Valid            bool // Valid is true if DataExportStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDataExportStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.DataExportStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DataExportStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDataExportStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DataExportStatus), nil
+}
+
 type Gender string
 
 const (
@@ -138,6 +270,104 @@ func (ns NullRoleType) Value() (driver.Value, error) {
 	return string(ns.RoleType), nil
 }
 
+type VisibilityLevel string
+
+const (
+	VisibilityLevelEVERYONE VisibilityLevel = "EVERYONE"
+	VisibilityLevelCONTACTS VisibilityLevel = "CONTACTS"
+	VisibilityLevelNOBODY   VisibilityLevel = "NOBODY"
+)
+
+func (e *VisibilityLevel) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = VisibilityLevel(s)
+	case string:
+		*e = VisibilityLevel(s)
+	default:
+		return fmt.Errorf("unsupported scan type for VisibilityLevel: %T", src)
+	}
+	return nil
+}
+
+type NullVisibilityLevel struct {
+	VisibilityLevel VisibilityLevel
+	Valid           bool // Valid is true if VisibilityLevel is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullVisibilityLevel) Scan(value interface{}) error {
+	if value == nil {
+		ns.VisibilityLevel, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.VisibilityLevel.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullVisibilityLevel) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.VisibilityLevel), nil
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+	WebhookDeliveryStatusDELIVERED WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryStatusDEAD      WebhookDeliveryStatus = "DEAD"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus
+	Valid                 bool // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
+type ApiKey struct {
+	ID               extensions.UUID
+	ServiceAccountID extensions.UUID
+	Prefix           string
+	KeyHash          []byte
+	Scopes           []string
+	LastUsedAt       *time.Time
+	RevokedAt        *time.Time
+	CreatedAt        time.Time
+}
+
 type Attachment struct {
 	ID        extensions.UUID
 	MessageID extensions.UUID
@@ -146,6 +376,19 @@ type Attachment struct {
 	UpdatedAt time.Time
 }
 
+type AuditLog struct {
+	ID         extensions.UUID
+	ActorType  AuditActorType
+	ActorID    *extensions.UUID
+	Action     string
+	TargetType string
+	TargetID   string
+	Changes    []byte
+	Ip         *string
+	RequestID  *string
+	CreatedAt  time.Time
+}
+
 type Chat struct {
 	ID        extensions.UUID
 	Title     *string
@@ -154,24 +397,90 @@ type Chat struct {
 	UpdatedAt time.Time
 }
 
-type Interest struct {
-	ID           extensions.UUID
-	Title        string
-	IconFileName string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	Description  string
+type ChatServiceAccount struct {
+	ChatID           extensions.UUID
+	ServiceAccountID extensions.UUID
+	InvitedBy        *extensions.UUID
+	CreatedAt        time.Time
 }
 
-type Message struct {
+type Contact struct {
+	UserID    extensions.UUID
+	ContactID extensions.UUID
+	CreatedAt time.Time
+}
+
+type ContactRequest struct {
+	ID         extensions.UUID
+	SenderID   extensions.UUID
+	ReceiverID extensions.UUID
+	Status     ContactRequestStatus
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type DataExport struct {
+	ID        extensions.UUID
+	UserID    extensions.UUID
+	Status    DataExportStatus
+	FileName  *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Interest struct {
 	ID                 extensions.UUID
-	ChatID             extensions.UUID
-	SenderID           extensions.UUID
-	RawText            *string
-	Edited             bool
-	MessageReferenceID *extensions.UUID
+	Title              string
+	IconFileName       string
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
+	Description        string
+	CategoryID         *extensions.UUID
+	Position           int32
+	IconThumbnailSizes []int32
+}
+
+type InterestCategory struct {
+	ID        extensions.UUID
+	ParentID  *extensions.UUID
+	Title     string
+	Position  int32
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type InterestDailyStat struct {
+	InterestID extensions.UUID
+	Day        time.Time
+	Added      int64
+	Removed    int64
+}
+
+type InterestStat struct {
+	InterestID extensions.UUID
+	UsersCount int64
+	UpdatedAt  time.Time
+}
+
+type InterestTranslation struct {
+	InterestID  extensions.UUID
+	Locale      string
+	Title       string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type Message struct {
+	ID                     extensions.UUID
+	ChatID                 extensions.UUID
+	SenderID               *extensions.UUID
+	RawText                *string
+	Edited                 bool
+	MessageReferenceID     *extensions.UUID
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+	SenderServiceAccountID *extensions.UUID
 }
 
 type ReadStatus struct {
@@ -180,20 +489,38 @@ type ReadStatus struct {
 	ReadAt    time.Time
 }
 
+type ServiceAccount struct {
+	ID          extensions.UUID
+	Name        string
+	Description string
+	CreatedBy   *extensions.UUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
 type User struct {
-	ID             extensions.UUID
-	FullName       string
-	Birthday       time.Time
-	Gender         Gender
-	Email          string
-	Password       []byte
-	AvatarFileName string
-	Online         bool
-	EmailVerified  bool
-	LastSeen       time.Time
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
-	Role           RoleType
+	ID                    extensions.UUID
+	FullName              string
+	Birthday              time.Time
+	Gender                Gender
+	Email                 string
+	Password              []byte
+	AvatarFileName        string
+	Online                bool
+	EmailVerified         bool
+	LastSeen              time.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	Role                  RoleType
+	DeletedAt             *time.Time
+	PurgeAfter            *time.Time
+	ProfileVisibility     VisibilityLevel
+	PresenceVisibility    VisibilityLevel
+	PrivateChatPermission VisibilityLevel
+	AvatarGenerated       bool
+	SuspendedUntil        *time.Time
+	SuspensionReason      *string
+	SessionVersion        int32
 }
 
 type UserChat struct {
@@ -214,3 +541,29 @@ type VerificationCode struct {
 	Code      int32
 	ExpiresAt time.Time
 }
+
+type WebhookDelivery struct {
+	ID               extensions.UUID
+	SubscriptionID   extensions.UUID
+	EventType        string
+	Payload          []byte
+	Status           WebhookDeliveryStatus
+	Attempts         int32
+	NextAttemptAt    time.Time
+	LastAttemptAt    *time.Time
+	LastResponseCode *int32
+	LastError        *string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type WebhookSubscription struct {
+	ID         extensions.UUID
+	Url        string
+	EventTypes []string
+	Secret     string
+	Active     bool
+	CreatedBy  *extensions.UUID
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
@@ -11,23 +11,127 @@ import (
 )
 
 type Querier interface {
+	ActiveUserExists(ctx context.Context, id extensions.UUID) (bool, error)
+	AddContact(ctx context.Context, arg AddContactParams) error
+	AddServiceAccountToChat(ctx context.Context, arg AddServiceAccountToChatParams) error
+	AddUserInterests(ctx context.Context, arg AddUserInterestsParams) error
+	AddUserToChat(ctx context.Context, arg AddUserToChatParams) error
+	AnonymizeUserMessages(ctx context.Context, senderID extensions.UUID) error
+	AreContacts(ctx context.Context, arg AreContactsParams) (bool, error)
 	AssignInterestsToUser(ctx context.Context, arg AssignInterestsToUserParams) error
+	ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error)
+	ClaimPendingDataExports(ctx context.Context, maxCount int32) ([]DataExport, error)
+	CompleteDataExport(ctx context.Context, arg CompleteDataExportParams) error
+	CountInterestSubcategories(ctx context.Context, id extensions.UUID) (int64, error)
+	CountSearchedUsers(ctx context.Context, arg CountSearchedUsersParams) (int64, error)
+	CountUserContacts(ctx context.Context, userID extensions.UUID) (int64, error)
+	CountUserInterests(ctx context.Context, userID extensions.UUID) (int64, error)
+	CreateApiKey(ctx context.Context, arg CreateApiKeyParams) (ApiKey, error)
+	CreateAuditLogEntry(ctx context.Context, arg CreateAuditLogEntryParams) error
+	CreateChat(ctx context.Context, arg CreateChatParams) (Chat, error)
+	CreateContactRequest(ctx context.Context, arg CreateContactRequestParams) (ContactRequest, error)
+	CreateDataExport(ctx context.Context, userID extensions.UUID) (DataExport, error)
 	CreateInterest(ctx context.Context, arg CreateInterestParams) (Interest, error)
+	CreateInterestCategory(ctx context.Context, arg CreateInterestCategoryParams) (InterestCategory, error)
+	CreateServiceAccount(ctx context.Context, arg CreateServiceAccountParams) (ServiceAccount, error)
+	CreateServiceAccountMessage(ctx context.Context, arg CreateServiceAccountMessageParams) (Message, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
-	DeleteInterest(ctx context.Context, id extensions.UUID) error
+	CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error)
+	DeleteInterest(ctx context.Context, arg DeleteInterestParams) (int64, error)
+	DeleteInterestCategory(ctx context.Context, id extensions.UUID) error
+	DeleteInterestTranslation(ctx context.Context, arg DeleteInterestTranslationParams) (InterestTranslation, error)
 	EmailExists(ctx context.Context, email string) (bool, error)
+	EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) error
 	ExistenceCheck(ctx context.Context, ids []extensions.UUID) (int64, error)
+	FailDataExport(ctx context.Context, id extensions.UUID) error
+	GetActiveApiKeyByPrefix(ctx context.Context, prefix string) (GetActiveApiKeyByPrefixRow, error)
+	GetAllInterests(ctx context.Context) ([]Interest, error)
+	GetAuditLogEntries(ctx context.Context, arg GetAuditLogEntriesParams) ([]AuditLog, error)
+	GetAuditLogEntriesAfter(ctx context.Context, arg GetAuditLogEntriesAfterParams) ([]AuditLog, error)
+	GetContactRequestById(ctx context.Context, id extensions.UUID) (ContactRequest, error)
+	GetDataExportById(ctx context.Context, id extensions.UUID) (DataExport, error)
+	GetIncomingContactRequests(ctx context.Context, arg GetIncomingContactRequestsParams) ([]ContactRequest, error)
 	GetInterestById(ctx context.Context, id extensions.UUID) (Interest, error)
-	GetManyInterestsByFilters(ctx context.Context, arg GetManyInterestsByFiltersParams) ([]Interest, error)
+	GetInterestCategories(ctx context.Context) ([]InterestCategory, error)
+	GetInterestCategoryById(ctx context.Context, id extensions.UUID) (InterestCategory, error)
+	GetInterestStats(ctx context.Context, arg GetInterestStatsParams) ([]GetInterestStatsRow, error)
+	GetInterestTranslation(ctx context.Context, arg GetInterestTranslationParams) (InterestTranslation, error)
+	GetInterestTranslations(ctx context.Context, interestIds []extensions.UUID) ([]InterestTranslation, error)
+	GetInterestsByCreatedAt(ctx context.Context, arg GetInterestsByCreatedAtParams) ([]GetInterestsByCreatedAtRow, error)
+	GetInterestsByCreatedAtDesc(ctx context.Context, arg GetInterestsByCreatedAtDescParams) ([]GetInterestsByCreatedAtDescRow, error)
+	GetInterestsByIds(ctx context.Context, ids []extensions.UUID) ([]Interest, error)
+	GetInterestsByPopularity(ctx context.Context, arg GetInterestsByPopularityParams) ([]GetInterestsByPopularityRow, error)
+	GetInterestsByPopularityDesc(ctx context.Context, arg GetInterestsByPopularityDescParams) ([]GetInterestsByPopularityDescRow, error)
+	GetInterestsByPosition(ctx context.Context, arg GetInterestsByPositionParams) ([]GetInterestsByPositionRow, error)
+	GetInterestsByPositionDesc(ctx context.Context, arg GetInterestsByPositionDescParams) ([]GetInterestsByPositionDescRow, error)
+	// the listing has a query per sort, so the order is static and the planner can read the pages from the
+	// indexes instead of sorting every matching interest
+	GetInterestsByTitle(ctx context.Context, arg GetInterestsByTitleParams) ([]GetInterestsByTitleRow, error)
+	GetInterestsByTitleDesc(ctx context.Context, arg GetInterestsByTitleDescParams) ([]GetInterestsByTitleDescRow, error)
+	GetInterestsByTitles(ctx context.Context, titles []string) ([]Interest, error)
+	GetOutgoingContactRequests(ctx context.Context, arg GetOutgoingContactRequestsParams) ([]ContactRequest, error)
+	GetPrivateChatBetween(ctx context.Context, arg GetPrivateChatBetweenParams) (Chat, error)
+	GetServiceAccountApiKeys(ctx context.Context, serviceAccountID extensions.UUID) ([]ApiKey, error)
+	GetServiceAccountById(ctx context.Context, id extensions.UUID) (ServiceAccount, error)
+	GetServiceAccountChats(ctx context.Context, serviceAccountID extensions.UUID) ([]Chat, error)
+	GetServiceAccounts(ctx context.Context) ([]ServiceAccount, error)
+	GetTrendingInterests(ctx context.Context, arg GetTrendingInterestsParams) ([]GetTrendingInterestsRow, error)
+	GetUserAttachments(ctx context.Context, senderID extensions.UUID) ([]Attachment, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserById(ctx context.Context, id extensions.UUID) (User, error)
+	GetUserChats(ctx context.Context, userID extensions.UUID) ([]Chat, error)
+	GetUserContacts(ctx context.Context, arg GetUserContactsParams) ([]GetUserContactsRow, error)
+	GetUserDataExports(ctx context.Context, userID extensions.UUID) ([]DataExport, error)
 	GetUserInterests(ctx context.Context, id extensions.UUID) ([]Interest, error)
+	GetUserMessages(ctx context.Context, senderID extensions.UUID) ([]Message, error)
+	GetUserOwnedChatAttachments(ctx context.Context, userID extensions.UUID) ([]Attachment, error)
+	GetUsersToPurge(ctx context.Context, maxCount int32) ([]User, error)
+	GetWebhookDeliveries(ctx context.Context, arg GetWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	GetWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error)
+	IncrementSessionVersion(ctx context.Context, id extensions.UUID) (User, error)
+	InterestCategoriesExistenceCheck(ctx context.Context, ids []extensions.UUID) (int64, error)
+	IsInterestCategoryInSubtree(ctx context.Context, arg IsInterestCategoryInSubtreeParams) (bool, error)
+	IsServiceAccountInChat(ctx context.Context, arg IsServiceAccountInChatParams) (bool, error)
+	IsUserInChat(ctx context.Context, arg IsUserInChatParams) (bool, error)
+	LiftUserSuspension(ctx context.Context, id extensions.UUID) (User, error)
+	// the moves lock every category, otherwise the subtree checks of two concurrent moves could both pass and
+	// the moves would form a cycle together
+	LockInterestCategories(ctx context.Context) error
+	// the changes of the interests of a user are serialized by the lock of the user row, the key isn't
+	// locked, so it doesn't block the inserts referencing the user. The interests are a part of the user,
+	// so the row is touched to change its version as well
+	LockUserInterests(ctx context.Context, userID extensions.UUID) error
+	LockUserToPurge(ctx context.Context, id extensions.UUID) (extensions.UUID, error)
+	MarkWebhookDeliveryDelivered(ctx context.Context, arg MarkWebhookDeliveryDeliveredParams) error
+	MarkWebhookDeliveryFailed(ctx context.Context, arg MarkWebhookDeliveryFailedParams) error
+	MessageExistsInChat(ctx context.Context, arg MessageExistsInChatParams) (bool, error)
 	NameExists(ctx context.Context, fullName string) (bool, error)
-	RemoveUser(ctx context.Context, id extensions.UUID) error
+	PendingContactRequestExists(ctx context.Context, arg PendingContactRequestExistsParams) (bool, error)
+	RemoveContact(ctx context.Context, arg RemoveContactParams) (int64, error)
+	RemoveSelectedUserInterests(ctx context.Context, arg RemoveSelectedUserInterestsParams) error
+	RemoveServiceAccountFromChat(ctx context.Context, arg RemoveServiceAccountFromChatParams) error
+	RemoveUser(ctx context.Context, id extensions.UUID) (int64, error)
 	RemoveUserInterests(ctx context.Context, userID extensions.UUID) error
+	RemoveUserOwnedChats(ctx context.Context, userID extensions.UUID) error
+	RemoveWebhookSubscription(ctx context.Context, id extensions.UUID) error
+	ResolveContactRequest(ctx context.Context, arg ResolveContactRequestParams) (ContactRequest, error)
+	RestoreUser(ctx context.Context, id extensions.UUID) (User, error)
+	RevokeApiKey(ctx context.Context, arg RevokeApiKeyParams) (ApiKey, error)
+	SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error)
+	ServiceAccountExists(ctx context.Context, id extensions.UUID) (bool, error)
+	ServiceAccountNameExists(ctx context.Context, name string) (bool, error)
+	SetUserRole(ctx context.Context, arg SetUserRoleParams) (User, error)
+	SoftDeleteUser(ctx context.Context, arg SoftDeleteUserParams) (User, error)
+	SuspendUser(ctx context.Context, arg SuspendUserParams) (User, error)
+	TouchApiKey(ctx context.Context, id extensions.UUID) error
 	UpdateInterest(ctx context.Context, arg UpdateInterestParams) (Interest, error)
+	UpdateInterestCategory(ctx context.Context, arg UpdateInterestCategoryParams) (InterestCategory, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
+	UpdateUserPrivacySettings(ctx context.Context, arg UpdateUserPrivacySettingsParams) (User, error)
+	UpsertInterestTranslation(ctx context.Context, arg UpsertInterestTranslationParams) (InterestTranslation, error)
 	UserExists(ctx context.Context, id extensions.UUID) (bool, error)
+	WebhookSubscriptionExists(ctx context.Context, id extensions.UUID) (bool, error)
 }
 
 var _ Querier = (*Queries)(nil)
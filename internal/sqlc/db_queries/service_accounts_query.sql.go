@@ -0,0 +1,390 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: service_accounts_query.sql
+
+package db_queries
+
+import (
+	"context"
+
+	"chat_app_backend/internal/extensions"
+)
+
+const addServiceAccountToChat = `-- name: AddServiceAccountToChat :exec
+INSERT INTO chat_service_accounts
+(chat_id, service_account_id, invited_by)
+VALUES
+($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type AddServiceAccountToChatParams struct {
+	ChatID           extensions.UUID
+	ServiceAccountID extensions.UUID
+	InvitedBy        *extensions.UUID
+}
+
+func (q *Queries) AddServiceAccountToChat(ctx context.Context, arg AddServiceAccountToChatParams) error {
+	_, err := q.db.Exec(ctx, addServiceAccountToChat, arg.ChatID, arg.ServiceAccountID, arg.InvitedBy)
+	return err
+}
+
+const createApiKey = `-- name: CreateApiKey :one
+INSERT INTO api_keys
+(service_account_id, prefix, key_hash, scopes)
+VALUES
+($1, $2, $3, $4::varchar[])
+RETURNING id, service_account_id, prefix, key_hash, scopes, last_used_at, revoked_at, created_at
+`
+
+type CreateApiKeyParams struct {
+	ServiceAccountID extensions.UUID
+	Prefix           string
+	KeyHash          []byte
+	Scopes           []string
+}
+
+func (q *Queries) CreateApiKey(ctx context.Context, arg CreateApiKeyParams) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, createApiKey,
+		arg.ServiceAccountID,
+		arg.Prefix,
+		arg.KeyHash,
+		arg.Scopes,
+	)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.ServiceAccountID,
+		&i.Prefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createServiceAccount = `-- name: CreateServiceAccount :one
+INSERT INTO service_accounts
+(name, description, created_by)
+VALUES
+($1, $2, $3)
+RETURNING id, name, description, created_by, created_at, updated_at
+`
+
+type CreateServiceAccountParams struct {
+	Name        string
+	Description string
+	CreatedBy   *extensions.UUID
+}
+
+func (q *Queries) CreateServiceAccount(ctx context.Context, arg CreateServiceAccountParams) (ServiceAccount, error) {
+	row := q.db.QueryRow(ctx, createServiceAccount, arg.Name, arg.Description, arg.CreatedBy)
+	var i ServiceAccount
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createServiceAccountMessage = `-- name: CreateServiceAccountMessage :one
+INSERT INTO messages
+(chat_id, sender_service_account_id, raw_text, edited, message_reference_id)
+VALUES
+($1, $2::uuid, $3, false, $4)
+RETURNING id, chat_id, sender_id, raw_text, edited, message_reference_id, created_at, updated_at, sender_service_account_id
+`
+
+type CreateServiceAccountMessageParams struct {
+	ChatID                 extensions.UUID
+	SenderServiceAccountID extensions.UUID
+	RawText                *string
+	MessageReferenceID     *extensions.UUID
+}
+
+func (q *Queries) CreateServiceAccountMessage(ctx context.Context, arg CreateServiceAccountMessageParams) (Message, error) {
+	row := q.db.QueryRow(ctx, createServiceAccountMessage,
+		arg.ChatID,
+		arg.SenderServiceAccountID,
+		arg.RawText,
+		arg.MessageReferenceID,
+	)
+	var i Message
+	err := row.Scan(
+		&i.ID,
+		&i.ChatID,
+		&i.SenderID,
+		&i.RawText,
+		&i.Edited,
+		&i.MessageReferenceID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SenderServiceAccountID,
+	)
+	return i, err
+}
+
+const getActiveApiKeyByPrefix = `-- name: GetActiveApiKeyByPrefix :one
+SELECT api_keys.id, api_keys.service_account_id, api_keys.prefix, api_keys.key_hash, api_keys.scopes, api_keys.last_used_at, api_keys.revoked_at, api_keys.created_at, service_accounts.id, service_accounts.name, service_accounts.description, service_accounts.created_by, service_accounts.created_at, service_accounts.updated_at
+FROM api_keys
+JOIN service_accounts ON api_keys.service_account_id = service_accounts.id
+WHERE api_keys.prefix = $1 AND api_keys.revoked_at IS NULL
+`
+
+type GetActiveApiKeyByPrefixRow struct {
+	ApiKey         ApiKey
+	ServiceAccount ServiceAccount
+}
+
+func (q *Queries) GetActiveApiKeyByPrefix(ctx context.Context, prefix string) (GetActiveApiKeyByPrefixRow, error) {
+	row := q.db.QueryRow(ctx, getActiveApiKeyByPrefix, prefix)
+	var i GetActiveApiKeyByPrefixRow
+	err := row.Scan(
+		&i.ApiKey.ID,
+		&i.ApiKey.ServiceAccountID,
+		&i.ApiKey.Prefix,
+		&i.ApiKey.KeyHash,
+		&i.ApiKey.Scopes,
+		&i.ApiKey.LastUsedAt,
+		&i.ApiKey.RevokedAt,
+		&i.ApiKey.CreatedAt,
+		&i.ServiceAccount.ID,
+		&i.ServiceAccount.Name,
+		&i.ServiceAccount.Description,
+		&i.ServiceAccount.CreatedBy,
+		&i.ServiceAccount.CreatedAt,
+		&i.ServiceAccount.UpdatedAt,
+	)
+	return i, err
+}
+
+const getServiceAccountApiKeys = `-- name: GetServiceAccountApiKeys :many
+SELECT id, service_account_id, prefix, key_hash, scopes, last_used_at, revoked_at, created_at
+FROM api_keys
+WHERE api_keys.service_account_id = $1
+ORDER BY api_keys.created_at
+`
+
+func (q *Queries) GetServiceAccountApiKeys(ctx context.Context, serviceAccountID extensions.UUID) ([]ApiKey, error) {
+	rows, err := q.db.Query(ctx, getServiceAccountApiKeys, serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ApiKey{}
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServiceAccountID,
+			&i.Prefix,
+			&i.KeyHash,
+			&i.Scopes,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getServiceAccountById = `-- name: GetServiceAccountById :one
+SELECT id, name, description, created_by, created_at, updated_at
+FROM service_accounts
+WHERE service_accounts.id = $1
+`
+
+func (q *Queries) GetServiceAccountById(ctx context.Context, id extensions.UUID) (ServiceAccount, error) {
+	row := q.db.QueryRow(ctx, getServiceAccountById, id)
+	var i ServiceAccount
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getServiceAccountChats = `-- name: GetServiceAccountChats :many
+SELECT chats.id, chats.title, chats.c_type, chats.created_at, chats.updated_at
+FROM chats
+JOIN chat_service_accounts ON chats.id = chat_service_accounts.chat_id
+WHERE chat_service_accounts.service_account_id = $1
+ORDER BY chats.created_at
+`
+
+func (q *Queries) GetServiceAccountChats(ctx context.Context, serviceAccountID extensions.UUID) ([]Chat, error) {
+	rows, err := q.db.Query(ctx, getServiceAccountChats, serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Chat{}
+	for rows.Next() {
+		var i Chat
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.CType,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getServiceAccounts = `-- name: GetServiceAccounts :many
+SELECT id, name, description, created_by, created_at, updated_at
+FROM service_accounts
+ORDER BY service_accounts.created_at
+`
+
+func (q *Queries) GetServiceAccounts(ctx context.Context) ([]ServiceAccount, error) {
+	rows, err := q.db.Query(ctx, getServiceAccounts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ServiceAccount{}
+	for rows.Next() {
+		var i ServiceAccount
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const isServiceAccountInChat = `-- name: IsServiceAccountInChat :one
+SELECT COUNT(*) > 0
+FROM chat_service_accounts
+WHERE chat_service_accounts.chat_id = $1 AND chat_service_accounts.service_account_id = $2
+`
+
+type IsServiceAccountInChatParams struct {
+	ChatID           extensions.UUID
+	ServiceAccountID extensions.UUID
+}
+
+func (q *Queries) IsServiceAccountInChat(ctx context.Context, arg IsServiceAccountInChatParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isServiceAccountInChat, arg.ChatID, arg.ServiceAccountID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const removeServiceAccountFromChat = `-- name: RemoveServiceAccountFromChat :exec
+DELETE FROM chat_service_accounts
+WHERE chat_service_accounts.chat_id = $1 AND chat_service_accounts.service_account_id = $2
+`
+
+type RemoveServiceAccountFromChatParams struct {
+	ChatID           extensions.UUID
+	ServiceAccountID extensions.UUID
+}
+
+func (q *Queries) RemoveServiceAccountFromChat(ctx context.Context, arg RemoveServiceAccountFromChatParams) error {
+	_, err := q.db.Exec(ctx, removeServiceAccountFromChat, arg.ChatID, arg.ServiceAccountID)
+	return err
+}
+
+const revokeApiKey = `-- name: RevokeApiKey :one
+UPDATE api_keys
+SET revoked_at = coalesce(revoked_at, now())
+WHERE api_keys.id = $1 AND api_keys.service_account_id = $2
+RETURNING id, service_account_id, prefix, key_hash, scopes, last_used_at, revoked_at, created_at
+`
+
+type RevokeApiKeyParams struct {
+	ID               extensions.UUID
+	ServiceAccountID extensions.UUID
+}
+
+func (q *Queries) RevokeApiKey(ctx context.Context, arg RevokeApiKeyParams) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, revokeApiKey, arg.ID, arg.ServiceAccountID)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.ServiceAccountID,
+		&i.Prefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const serviceAccountExists = `-- name: ServiceAccountExists :one
+SELECT COUNT(id) > 0
+FROM service_accounts
+WHERE id = $1
+`
+
+func (q *Queries) ServiceAccountExists(ctx context.Context, id extensions.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, serviceAccountExists, id)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const serviceAccountNameExists = `-- name: ServiceAccountNameExists :one
+SELECT COUNT(id) > 0
+FROM service_accounts
+WHERE name = $1
+`
+
+func (q *Queries) ServiceAccountNameExists(ctx context.Context, name string) (bool, error) {
+	row := q.db.QueryRow(ctx, serviceAccountNameExists, name)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const touchApiKey = `-- name: TouchApiKey :exec
+UPDATE api_keys
+SET last_used_at = now()
+WHERE
+    api_keys.id = $1
+  AND
+    (api_keys.last_used_at IS NULL OR api_keys.last_used_at < now() - interval '1 minute')
+`
+
+func (q *Queries) TouchApiKey(ctx context.Context, id extensions.UUID) error {
+	_, err := q.db.Exec(ctx, touchApiKey, id)
+	return err
+}
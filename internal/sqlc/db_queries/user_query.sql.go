@@ -12,21 +12,35 @@ import (
 	"chat_app_backend/internal/extensions"
 )
 
+const activeUserExists = `-- name: ActiveUserExists :one
+SELECT COUNT(id) > 0
+FROM users
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) ActiveUserExists(ctx context.Context, id extensions.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, activeUserExists, id)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users
-(full_name, birthday, gender, email, password, avatar_file_name, online)
+(full_name, birthday, gender, email, password, avatar_file_name, avatar_generated, online)
 VALUES
-($1, $2, $3::gender, $4, $5, $6, true)
-RETURNING id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role
+($1, $2, $3::gender, $4, $5, $6, $7, true)
+RETURNING id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role, deleted_at, purge_after, profile_visibility, presence_visibility, private_chat_permission, avatar_generated, suspended_until, suspension_reason, session_version
 `
 
 type CreateUserParams struct {
-	FullName       string
-	Birthday       time.Time
-	Gender         Gender
-	Email          string
-	Password       []byte
-	AvatarFileName string
+	FullName        string
+	Birthday        time.Time
+	Gender          Gender
+	Email           string
+	Password        []byte
+	AvatarFileName  string
+	AvatarGenerated bool
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
@@ -37,6 +51,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		arg.Email,
 		arg.Password,
 		arg.AvatarFileName,
+		arg.AvatarGenerated,
 	)
 	var i User
 	err := row.Scan(
@@ -53,6 +68,15 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Role,
+		&i.DeletedAt,
+		&i.PurgeAfter,
+		&i.ProfileVisibility,
+		&i.PresenceVisibility,
+		&i.PrivateChatPermission,
+		&i.AvatarGenerated,
+		&i.SuspendedUntil,
+		&i.SuspensionReason,
+		&i.SessionVersion,
 	)
 	return i, err
 }
@@ -71,7 +95,7 @@ func (q *Queries) EmailExists(ctx context.Context, email string) (bool, error) {
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role
+SELECT id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role, deleted_at, purge_after, profile_visibility, presence_visibility, private_chat_permission, avatar_generated, suspended_until, suspension_reason, session_version
 FROM users
 WHERE users.email = $1
 LIMIT 1
@@ -94,12 +118,21 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Role,
+		&i.DeletedAt,
+		&i.PurgeAfter,
+		&i.ProfileVisibility,
+		&i.PresenceVisibility,
+		&i.PrivateChatPermission,
+		&i.AvatarGenerated,
+		&i.SuspendedUntil,
+		&i.SuspensionReason,
+		&i.SessionVersion,
 	)
 	return i, err
 }
 
 const getUserById = `-- name: GetUserById :one
-SELECT id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role
+SELECT id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role, deleted_at, purge_after, profile_visibility, presence_visibility, private_chat_permission, avatar_generated, suspended_until, suspension_reason, session_version
 FROM users
 WHERE users.id = $1
 `
@@ -121,10 +154,91 @@ func (q *Queries) GetUserById(ctx context.Context, id extensions.UUID) (User, er
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Role,
+		&i.DeletedAt,
+		&i.PurgeAfter,
+		&i.ProfileVisibility,
+		&i.PresenceVisibility,
+		&i.PrivateChatPermission,
+		&i.AvatarGenerated,
+		&i.SuspendedUntil,
+		&i.SuspensionReason,
+		&i.SessionVersion,
 	)
 	return i, err
 }
 
+const getUsersToPurge = `-- name: GetUsersToPurge :many
+SELECT id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role, deleted_at, purge_after, profile_visibility, presence_visibility, private_chat_permission, avatar_generated, suspended_until, suspension_reason, session_version
+FROM users
+WHERE
+    users.purge_after IS NOT NULL
+  AND
+    users.purge_after <= now()
+ORDER BY users.purge_after
+LIMIT $1
+`
+
+func (q *Queries) GetUsersToPurge(ctx context.Context, maxCount int32) ([]User, error) {
+	rows, err := q.db.Query(ctx, getUsersToPurge, maxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Birthday,
+			&i.Gender,
+			&i.Email,
+			&i.Password,
+			&i.AvatarFileName,
+			&i.Online,
+			&i.EmailVerified,
+			&i.LastSeen,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Role,
+			&i.DeletedAt,
+			&i.PurgeAfter,
+			&i.ProfileVisibility,
+			&i.PresenceVisibility,
+			&i.PrivateChatPermission,
+			&i.AvatarGenerated,
+			&i.SuspendedUntil,
+			&i.SuspensionReason,
+			&i.SessionVersion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockUserToPurge = `-- name: LockUserToPurge :one
+SELECT users.id
+FROM users
+WHERE
+    users.id = $1
+  AND
+    users.purge_after IS NOT NULL
+  AND
+    users.purge_after <= now()
+FOR UPDATE
+`
+
+func (q *Queries) LockUserToPurge(ctx context.Context, id extensions.UUID) (extensions.UUID, error) {
+	row := q.db.QueryRow(ctx, lockUserToPurge, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
 const nameExists = `-- name: NameExists :one
 SELECT COUNT(id) > 0
 FROM users
@@ -138,14 +252,119 @@ func (q *Queries) NameExists(ctx context.Context, fullName string) (bool, error)
 	return column_1, err
 }
 
-const removeUser = `-- name: RemoveUser :exec
+const removeUser = `-- name: RemoveUser :execrows
 DELETE FROM users
-WHERE users.id = $1
+WHERE
+    users.id = $1
+  AND
+    users.purge_after IS NOT NULL
+  AND
+    users.purge_after <= now()
 `
 
-func (q *Queries) RemoveUser(ctx context.Context, id extensions.UUID) error {
-	_, err := q.db.Exec(ctx, removeUser, id)
-	return err
+func (q *Queries) RemoveUser(ctx context.Context, id extensions.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, removeUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreUser = `-- name: RestoreUser :one
+UPDATE users
+SET
+    deleted_at = null,
+    purge_after = null,
+    updated_at = now()
+WHERE
+    users.id = $1
+  AND
+    users.deleted_at IS NOT NULL
+  AND
+    users.purge_after > now()
+RETURNING id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role, deleted_at, purge_after, profile_visibility, presence_visibility, private_chat_permission, avatar_generated, suspended_until, suspension_reason, session_version
+`
+
+func (q *Queries) RestoreUser(ctx context.Context, id extensions.UUID) (User, error) {
+	row := q.db.QueryRow(ctx, restoreUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.Birthday,
+		&i.Gender,
+		&i.Email,
+		&i.Password,
+		&i.AvatarFileName,
+		&i.Online,
+		&i.EmailVerified,
+		&i.LastSeen,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DeletedAt,
+		&i.PurgeAfter,
+		&i.ProfileVisibility,
+		&i.PresenceVisibility,
+		&i.PrivateChatPermission,
+		&i.AvatarGenerated,
+		&i.SuspendedUntil,
+		&i.SuspensionReason,
+		&i.SessionVersion,
+	)
+	return i, err
+}
+
+const softDeleteUser = `-- name: SoftDeleteUser :one
+UPDATE users
+SET
+    deleted_at = now(),
+    purge_after = $1,
+    online = false,
+    updated_at = now()
+WHERE
+    users.id = $2
+  AND
+    users.deleted_at IS NULL
+  AND
+    ($3::timestamptz[] IS NULL OR users.updated_at = ANY($3::timestamptz[]))
+RETURNING id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role, deleted_at, purge_after, profile_visibility, presence_visibility, private_chat_permission, avatar_generated, suspended_until, suspension_reason, session_version
+`
+
+type SoftDeleteUserParams struct {
+	PurgeAfter       *time.Time
+	ID               extensions.UUID
+	ExpectedVersions []time.Time
+}
+
+func (q *Queries) SoftDeleteUser(ctx context.Context, arg SoftDeleteUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, softDeleteUser, arg.PurgeAfter, arg.ID, arg.ExpectedVersions)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.Birthday,
+		&i.Gender,
+		&i.Email,
+		&i.Password,
+		&i.AvatarFileName,
+		&i.Online,
+		&i.EmailVerified,
+		&i.LastSeen,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+		&i.DeletedAt,
+		&i.PurgeAfter,
+		&i.ProfileVisibility,
+		&i.PresenceVisibility,
+		&i.PrivateChatPermission,
+		&i.AvatarGenerated,
+		&i.SuspendedUntil,
+		&i.SuspensionReason,
+		&i.SessionVersion,
+	)
+	return i, err
 }
 
 const updateUser = `-- name: UpdateUser :one
@@ -158,26 +377,32 @@ SET
     email = coalesce($5, email),
     password = coalesce($6, password),
     avatar_file_name = coalesce($7, avatar_file_name),
-    role = coalesce($8, role),
+    avatar_generated = coalesce($8, avatar_generated),
+    role = coalesce($9, role),
     email_verified = case
         when $5 is null then email_verified
         else false
     end,
     updated_at = now()
-WHERE users.id = $9
-RETURNING id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role
+WHERE
+    users.id = $10
+  AND
+    ($11::timestamptz[] IS NULL OR users.updated_at = ANY($11::timestamptz[]))
+RETURNING id, full_name, birthday, gender, email, password, avatar_file_name, online, email_verified, last_seen, created_at, updated_at, role, deleted_at, purge_after, profile_visibility, presence_visibility, private_chat_permission, avatar_generated, suspended_until, suspension_reason, session_version
 `
 
 type UpdateUserParams struct {
-	Online         *bool
-	FullName       *string
-	Birthday       *time.Time
-	Gender         NullGender
-	Email          *string
-	Password       *[]byte
-	AvatarFileName *string
-	Role           NullRoleType
-	ID             extensions.UUID
+	Online           *bool
+	FullName         *string
+	Birthday         *time.Time
+	Gender           NullGender
+	Email            *string
+	Password         *[]byte
+	AvatarFileName   *string
+	AvatarGenerated  *bool
+	Role             NullRoleType
+	ID               extensions.UUID
+	ExpectedVersions []time.Time
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
@@ -189,8 +414,10 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		arg.Email,
 		arg.Password,
 		arg.AvatarFileName,
+		arg.AvatarGenerated,
 		arg.Role,
 		arg.ID,
+		arg.ExpectedVersions,
 	)
 	var i User
 	err := row.Scan(
@@ -207,10 +434,35 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Role,
+		&i.DeletedAt,
+		&i.PurgeAfter,
+		&i.ProfileVisibility,
+		&i.PresenceVisibility,
+		&i.PrivateChatPermission,
+		&i.AvatarGenerated,
+		&i.SuspendedUntil,
+		&i.SuspensionReason,
+		&i.SessionVersion,
 	)
 	return i, err
 }
 
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users
+SET password = $1
+WHERE users.id = $2
+`
+
+type UpdateUserPasswordParams struct {
+	Password []byte
+	ID       extensions.UUID
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.Exec(ctx, updateUserPassword, arg.Password, arg.ID)
+	return err
+}
+
 const userExists = `-- name: UserExists :one
 SELECT COUNT(id) > 0
 FROM users
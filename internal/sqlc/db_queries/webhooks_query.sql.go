@@ -0,0 +1,292 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: webhooks_query.sql
+
+package db_queries
+
+import (
+	"context"
+	"time"
+
+	"chat_app_backend/internal/extensions"
+)
+
+const claimDueWebhookDeliveries = `-- name: ClaimDueWebhookDeliveries :many
+WITH claimed AS (
+    SELECT webhook_deliveries.id
+    FROM webhook_deliveries
+    WHERE
+        webhook_deliveries.status IN ('PENDING', 'FAILED')
+      AND
+        webhook_deliveries.next_attempt_at <= now()
+    ORDER BY webhook_deliveries.next_attempt_at
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE webhook_deliveries
+SET next_attempt_at = now() + make_interval(secs => $1::float8)
+FROM claimed, webhook_subscriptions
+WHERE webhook_deliveries.id = claimed.id AND webhook_subscriptions.id = webhook_deliveries.subscription_id
+RETURNING
+    webhook_deliveries.id,
+    webhook_deliveries.event_type,
+    webhook_deliveries.payload,
+    webhook_deliveries.attempts,
+    webhook_subscriptions.url,
+    webhook_subscriptions.secret
+`
+
+type ClaimDueWebhookDeliveriesParams struct {
+	LeaseSeconds float64
+	MaxCount     int32
+}
+
+type ClaimDueWebhookDeliveriesRow struct {
+	ID        extensions.UUID
+	EventType string
+	Payload   []byte
+	Attempts  int32
+	Url       string
+	Secret    string
+}
+
+func (q *Queries) ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, claimDueWebhookDeliveries, arg.LeaseSeconds, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ClaimDueWebhookDeliveriesRow{}
+	for rows.Next() {
+		var i ClaimDueWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.Payload,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions
+(url, event_types, secret, created_by)
+VALUES
+($1, $2::varchar[], $3, $4)
+RETURNING id, url, event_types, secret, active, created_by, created_at, updated_at
+`
+
+type CreateWebhookSubscriptionParams struct {
+	Url        string
+	EventTypes []string
+	Secret     string
+	CreatedBy  *extensions.UUID
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, createWebhookSubscription,
+		arg.Url,
+		arg.EventTypes,
+		arg.Secret,
+		arg.CreatedBy,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.EventTypes,
+		&i.Secret,
+		&i.Active,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const enqueueWebhookDeliveries = `-- name: EnqueueWebhookDeliveries :exec
+INSERT INTO webhook_deliveries
+(subscription_id, event_type, payload)
+SELECT webhook_subscriptions.id, $1::varchar, $2::jsonb
+FROM webhook_subscriptions
+WHERE webhook_subscriptions.active AND $1::varchar = ANY (webhook_subscriptions.event_types)
+`
+
+type EnqueueWebhookDeliveriesParams struct {
+	EventType string
+	Payload   []byte
+}
+
+func (q *Queries) EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) error {
+	_, err := q.db.Exec(ctx, enqueueWebhookDeliveries, arg.EventType, arg.Payload)
+	return err
+}
+
+const getWebhookDeliveries = `-- name: GetWebhookDeliveries :many
+SELECT id, subscription_id, event_type, payload, status, attempts, next_attempt_at, last_attempt_at, last_response_code, last_error, created_at, updated_at
+FROM webhook_deliveries
+WHERE webhook_deliveries.subscription_id = $1
+ORDER BY webhook_deliveries.created_at DESC
+LIMIT $3 OFFSET $2
+`
+
+type GetWebhookDeliveriesParams struct {
+	SubscriptionID extensions.UUID
+	SkipCount      int32
+	MaxCount       int32
+}
+
+func (q *Queries) GetWebhookDeliveries(ctx context.Context, arg GetWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, getWebhookDeliveries, arg.SubscriptionID, arg.SkipCount, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookDelivery{}
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastAttemptAt,
+			&i.LastResponseCode,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhookSubscriptions = `-- name: GetWebhookSubscriptions :many
+SELECT id, url, event_types, secret, active, created_by, created_at, updated_at
+FROM webhook_subscriptions
+ORDER BY webhook_subscriptions.created_at
+`
+
+func (q *Queries) GetWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error) {
+	rows, err := q.db.Query(ctx, getWebhookSubscriptions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookSubscription{}
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.EventTypes,
+			&i.Secret,
+			&i.Active,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markWebhookDeliveryDelivered = `-- name: MarkWebhookDeliveryDelivered :exec
+UPDATE webhook_deliveries
+SET
+    status = 'DELIVERED'::webhook_delivery_status,
+    attempts = attempts + 1,
+    last_attempt_at = now(),
+    last_response_code = $1,
+    last_error = null,
+    updated_at = now()
+WHERE webhook_deliveries.id = $2
+`
+
+type MarkWebhookDeliveryDeliveredParams struct {
+	LastResponseCode *int32
+	ID               extensions.UUID
+}
+
+func (q *Queries) MarkWebhookDeliveryDelivered(ctx context.Context, arg MarkWebhookDeliveryDeliveredParams) error {
+	_, err := q.db.Exec(ctx, markWebhookDeliveryDelivered, arg.LastResponseCode, arg.ID)
+	return err
+}
+
+const markWebhookDeliveryFailed = `-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET
+    status = $1::webhook_delivery_status,
+    attempts = attempts + 1,
+    next_attempt_at = $2,
+    last_attempt_at = now(),
+    last_response_code = $3,
+    last_error = $4,
+    updated_at = now()
+WHERE webhook_deliveries.id = $5
+`
+
+type MarkWebhookDeliveryFailedParams struct {
+	Status           WebhookDeliveryStatus
+	NextAttemptAt    time.Time
+	LastResponseCode *int32
+	LastError        *string
+	ID               extensions.UUID
+}
+
+func (q *Queries) MarkWebhookDeliveryFailed(ctx context.Context, arg MarkWebhookDeliveryFailedParams) error {
+	_, err := q.db.Exec(ctx, markWebhookDeliveryFailed,
+		arg.Status,
+		arg.NextAttemptAt,
+		arg.LastResponseCode,
+		arg.LastError,
+		arg.ID,
+	)
+	return err
+}
+
+const removeWebhookSubscription = `-- name: RemoveWebhookSubscription :exec
+DELETE FROM webhook_subscriptions
+WHERE webhook_subscriptions.id = $1
+`
+
+func (q *Queries) RemoveWebhookSubscription(ctx context.Context, id extensions.UUID) error {
+	_, err := q.db.Exec(ctx, removeWebhookSubscription, id)
+	return err
+}
+
+const webhookSubscriptionExists = `-- name: WebhookSubscriptionExists :one
+SELECT COUNT(id) > 0
+FROM webhook_subscriptions
+WHERE id = $1
+`
+
+func (q *Queries) WebhookSubscriptionExists(ctx context.Context, id extensions.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, webhookSubscriptionExists, id)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
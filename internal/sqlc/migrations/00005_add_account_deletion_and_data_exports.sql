@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE data_export_status AS ENUM (
+    'PENDING',
+    'PROCESSING',
+    'READY',
+    'FAILED'
+);
+
+ALTER TABLE users ADD COLUMN deleted_at timestamptz;
+ALTER TABLE users ADD COLUMN purge_after timestamptz;
+
+CREATE TABLE data_exports
+(
+    id         uuid primary key            default gen_random_uuid(),
+    user_id    uuid               not null references users (id) on delete cascade,
+    status     data_export_status not null default 'PENDING'::data_export_status,
+    file_name  varchar(255),
+    created_at timestamptz        not null default now(),
+    updated_at timestamptz        not null default now()
+);
+
+ALTER TABLE messages ALTER COLUMN sender_id DROP NOT NULL;
+ALTER TABLE messages DROP CONSTRAINT messages_sender_id_fkey;
+ALTER TABLE messages
+    ADD CONSTRAINT messages_sender_id_fkey FOREIGN KEY (sender_id) REFERENCES users (id) on delete set null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM messages WHERE sender_id IS NULL;
+ALTER TABLE messages DROP CONSTRAINT messages_sender_id_fkey;
+ALTER TABLE messages
+    ADD CONSTRAINT messages_sender_id_fkey FOREIGN KEY (sender_id) REFERENCES users (id) on delete cascade;
+ALTER TABLE messages ALTER COLUMN sender_id SET NOT NULL;
+
+DROP TABLE data_exports;
+
+ALTER TABLE users DROP COLUMN purge_after;
+ALTER TABLE users DROP COLUMN deleted_at;
+
+DROP TYPE data_export_status;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE service_accounts
+(
+    id          uuid primary key      default gen_random_uuid(),
+    name        varchar(255) not null unique,
+    description text         not null default '',
+    created_by  uuid         references users (id) on delete set null,
+    created_at  timestamptz  not null default now(),
+    updated_at  timestamptz  not null default now()
+);
+
+CREATE TABLE api_keys
+(
+    id                 uuid primary key     default gen_random_uuid(),
+    service_account_id uuid          not null references service_accounts (id) on delete cascade,
+    prefix             varchar(32)   not null unique,
+    key_hash           bytea         not null,
+    scopes             varchar(64)[] not null,
+    last_used_at       timestamptz,
+    revoked_at         timestamptz,
+    created_at         timestamptz   not null default now()
+);
+
+CREATE TABLE chat_service_accounts
+(
+    chat_id            uuid        not null references chats (id) on delete cascade,
+    service_account_id uuid        not null references service_accounts (id) on delete cascade,
+    invited_by         uuid        references users (id) on delete set null,
+    created_at         timestamptz not null default now(),
+
+    primary key (chat_id, service_account_id)
+);
+
+ALTER TABLE messages ADD COLUMN sender_service_account_id uuid references service_accounts (id) on delete set null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE messages DROP COLUMN sender_service_account_id;
+
+DROP TABLE chat_service_accounts;
+DROP TABLE api_keys;
+DROP TABLE service_accounts;
+-- +goose StatementEnd
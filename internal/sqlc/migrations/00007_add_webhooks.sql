@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE webhook_delivery_status AS ENUM (
+    'PENDING',
+    'FAILED',
+    'DELIVERED',
+    'DEAD'
+);
+
+CREATE TABLE webhook_subscriptions
+(
+    id          uuid primary key       default gen_random_uuid(),
+    url         varchar(2048) not null,
+    event_types varchar(64)[] not null,
+    secret      varchar(255)  not null,
+    active      bool          not null default true,
+    created_by  uuid          references users (id) on delete set null,
+    created_at  timestamptz   not null default now(),
+    updated_at  timestamptz   not null default now()
+);
+
+CREATE TABLE webhook_deliveries
+(
+    id                 uuid primary key                 default gen_random_uuid(),
+    subscription_id    uuid                    not null references webhook_subscriptions (id) on delete cascade,
+    event_type         varchar(64)             not null,
+    payload            jsonb                   not null,
+    status             webhook_delivery_status not null default 'PENDING'::webhook_delivery_status,
+    attempts           integer                 not null default 0,
+    next_attempt_at    timestamptz             not null default now(),
+    last_attempt_at    timestamptz,
+    last_response_code integer,
+    last_error         text,
+    created_at         timestamptz             not null default now(),
+    updated_at         timestamptz             not null default now()
+);
+
+CREATE INDEX webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at)
+    WHERE status IN ('PENDING', 'FAILED');
+CREATE INDEX webhook_deliveries_subscription_idx ON webhook_deliveries (subscription_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_deliveries;
+DROP TABLE webhook_subscriptions;
+
+DROP TYPE webhook_delivery_status;
+-- +goose StatementEnd
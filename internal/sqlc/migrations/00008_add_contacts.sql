@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE contact_request_status AS ENUM (
+    'PENDING',
+    'ACCEPTED',
+    'DECLINED',
+    'CANCELLED'
+);
+
+CREATE TYPE visibility_level AS ENUM (
+    'EVERYONE',
+    'CONTACTS',
+    'NOBODY'
+);
+
+CREATE TABLE contact_requests
+(
+    id          uuid primary key                default gen_random_uuid(),
+    sender_id   uuid                   not null references users (id) on delete cascade,
+    receiver_id uuid                   not null references users (id) on delete cascade,
+    status      contact_request_status not null default 'PENDING'::contact_request_status,
+    created_at  timestamptz            not null default now(),
+    updated_at  timestamptz            not null default now(),
+
+    check (sender_id <> receiver_id)
+);
+
+CREATE UNIQUE INDEX contact_requests_pending_pair_idx
+    ON contact_requests (least(sender_id, receiver_id), greatest(sender_id, receiver_id))
+    WHERE status = 'PENDING';
+
+CREATE TABLE contacts
+(
+    user_id    uuid        not null references users (id) on delete cascade,
+    contact_id uuid        not null references users (id) on delete cascade,
+    created_at timestamptz not null default now(),
+
+    primary key (user_id, contact_id)
+);
+
+ALTER TABLE users ADD COLUMN profile_visibility visibility_level not null default 'EVERYONE'::visibility_level;
+ALTER TABLE users ADD COLUMN presence_visibility visibility_level not null default 'CONTACTS'::visibility_level;
+ALTER TABLE users ADD COLUMN private_chat_permission visibility_level not null default 'EVERYONE'::visibility_level;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN private_chat_permission;
+ALTER TABLE users DROP COLUMN presence_visibility;
+ALTER TABLE users DROP COLUMN profile_visibility;
+
+DROP TABLE contacts;
+DROP TABLE contact_requests;
+
+DROP TYPE visibility_level;
+DROP TYPE contact_request_status;
+-- +goose StatementEnd
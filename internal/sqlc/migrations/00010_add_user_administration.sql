@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE admin_action_type AS ENUM (
+    'ROLE_CHANGED',
+    'SUSPENDED',
+    'SUSPENSION_LIFTED',
+    'FORCED_LOGOUT'
+);
+
+ALTER TABLE users ADD COLUMN suspended_until timestamptz;
+ALTER TABLE users ADD COLUMN suspension_reason text;
+ALTER TABLE users ADD COLUMN session_version integer not null default 0;
+
+CREATE TABLE admin_actions
+(
+    id             uuid primary key           default gen_random_uuid(),
+    admin_id       uuid references users (id) on delete set null,
+    target_user_id uuid references users (id) on delete set null,
+    action         admin_action_type not null,
+    details        jsonb             not null default '{}'::jsonb,
+    created_at     timestamptz       not null default now()
+);
+
+CREATE INDEX admin_actions_target_user_idx ON admin_actions (target_user_id, created_at);
+CREATE INDEX users_created_at_idx ON users (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX users_created_at_idx;
+DROP TABLE admin_actions;
+
+ALTER TABLE users DROP COLUMN session_version;
+ALTER TABLE users DROP COLUMN suspension_reason;
+ALTER TABLE users DROP COLUMN suspended_until;
+
+DROP TYPE admin_action_type;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE audit_actor_type AS ENUM (
+    'USER',
+    'SERVICE_ACCOUNT',
+    'SYSTEM',
+    'ANONYMOUS'
+);
+
+-- actor and target ids aren't foreign keys, so the entries outlive the users and resources they mention
+CREATE TABLE audit_log
+(
+    id          uuid primary key          default gen_random_uuid(),
+    actor_type  audit_actor_type not null,
+    actor_id    uuid,
+    action      varchar(255)     not null,
+    target_type varchar(255)     not null,
+    target_id   varchar(255)     not null,
+    changes     jsonb            not null default '{}'::jsonb,
+    ip          varchar(64),
+    request_id  varchar(128),
+    created_at  timestamptz      not null default now()
+);
+
+CREATE INDEX audit_log_created_at_idx ON audit_log (created_at, id);
+CREATE INDEX audit_log_actor_idx ON audit_log (actor_id, created_at);
+CREATE INDEX audit_log_target_idx ON audit_log (target_type, target_id, created_at);
+
+CREATE FUNCTION prevent_audit_log_modification() RETURNS trigger AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE OR TRUNCATE
+    ON audit_log
+    FOR EACH STATEMENT
+EXECUTE FUNCTION prevent_audit_log_modification();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER audit_log_append_only ON audit_log;
+DROP FUNCTION prevent_audit_log_modification();
+DROP TABLE audit_log;
+DROP TYPE audit_actor_type;
+-- +goose StatementEnd
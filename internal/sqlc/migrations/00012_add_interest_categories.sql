@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE interest_categories
+(
+    id         uuid primary key      default gen_random_uuid(),
+    parent_id  uuid references interest_categories (id) on delete restrict,
+    title      varchar(255) not null,
+    position   int          not null default 0,
+    created_at timestamptz  not null default now(),
+    updated_at timestamptz  not null default now()
+);
+
+CREATE INDEX interest_categories_parent_idx ON interest_categories (parent_id, position);
+
+-- interests of a removed category stay, they just become uncategorized
+ALTER TABLE interests ADD COLUMN category_id uuid references interest_categories (id) on delete set null;
+ALTER TABLE interests ADD COLUMN position int not null default 0;
+
+CREATE INDEX interests_category_idx ON interests (category_id, position);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX interests_category_idx;
+ALTER TABLE interests DROP COLUMN position;
+ALTER TABLE interests DROP COLUMN category_id;
+DROP TABLE interest_categories;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the 'simple' configuration doesn't stem, so the search works the same for every language of the titles
+CREATE INDEX interests_search_idx ON interests
+    USING GIN (to_tsvector('simple', title || ' ' || description));
+
+CREATE INDEX interests_created_at_idx ON interests (created_at, id);
+CREATE INDEX interests_title_idx ON interests (title, id);
+CREATE INDEX user_interests_interest_idx ON user_interests (interest_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX user_interests_interest_idx;
+DROP INDEX interests_title_idx;
+DROP INDEX interests_created_at_idx;
+DROP INDEX interests_search_idx;
+-- +goose StatementEnd
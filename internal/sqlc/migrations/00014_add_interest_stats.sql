@@ -0,0 +1,138 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the counters are kept by the triggers below, so reading the statistics never scans user_interests
+CREATE TABLE interest_stats
+(
+    interest_id uuid primary key references interests (id) on delete cascade,
+    users_count bigint      not null default 0,
+    updated_at  timestamptz not null default now()
+);
+
+CREATE INDEX interest_stats_users_count_idx ON interest_stats (users_count);
+
+-- days are in utc, so the windows don't depend on the timezone of the connection
+CREATE TABLE interest_daily_stats
+(
+    interest_id uuid   not null references interests (id) on delete cascade,
+    day         date   not null,
+    added       bigint not null default 0,
+    removed     bigint not null default 0,
+    primary key (interest_id, day)
+);
+
+CREATE INDEX interest_daily_stats_day_idx ON interest_daily_stats (day);
+
+-- changes is a set of (interest_id, delta) rows, the counters of removed interests are skipped
+CREATE FUNCTION apply_interest_stats_changes(changes jsonb) RETURNS void AS
+$$
+BEGIN
+    INSERT INTO interest_stats (interest_id, users_count, updated_at)
+    SELECT change.interest_id, SUM(change.delta), now()
+    FROM jsonb_to_recordset(changes) AS change(interest_id uuid, delta bigint)
+    JOIN interests ON interests.id = change.interest_id
+    GROUP BY change.interest_id
+    ON CONFLICT (interest_id) DO UPDATE
+        SET users_count = interest_stats.users_count + EXCLUDED.users_count,
+            updated_at  = now();
+
+    INSERT INTO interest_daily_stats (interest_id, day, added, removed)
+    SELECT change.interest_id,
+           (now() AT TIME ZONE 'UTC')::date,
+           COALESCE(SUM(change.delta) FILTER (WHERE change.delta > 0), 0),
+           COALESCE(-SUM(change.delta) FILTER (WHERE change.delta < 0), 0)
+    FROM jsonb_to_recordset(changes) AS change(interest_id uuid, delta bigint)
+    JOIN interests ON interests.id = change.interest_id
+    GROUP BY change.interest_id
+    ON CONFLICT (interest_id, day) DO UPDATE
+        SET added   = interest_daily_stats.added + EXCLUDED.added,
+            removed = interest_daily_stats.removed + EXCLUDED.removed;
+END;
+$$ LANGUAGE plpgsql;
+
+-- interests of deleted users are not counted, they are subtracted once the user is soft deleted and added
+-- back when the user is restored. Only soft deleted users are purged, and their users row is already gone
+-- when the rows of user_interests removed by the cascade reach count_removed_user_interests, so the join
+-- skips them and purging never subtracts the interests twice. The deleted users can't change their
+-- interests, so the counters of their interests don't change until they are restored or purged
+CREATE FUNCTION count_added_user_interests() RETURNS trigger AS
+$$
+BEGIN
+    PERFORM apply_interest_stats_changes(
+        (SELECT COALESCE(jsonb_agg(jsonb_build_object('interest_id', added.interest_id, 'delta', 1)), '[]'::jsonb)
+         FROM added_user_interests AS added
+         JOIN users ON users.id = added.user_id AND users.deleted_at IS NULL)
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION count_removed_user_interests() RETURNS trigger AS
+$$
+BEGIN
+    PERFORM apply_interest_stats_changes(
+        (SELECT COALESCE(jsonb_agg(jsonb_build_object('interest_id', removed.interest_id, 'delta', -1)), '[]'::jsonb)
+         FROM removed_user_interests AS removed
+         JOIN users ON users.id = removed.user_id AND users.deleted_at IS NULL)
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION count_deleted_user_interests() RETURNS trigger AS
+$$
+BEGIN
+    IF (OLD.deleted_at IS NULL) = (NEW.deleted_at IS NULL) THEN
+        RETURN NULL;
+    END IF;
+
+    PERFORM apply_interest_stats_changes(
+        (SELECT COALESCE(
+                    jsonb_agg(jsonb_build_object(
+                        'interest_id', user_interests.interest_id,
+                        'delta', CASE WHEN NEW.deleted_at IS NULL THEN 1 ELSE -1 END
+                    )),
+                    '[]'::jsonb
+                )
+         FROM user_interests
+         WHERE user_interests.user_id = NEW.id)
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER user_interests_added
+    AFTER INSERT ON user_interests
+    REFERENCING NEW TABLE AS added_user_interests
+    FOR EACH STATEMENT
+EXECUTE FUNCTION count_added_user_interests();
+
+CREATE TRIGGER user_interests_removed
+    AFTER DELETE ON user_interests
+    REFERENCING OLD TABLE AS removed_user_interests
+    FOR EACH STATEMENT
+EXECUTE FUNCTION count_removed_user_interests();
+
+CREATE TRIGGER users_deletion_changed
+    AFTER UPDATE OF deleted_at ON users
+    FOR EACH ROW
+EXECUTE FUNCTION count_deleted_user_interests();
+
+INSERT INTO interest_stats (interest_id, users_count)
+SELECT user_interests.interest_id, COUNT(*)
+FROM user_interests
+JOIN users ON users.id = user_interests.user_id AND users.deleted_at IS NULL
+GROUP BY user_interests.interest_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER users_deletion_changed ON users;
+DROP TRIGGER user_interests_removed ON user_interests;
+DROP TRIGGER user_interests_added ON user_interests;
+DROP FUNCTION count_deleted_user_interests();
+DROP FUNCTION count_removed_user_interests();
+DROP FUNCTION count_added_user_interests();
+DROP FUNCTION apply_interest_stats_changes(jsonb);
+DROP TABLE interest_daily_stats;
+DROP TABLE interest_stats;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the title and the description of the interest itself are in the default locale, the translations
+-- override them for the other locales, locales are stored normalized, e.g. "pt-br"
+CREATE TABLE interest_translations
+(
+    interest_id uuid         not null references interests (id) on delete cascade,
+    locale      varchar(35)  not null,
+    title       varchar(255) not null,
+    description text         not null default '',
+    created_at  timestamptz  not null default now(),
+    updated_at  timestamptz  not null default now(),
+    primary key (interest_id, locale)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE interest_translations;
+-- +goose StatementEnd
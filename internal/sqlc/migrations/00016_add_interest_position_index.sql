@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the order of the interests within their categories, the listing sorted by position reads it
+CREATE INDEX interests_position_idx ON interests (position, title, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX interests_position_idx;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the sizes of the thumbnails stored next to the icon, the missing sizes are served by the icon itself,
+-- it is null for the icons stored before, whose thumbnails are looked up in the storage
+ALTER TABLE interests ADD COLUMN icon_thumbnail_sizes integer[];
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE interests DROP COLUMN icon_thumbnail_sizes;
+-- +goose StatementEnd
@@ -0,0 +1,67 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the admin actions are recorded in the audit log only. The actions recorded before the audit log was
+-- added are moved there with their details converted to changes, the later ones are already there, as
+-- both were written in the same transaction and got the same created_at
+INSERT INTO audit_log
+(actor_type, actor_id, action, target_type, target_id, changes, created_at)
+SELECT 'USER',
+       admin_actions.admin_id,
+       CASE admin_actions.action
+           WHEN 'ROLE_CHANGED' THEN 'user.role_changed'
+           WHEN 'SUSPENDED' THEN 'user.suspended'
+           WHEN 'SUSPENSION_LIFTED' THEN 'user.suspension_lifted'
+           WHEN 'FORCED_LOGOUT' THEN 'user.forced_logout'
+           END,
+       'user',
+       COALESCE(admin_actions.target_user_id::text, ''),
+       CASE admin_actions.action
+           WHEN 'ROLE_CHANGED' THEN jsonb_build_object(
+                   'role', jsonb_build_object('before', details -> 'previous_role', 'after', details -> 'role'))
+           WHEN 'SUSPENDED' THEN jsonb_build_object(
+                   'suspended_until', jsonb_build_object('before', NULL, 'after', details -> 'suspended_until'),
+                   'suspension_reason', jsonb_build_object('before', NULL, 'after', details -> 'reason'))
+           WHEN 'SUSPENSION_LIFTED' THEN jsonb_build_object(
+                   'suspended_until', jsonb_build_object('before', details -> 'suspended_until', 'after', NULL),
+                   'suspension_reason', jsonb_build_object('before', details -> 'reason', 'after', NULL))
+           WHEN 'FORCED_LOGOUT' THEN jsonb_build_object(
+                   'session_version', jsonb_build_object(
+                           'before', (details ->> 'session_version')::int - 1,
+                           'after', (details ->> 'session_version')::int))
+           END,
+       admin_actions.created_at
+FROM admin_actions
+WHERE NOT EXISTS (SELECT
+                  FROM audit_log
+                  WHERE audit_log.target_type = 'user'
+                    AND audit_log.target_id = admin_actions.target_user_id::text
+                    AND audit_log.created_at = admin_actions.created_at
+                    AND audit_log.action IN
+                        ('user.role_changed', 'user.suspended', 'user.suspension_lifted', 'user.forced_logout'));
+
+DROP TABLE admin_actions;
+DROP TYPE admin_action_type;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- the moved actions stay in the audit log, which can't be changed
+CREATE TYPE admin_action_type AS ENUM (
+    'ROLE_CHANGED',
+    'SUSPENDED',
+    'SUSPENSION_LIFTED',
+    'FORCED_LOGOUT'
+);
+
+CREATE TABLE admin_actions
+(
+    id             uuid primary key           default gen_random_uuid(),
+    admin_id       uuid references users (id) on delete set null,
+    target_user_id uuid references users (id) on delete set null,
+    action         admin_action_type not null,
+    details        jsonb             not null default '{}'::jsonb,
+    created_at     timestamptz       not null default now()
+);
+
+CREATE INDEX admin_actions_target_user_idx ON admin_actions (target_user_id, created_at);
+-- +goose StatementEnd
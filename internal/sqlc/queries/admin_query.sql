@@ -0,0 +1,86 @@
+-- name: SearchUsers :many
+SELECT *
+FROM users
+WHERE
+    (sqlc.narg('role')::role_type IS NULL OR users.role = sqlc.narg('role')::role_type)
+  AND
+    (sqlc.narg('email_verified')::bool IS NULL OR users.email_verified = sqlc.narg('email_verified')::bool)
+  AND
+    (sqlc.narg('online')::bool IS NULL OR users.online = sqlc.narg('online')::bool)
+  AND
+    (sqlc.narg('created_from')::timestamptz IS NULL OR users.created_at >= sqlc.narg('created_from')::timestamptz)
+  AND
+    (sqlc.narg('created_to')::timestamptz IS NULL OR users.created_at < sqlc.narg('created_to')::timestamptz)
+  AND
+    (
+        sqlc.narg('search')::text IS NULL
+        OR users.full_name ILIKE '%' || sqlc.narg('search')::text || '%' ESCAPE '\'
+        OR users.email ILIKE '%' || sqlc.narg('search')::text || '%' ESCAPE '\'
+    )
+ORDER BY
+    CASE WHEN @sort_by::text = 'full_name' AND NOT @descending::bool THEN users.full_name END,
+    CASE WHEN @sort_by::text = 'full_name' AND @descending::bool THEN users.full_name END DESC,
+    CASE WHEN @sort_by::text = 'email' AND NOT @descending::bool THEN users.email END,
+    CASE WHEN @sort_by::text = 'email' AND @descending::bool THEN users.email END DESC,
+    CASE WHEN @sort_by::text = 'last_seen' AND NOT @descending::bool THEN users.last_seen END,
+    CASE WHEN @sort_by::text = 'last_seen' AND @descending::bool THEN users.last_seen END DESC,
+    CASE WHEN @sort_by::text = 'created_at' AND NOT @descending::bool THEN users.created_at END,
+    CASE WHEN @sort_by::text = 'created_at' AND @descending::bool THEN users.created_at END DESC,
+    users.id
+LIMIT @max_count OFFSET @skip_count;
+
+-- name: CountSearchedUsers :one
+SELECT COUNT(*)
+FROM users
+WHERE
+    (sqlc.narg('role')::role_type IS NULL OR users.role = sqlc.narg('role')::role_type)
+  AND
+    (sqlc.narg('email_verified')::bool IS NULL OR users.email_verified = sqlc.narg('email_verified')::bool)
+  AND
+    (sqlc.narg('online')::bool IS NULL OR users.online = sqlc.narg('online')::bool)
+  AND
+    (sqlc.narg('created_from')::timestamptz IS NULL OR users.created_at >= sqlc.narg('created_from')::timestamptz)
+  AND
+    (sqlc.narg('created_to')::timestamptz IS NULL OR users.created_at < sqlc.narg('created_to')::timestamptz)
+  AND
+    (
+        sqlc.narg('search')::text IS NULL
+        OR users.full_name ILIKE '%' || sqlc.narg('search')::text || '%' ESCAPE '\'
+        OR users.email ILIKE '%' || sqlc.narg('search')::text || '%' ESCAPE '\'
+    );
+
+-- name: SetUserRole :one
+UPDATE users
+SET
+    role = @role,
+    updated_at = now()
+WHERE users.id = @id
+RETURNING *;
+
+-- name: SuspendUser :one
+UPDATE users
+SET
+    suspended_until = @suspended_until,
+    suspension_reason = @suspension_reason,
+    online = false,
+    updated_at = now()
+WHERE users.id = @id
+RETURNING *;
+
+-- name: LiftUserSuspension :one
+UPDATE users
+SET
+    suspended_until = null,
+    suspension_reason = null,
+    updated_at = now()
+WHERE users.id = @id
+RETURNING *;
+
+-- name: IncrementSessionVersion :one
+UPDATE users
+SET
+    session_version = session_version + 1,
+    online = false,
+    updated_at = now()
+WHERE users.id = @id
+RETURNING *;
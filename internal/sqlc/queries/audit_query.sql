@@ -0,0 +1,50 @@
+-- name: CreateAuditLogEntry :exec
+INSERT INTO audit_log
+(actor_type, actor_id, action, target_type, target_id, changes, ip, request_id)
+VALUES
+(@actor_type, @actor_id, @action, @target_type, @target_id, @changes, @ip, @request_id);
+
+-- name: GetAuditLogEntries :many
+SELECT *
+FROM audit_log
+WHERE
+    (sqlc.narg('actor_id')::uuid IS NULL OR audit_log.actor_id = sqlc.narg('actor_id')::uuid)
+  AND
+    (sqlc.narg('action')::text IS NULL OR audit_log.action = sqlc.narg('action')::text)
+  AND
+    (sqlc.narg('target_type')::text IS NULL OR audit_log.target_type = sqlc.narg('target_type')::text)
+  AND
+    (sqlc.narg('target_id')::text IS NULL OR audit_log.target_id = sqlc.narg('target_id')::text)
+  AND
+    (sqlc.narg('request_id')::text IS NULL OR audit_log.request_id = sqlc.narg('request_id')::text)
+  AND
+    (sqlc.narg('created_from')::timestamptz IS NULL OR audit_log.created_at >= sqlc.narg('created_from')::timestamptz)
+  AND
+    (sqlc.narg('created_to')::timestamptz IS NULL OR audit_log.created_at < sqlc.narg('created_to')::timestamptz)
+ORDER BY audit_log.created_at DESC, audit_log.id DESC
+LIMIT @max_count OFFSET @skip_count;
+
+-- name: GetAuditLogEntriesAfter :many
+SELECT *
+FROM audit_log
+WHERE
+    (sqlc.narg('actor_id')::uuid IS NULL OR audit_log.actor_id = sqlc.narg('actor_id')::uuid)
+  AND
+    (sqlc.narg('action')::text IS NULL OR audit_log.action = sqlc.narg('action')::text)
+  AND
+    (sqlc.narg('target_type')::text IS NULL OR audit_log.target_type = sqlc.narg('target_type')::text)
+  AND
+    (sqlc.narg('target_id')::text IS NULL OR audit_log.target_id = sqlc.narg('target_id')::text)
+  AND
+    (sqlc.narg('request_id')::text IS NULL OR audit_log.request_id = sqlc.narg('request_id')::text)
+  AND
+    (sqlc.narg('created_from')::timestamptz IS NULL OR audit_log.created_at >= sqlc.narg('created_from')::timestamptz)
+  AND
+    (sqlc.narg('created_to')::timestamptz IS NULL OR audit_log.created_at < sqlc.narg('created_to')::timestamptz)
+  AND
+    (
+        sqlc.narg('after_created_at')::timestamptz IS NULL
+        OR (audit_log.created_at, audit_log.id) > (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')::uuid)
+    )
+ORDER BY audit_log.created_at, audit_log.id
+LIMIT @max_count;
@@ -0,0 +1,89 @@
+-- name: GetUserChats :many
+SELECT chats.*
+FROM chats
+JOIN user_chats ON chats.id = user_chats.chat_id
+WHERE user_chats.user_id = @user_id;
+
+-- name: GetUserMessages :many
+SELECT *
+FROM messages
+WHERE messages.sender_id = @sender_id::uuid
+ORDER BY messages.created_at;
+
+-- name: GetUserAttachments :many
+SELECT attachments.*
+FROM attachments
+JOIN messages ON attachments.message_id = messages.id
+WHERE messages.sender_id = @sender_id::uuid;
+
+-- name: GetUserOwnedChatAttachments :many
+SELECT attachments.*
+FROM attachments
+JOIN messages ON attachments.message_id = messages.id
+JOIN user_chats ON messages.chat_id = user_chats.chat_id
+WHERE
+    user_chats.user_id = @user_id
+  AND
+    NOT EXISTS (
+        SELECT 1
+        FROM user_chats AS members
+        WHERE members.chat_id = user_chats.chat_id AND members.user_id <> @user_id
+    );
+
+-- name: RemoveUserOwnedChats :exec
+DELETE FROM chats
+WHERE
+    chats.id IN (
+        SELECT user_chats.chat_id
+        FROM user_chats
+        WHERE user_chats.user_id = @user_id
+    )
+  AND
+    NOT EXISTS (
+        SELECT 1
+        FROM user_chats AS members
+        WHERE members.chat_id = chats.id AND members.user_id <> @user_id
+    );
+
+-- name: AnonymizeUserMessages :exec
+UPDATE messages
+SET
+    sender_id = null,
+    updated_at = now()
+WHERE messages.sender_id = @sender_id::uuid;
+
+-- name: IsUserInChat :one
+SELECT COUNT(*) > 0
+FROM user_chats
+WHERE user_chats.user_id = @user_id AND user_chats.chat_id = @chat_id;
+
+-- name: MessageExistsInChat :one
+SELECT COUNT(id) > 0
+FROM messages
+WHERE messages.id = @id AND messages.chat_id = @chat_id;
+
+-- name: GetPrivateChatBetween :one
+SELECT chats.*
+FROM chats
+JOIN user_chats AS first_member ON chats.id = first_member.chat_id
+JOIN user_chats AS second_member ON chats.id = second_member.chat_id
+WHERE
+    chats.c_type = 'PRIVATE_CHAT'::chat_type
+  AND
+    first_member.user_id = @first_user_id
+  AND
+    second_member.user_id = @second_user_id
+LIMIT 1;
+
+-- name: CreateChat :one
+INSERT INTO chats
+(title, c_type)
+VALUES
+(@title, @c_type)
+RETURNING *;
+
+-- name: AddUserToChat :exec
+INSERT INTO user_chats
+(user_id, chat_id)
+VALUES
+(@user_id, @chat_id);
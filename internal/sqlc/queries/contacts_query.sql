@@ -0,0 +1,107 @@
+-- name: CreateContactRequest :one
+INSERT INTO contact_requests
+(sender_id, receiver_id)
+VALUES
+(@sender_id, @receiver_id)
+RETURNING *;
+
+-- name: GetContactRequestById :one
+SELECT *
+FROM contact_requests
+WHERE contact_requests.id = @id;
+
+-- name: PendingContactRequestExists :one
+SELECT COUNT(id) > 0
+FROM contact_requests
+WHERE
+    status = 'PENDING'::contact_request_status
+  AND
+    (
+        (sender_id = @first_user_id AND receiver_id = @second_user_id)
+        OR
+        (sender_id = @second_user_id AND receiver_id = @first_user_id)
+    );
+
+-- name: ResolveContactRequest :one
+UPDATE contact_requests
+SET
+    status = @status::contact_request_status,
+    updated_at = now()
+WHERE contact_requests.id = @id AND contact_requests.status = 'PENDING'::contact_request_status
+RETURNING *;
+
+-- name: GetIncomingContactRequests :many
+SELECT *
+FROM contact_requests
+WHERE
+    contact_requests.receiver_id = @user_id
+  AND
+    contact_requests.status = 'PENDING'::contact_request_status
+  AND
+    -- the users scheduled for deletion are hidden from the others
+    NOT EXISTS (SELECT 1 FROM users WHERE users.id = contact_requests.sender_id AND users.deleted_at IS NOT NULL)
+ORDER BY contact_requests.created_at DESC
+LIMIT @max_count OFFSET @skip_count;
+
+-- name: GetOutgoingContactRequests :many
+SELECT *
+FROM contact_requests
+WHERE
+    contact_requests.sender_id = @user_id
+  AND
+    contact_requests.status = 'PENDING'::contact_request_status
+  AND
+    -- the users scheduled for deletion are hidden from the others
+    NOT EXISTS (SELECT 1 FROM users WHERE users.id = contact_requests.receiver_id AND users.deleted_at IS NOT NULL)
+ORDER BY contact_requests.created_at DESC
+LIMIT @max_count OFFSET @skip_count;
+
+-- name: AddContact :exec
+INSERT INTO contacts
+(user_id, contact_id)
+VALUES
+(@user_id, @contact_id),
+(@contact_id, @user_id)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveContact :execrows
+DELETE FROM contacts
+WHERE
+    (contacts.user_id = @user_id AND contacts.contact_id = @contact_id)
+   OR
+    (contacts.user_id = @contact_id AND contacts.contact_id = @user_id);
+
+-- name: AreContacts :one
+SELECT COUNT(*) > 0
+FROM contacts
+WHERE contacts.user_id = @user_id AND contacts.contact_id = @contact_id;
+
+-- name: GetUserContacts :many
+SELECT
+    users.id,
+    users.full_name,
+    users.online,
+    users.last_seen,
+    users.presence_visibility,
+    contacts.created_at AS contact_since
+FROM contacts
+JOIN users ON contacts.contact_id = users.id
+WHERE contacts.user_id = @user_id AND users.deleted_at IS NULL
+ORDER BY users.full_name
+LIMIT @max_count OFFSET @skip_count;
+
+-- name: CountUserContacts :one
+SELECT COUNT(*)
+FROM contacts
+JOIN users ON contacts.contact_id = users.id
+WHERE contacts.user_id = @user_id AND users.deleted_at IS NULL;
+
+-- name: UpdateUserPrivacySettings :one
+UPDATE users
+SET
+    profile_visibility = coalesce(sqlc.narg('profile_visibility'), profile_visibility),
+    presence_visibility = coalesce(sqlc.narg('presence_visibility'), presence_visibility),
+    private_chat_permission = coalesce(sqlc.narg('private_chat_permission'), private_chat_permission),
+    updated_at = now()
+WHERE users.id = @id
+RETURNING *;
@@ -0,0 +1,46 @@
+-- name: CreateDataExport :one
+INSERT INTO data_exports
+(user_id)
+VALUES
+(@user_id)
+RETURNING *;
+
+-- name: GetDataExportById :one
+SELECT *
+FROM data_exports
+WHERE data_exports.id = @id;
+
+-- name: GetUserDataExports :many
+SELECT *
+FROM data_exports
+WHERE data_exports.user_id = @user_id;
+
+-- name: ClaimPendingDataExports :many
+UPDATE data_exports
+SET
+    status = 'PROCESSING'::data_export_status,
+    updated_at = now()
+WHERE data_exports.id IN (
+    SELECT pending.id
+    FROM data_exports AS pending
+    WHERE pending.status = 'PENDING'::data_export_status
+    ORDER BY pending.created_at
+    LIMIT @max_count
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: CompleteDataExport :exec
+UPDATE data_exports
+SET
+    status = 'READY'::data_export_status,
+    file_name = @file_name,
+    updated_at = now()
+WHERE data_exports.id = @id;
+
+-- name: FailDataExport :exec
+UPDATE data_exports
+SET
+    status = 'FAILED'::data_export_status,
+    updated_at = now()
+WHERE data_exports.id = @id;
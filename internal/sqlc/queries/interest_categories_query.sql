@@ -0,0 +1,65 @@
+-- name: CreateInterestCategory :one
+INSERT INTO interest_categories
+(parent_id, title, position)
+VALUES
+(sqlc.narg('parent_id')::uuid, @title, @position)
+RETURNING *;
+
+-- name: GetInterestCategoryById :one
+SELECT *
+FROM interest_categories
+WHERE id = @id;
+
+-- name: GetInterestCategories :many
+SELECT *
+FROM interest_categories
+ORDER BY position, title;
+
+-- name: InterestCategoriesExistenceCheck :one
+SELECT COUNT(id)
+FROM interest_categories
+WHERE id = ANY(@ids::uuid[]);
+
+-- name: UpdateInterestCategory :one
+UPDATE interest_categories
+SET
+    title = COALESCE(sqlc.narg('title')::varchar(255), title),
+    parent_id = CASE
+        WHEN @clear_parent::bool THEN NULL
+        ELSE COALESCE(sqlc.narg('parent_id')::uuid, parent_id)
+    END,
+    position = COALESCE(sqlc.narg('position')::int, position),
+    updated_at = now()
+WHERE id = @id
+RETURNING *;
+
+-- name: DeleteInterestCategory :exec
+DELETE FROM interest_categories
+WHERE id = @id;
+
+-- name: CountInterestSubcategories :one
+SELECT COUNT(id)
+FROM interest_categories
+WHERE parent_id = @id::uuid;
+
+-- name: IsInterestCategoryInSubtree :one
+WITH RECURSIVE subtree(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = @root_id
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN subtree ON interest_categories.parent_id = subtree.id
+)
+SELECT (COUNT(*) > 0)::bool AS in_subtree
+FROM subtree
+WHERE id = @id::uuid;
+
+-- name: LockInterestCategories :exec
+-- the moves lock every category, otherwise the subtree checks of two concurrent moves could both pass and
+-- the moves would form a cycle together
+SELECT id
+FROM interest_categories
+ORDER BY id
+FOR UPDATE;
@@ -0,0 +1,42 @@
+-- name: GetInterestStats :many
+SELECT
+    interests.id,
+    interests.title,
+    COALESCE(interest_stats.users_count, 0)::bigint AS users_count,
+    COALESCE(window_stats.added, 0)::bigint AS added,
+    COALESCE(window_stats.removed, 0)::bigint AS removed
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+LEFT JOIN (
+    SELECT
+        interest_daily_stats.interest_id,
+        SUM(interest_daily_stats.added) AS added,
+        SUM(interest_daily_stats.removed) AS removed
+    FROM interest_daily_stats
+    WHERE interest_daily_stats.day >= @window_start::date
+    GROUP BY interest_daily_stats.interest_id
+) AS window_stats ON window_stats.interest_id = interests.id
+ORDER BY users_count DESC, interests.title, interests.id
+LIMIT sqlc.narg('max_count')::int OFFSET @skip_count;
+
+-- name: GetTrendingInterests :many
+SELECT
+    interests.id,
+    interests.title,
+    COALESCE(interest_stats.users_count, 0)::bigint AS users_count,
+    window_stats.added::bigint AS added,
+    window_stats.removed::bigint AS removed
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+JOIN (
+    SELECT
+        interest_daily_stats.interest_id,
+        SUM(interest_daily_stats.added) AS added,
+        SUM(interest_daily_stats.removed) AS removed
+    FROM interest_daily_stats
+    WHERE interest_daily_stats.day >= @window_start::date
+    GROUP BY interest_daily_stats.interest_id
+) AS window_stats ON window_stats.interest_id = interests.id
+WHERE window_stats.added > window_stats.removed
+ORDER BY window_stats.added - window_stats.removed DESC, users_count DESC, interests.id
+LIMIT @max_count;
@@ -0,0 +1,26 @@
+-- name: GetInterestTranslations :many
+SELECT *
+FROM interest_translations
+WHERE interest_id = ANY(@interest_ids::uuid[])
+ORDER BY interest_id, locale;
+
+-- name: UpsertInterestTranslation :one
+INSERT INTO interest_translations
+(interest_id, locale, title, description)
+VALUES
+(@interest_id, @locale, @title, @description)
+ON CONFLICT (interest_id, locale) DO UPDATE
+    SET title = EXCLUDED.title,
+        description = EXCLUDED.description,
+        updated_at = now()
+RETURNING *;
+
+-- name: GetInterestTranslation :one
+SELECT *
+FROM interest_translations
+WHERE interest_id = @interest_id AND locale = @locale;
+
+-- name: DeleteInterestTranslation :one
+DELETE FROM interest_translations
+WHERE interest_id = @interest_id AND locale = @locale
+RETURNING *;
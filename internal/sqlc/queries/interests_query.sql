@@ -1,10 +1,245 @@
--- name: GetManyInterestsByFilters :many
-SELECT id, title, icon_file_name, created_at, updated_at, description
+-- the listing has a query per sort, so the order is static and the planner can read the pages from the
+-- indexes instead of sorting every matching interest
+-- name: GetInterestsByTitle :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = sqlc.narg('category_id')::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE @include_subcategories::bool
+)
+SELECT sqlc.embed(interests), COALESCE(interest_stats.users_count, 0)::bigint AS popularity
 FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
 WHERE
     (sqlc.narg('ids')::uuid[] IS NULL OR interests.id = ANY(sqlc.narg('ids')::uuid[]))
   AND
-    (sqlc.narg('name')::text IS NULL OR interests.title ILIKE sqlc.narg('name')::text || '%');
+    (
+        sqlc.narg('search')::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', sqlc.narg('search')::text)
+    )
+  AND
+    (sqlc.narg('category_id')::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    (sqlc.narg('after_id')::uuid IS NULL OR (interests.title, interests.id) > (sqlc.narg('after_title')::text, sqlc.narg('after_id')::uuid))
+ORDER BY interests.title, interests.id
+LIMIT @max_count;
+
+-- name: GetInterestsByTitleDesc :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = sqlc.narg('category_id')::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE @include_subcategories::bool
+)
+SELECT sqlc.embed(interests), COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    (sqlc.narg('ids')::uuid[] IS NULL OR interests.id = ANY(sqlc.narg('ids')::uuid[]))
+  AND
+    (
+        sqlc.narg('search')::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', sqlc.narg('search')::text)
+    )
+  AND
+    (sqlc.narg('category_id')::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    (sqlc.narg('after_id')::uuid IS NULL OR (interests.title, interests.id) < (sqlc.narg('after_title')::text, sqlc.narg('after_id')::uuid))
+ORDER BY interests.title DESC, interests.id DESC
+LIMIT @max_count;
+
+-- name: GetInterestsByCreatedAt :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = sqlc.narg('category_id')::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE @include_subcategories::bool
+)
+SELECT sqlc.embed(interests), COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    (sqlc.narg('ids')::uuid[] IS NULL OR interests.id = ANY(sqlc.narg('ids')::uuid[]))
+  AND
+    (
+        sqlc.narg('search')::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', sqlc.narg('search')::text)
+    )
+  AND
+    (sqlc.narg('category_id')::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    (sqlc.narg('after_id')::uuid IS NULL OR (interests.created_at, interests.id) > (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')::uuid))
+ORDER BY interests.created_at, interests.id
+LIMIT @max_count;
+
+-- name: GetInterestsByCreatedAtDesc :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = sqlc.narg('category_id')::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE @include_subcategories::bool
+)
+SELECT sqlc.embed(interests), COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    (sqlc.narg('ids')::uuid[] IS NULL OR interests.id = ANY(sqlc.narg('ids')::uuid[]))
+  AND
+    (
+        sqlc.narg('search')::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', sqlc.narg('search')::text)
+    )
+  AND
+    (sqlc.narg('category_id')::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    (sqlc.narg('after_id')::uuid IS NULL OR (interests.created_at, interests.id) < (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')::uuid))
+ORDER BY interests.created_at DESC, interests.id DESC
+LIMIT @max_count;
+
+-- name: GetInterestsByPopularity :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = sqlc.narg('category_id')::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE @include_subcategories::bool
+)
+SELECT sqlc.embed(interests), COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    (sqlc.narg('ids')::uuid[] IS NULL OR interests.id = ANY(sqlc.narg('ids')::uuid[]))
+  AND
+    (
+        sqlc.narg('search')::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', sqlc.narg('search')::text)
+    )
+  AND
+    (sqlc.narg('category_id')::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    (sqlc.narg('after_id')::uuid IS NULL OR (COALESCE(interest_stats.users_count, 0), interests.id) > (sqlc.narg('after_popularity')::bigint, sqlc.narg('after_id')::uuid))
+ORDER BY popularity, interests.id
+LIMIT @max_count;
+
+-- name: GetInterestsByPopularityDesc :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = sqlc.narg('category_id')::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE @include_subcategories::bool
+)
+SELECT sqlc.embed(interests), COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    (sqlc.narg('ids')::uuid[] IS NULL OR interests.id = ANY(sqlc.narg('ids')::uuid[]))
+  AND
+    (
+        sqlc.narg('search')::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', sqlc.narg('search')::text)
+    )
+  AND
+    (sqlc.narg('category_id')::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    (sqlc.narg('after_id')::uuid IS NULL OR (COALESCE(interest_stats.users_count, 0), interests.id) < (sqlc.narg('after_popularity')::bigint, sqlc.narg('after_id')::uuid))
+ORDER BY popularity DESC, interests.id DESC
+LIMIT @max_count;
+
+-- name: GetInterestsByPosition :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = sqlc.narg('category_id')::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE @include_subcategories::bool
+)
+SELECT sqlc.embed(interests), COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    (sqlc.narg('ids')::uuid[] IS NULL OR interests.id = ANY(sqlc.narg('ids')::uuid[]))
+  AND
+    (
+        sqlc.narg('search')::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', sqlc.narg('search')::text)
+    )
+  AND
+    (sqlc.narg('category_id')::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    (sqlc.narg('after_id')::uuid IS NULL OR (interests.position, interests.title, interests.id) > (sqlc.narg('after_position')::int, sqlc.narg('after_title')::text, sqlc.narg('after_id')::uuid))
+ORDER BY interests.position, interests.title, interests.id
+LIMIT @max_count;
+
+-- name: GetInterestsByPositionDesc :many
+WITH RECURSIVE selected_categories(id) AS (
+    SELECT interest_categories.id
+    FROM interest_categories
+    WHERE interest_categories.id = sqlc.narg('category_id')::uuid
+    UNION
+    SELECT interest_categories.id
+    FROM interest_categories
+    JOIN selected_categories ON interest_categories.parent_id = selected_categories.id
+    WHERE @include_subcategories::bool
+)
+SELECT sqlc.embed(interests), COALESCE(interest_stats.users_count, 0)::bigint AS popularity
+FROM interests
+LEFT JOIN interest_stats ON interest_stats.interest_id = interests.id
+WHERE
+    (sqlc.narg('ids')::uuid[] IS NULL OR interests.id = ANY(sqlc.narg('ids')::uuid[]))
+  AND
+    (
+        sqlc.narg('search')::text IS NULL
+        OR to_tsvector('simple', interests.title || ' ' || interests.description) @@ to_tsquery('simple', sqlc.narg('search')::text)
+    )
+  AND
+    (sqlc.narg('category_id')::uuid IS NULL OR interests.category_id IN (SELECT id FROM selected_categories))
+  AND
+    (sqlc.narg('after_id')::uuid IS NULL OR (interests.position, interests.title, interests.id) < (sqlc.narg('after_position')::int, sqlc.narg('after_title')::text, sqlc.narg('after_id')::uuid))
+ORDER BY interests.position DESC, interests.title DESC, interests.id DESC
+LIMIT @max_count;
+
+-- name: GetInterestsByIds :many
+SELECT *
+FROM interests
+WHERE id = ANY(@ids::uuid[])
+ORDER BY position, title;
+
+-- name: GetInterestsByTitles :many
+SELECT *
+FROM interests
+WHERE title = ANY(@titles::text[])
+ORDER BY created_at, id;
+
+-- name: GetAllInterests :many
+SELECT *
+FROM interests
+ORDER BY title, id;
 
 -- name: GetInterestById :one
 SELECT *
@@ -17,44 +252,76 @@ FROM interests
 WHERE id = ANY(@ids::uuid[]);
 
 -- name: GetUserInterests :many
-SELECT 
-    interests.id,
-    interests.title,
-    interests.icon_file_name,
-    interests.created_at,
-    interests.updated_at,
-    interests.description
+SELECT interests.*
 FROM interests
 JOIN user_interests on interests.id = user_interests.interest_id
-WHERE user_interests.user_id = @id;
+WHERE user_interests.user_id = @id
+ORDER BY interests.position, interests.title, interests.id;
 
 -- name: AssignInterestsToUser :exec
 INSERT INTO user_interests
 (user_id, interest_id)
 VALUES
-(@user_id, unnest(@interest_ids::uuid[]));
+(@user_id, unnest(@interest_ids::uuid[]))
+ON CONFLICT DO NOTHING;
 
 -- name: RemoveUserInterests :exec
 DELETE FROM user_interests
 WHERE
     user_id = @user_id;
 
+-- name: LockUserInterests :exec
+-- the changes of the interests of a user are serialized by the lock of the user row, the key isn't
+-- locked, so it doesn't block the inserts referencing the user. The interests are a part of the user,
+-- so the row is touched to change its version as well
+UPDATE users
+SET updated_at = now()
+WHERE id = @user_id;
+
+-- name: AddUserInterests :exec
+INSERT INTO user_interests
+(user_id, interest_id)
+SELECT @user_id::uuid, unnest(@interest_ids::uuid[])
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveSelectedUserInterests :exec
+DELETE FROM user_interests
+WHERE
+    user_id = @user_id AND interest_id = ANY(@interest_ids::uuid[]);
+
+-- name: CountUserInterests :one
+SELECT COUNT(*)
+FROM user_interests
+WHERE user_id = @user_id;
+
 -- name: CreateInterest :one
 INSERT INTO interests
-(title, icon_file_name, description)
+(title, icon_file_name, icon_thumbnail_sizes, description, category_id, position)
 VALUES
-(@title, @icon_file_name, @description)
+(@title, @icon_file_name, @icon_thumbnail_sizes::int[], @description, sqlc.narg('category_id')::uuid, @position)
 RETURNING *;
 
--- name: DeleteInterest :exec
+-- name: DeleteInterest :execrows
 DELETE FROM interests
-WHERE interests.id = @id;
+WHERE
+    interests.id = @id
+  AND
+    (sqlc.narg('expected_versions')::timestamptz[] IS NULL OR interests.updated_at = ANY(sqlc.narg('expected_versions')::timestamptz[]));
 
 -- name: UpdateInterest :one
 UPDATE interests
 SET
-    description = sqlc.narg('description')::text,
-    icon_file_name = sqlc.narg('icon_file_name')::varchar(255)
+    description = COALESCE(sqlc.narg('description')::text, description),
+    icon_file_name = sqlc.narg('icon_file_name')::varchar(255),
+    icon_thumbnail_sizes = COALESCE(sqlc.narg('icon_thumbnail_sizes')::int[], icon_thumbnail_sizes),
+    category_id = CASE
+        WHEN @clear_category::bool THEN NULL
+        ELSE COALESCE(sqlc.narg('category_id')::uuid, category_id)
+    END,
+    position = COALESCE(sqlc.narg('position')::int, position),
+    updated_at = now()
 WHERE
     id = @id
+  AND
+    (sqlc.narg('expected_versions')::timestamptz[] IS NULL OR updated_at = ANY(sqlc.narg('expected_versions')::timestamptz[]))
 RETURNING *;
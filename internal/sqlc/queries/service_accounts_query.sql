@@ -0,0 +1,89 @@
+-- name: CreateServiceAccount :one
+INSERT INTO service_accounts
+(name, description, created_by)
+VALUES
+(@name, @description, @created_by)
+RETURNING *;
+
+-- name: GetServiceAccounts :many
+SELECT *
+FROM service_accounts
+ORDER BY service_accounts.created_at;
+
+-- name: GetServiceAccountById :one
+SELECT *
+FROM service_accounts
+WHERE service_accounts.id = @id;
+
+-- name: ServiceAccountExists :one
+SELECT COUNT(id) > 0
+FROM service_accounts
+WHERE id = @id;
+
+-- name: ServiceAccountNameExists :one
+SELECT COUNT(id) > 0
+FROM service_accounts
+WHERE name = @name;
+
+-- name: CreateApiKey :one
+INSERT INTO api_keys
+(service_account_id, prefix, key_hash, scopes)
+VALUES
+(@service_account_id, @prefix, @key_hash, @scopes::varchar[])
+RETURNING *;
+
+-- name: GetServiceAccountApiKeys :many
+SELECT *
+FROM api_keys
+WHERE api_keys.service_account_id = @service_account_id
+ORDER BY api_keys.created_at;
+
+-- name: GetActiveApiKeyByPrefix :one
+SELECT sqlc.embed(api_keys), sqlc.embed(service_accounts)
+FROM api_keys
+JOIN service_accounts ON api_keys.service_account_id = service_accounts.id
+WHERE api_keys.prefix = @prefix AND api_keys.revoked_at IS NULL;
+
+-- name: RevokeApiKey :one
+UPDATE api_keys
+SET revoked_at = coalesce(revoked_at, now())
+WHERE api_keys.id = @id AND api_keys.service_account_id = @service_account_id
+RETURNING *;
+
+-- name: TouchApiKey :exec
+UPDATE api_keys
+SET last_used_at = now()
+WHERE
+    api_keys.id = @id
+  AND
+    (api_keys.last_used_at IS NULL OR api_keys.last_used_at < now() - interval '1 minute');
+
+-- name: AddServiceAccountToChat :exec
+INSERT INTO chat_service_accounts
+(chat_id, service_account_id, invited_by)
+VALUES
+(@chat_id, @service_account_id, @invited_by)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveServiceAccountFromChat :exec
+DELETE FROM chat_service_accounts
+WHERE chat_service_accounts.chat_id = @chat_id AND chat_service_accounts.service_account_id = @service_account_id;
+
+-- name: IsServiceAccountInChat :one
+SELECT COUNT(*) > 0
+FROM chat_service_accounts
+WHERE chat_service_accounts.chat_id = @chat_id AND chat_service_accounts.service_account_id = @service_account_id;
+
+-- name: GetServiceAccountChats :many
+SELECT chats.*
+FROM chats
+JOIN chat_service_accounts ON chats.id = chat_service_accounts.chat_id
+WHERE chat_service_accounts.service_account_id = @service_account_id
+ORDER BY chats.created_at;
+
+-- name: CreateServiceAccountMessage :one
+INSERT INTO messages
+(chat_id, sender_service_account_id, raw_text, edited, message_reference_id)
+VALUES
+(@chat_id, @sender_service_account_id::uuid, @raw_text, false, @message_reference_id)
+RETURNING *;
@@ -19,6 +19,11 @@ SELECT COUNT(id) > 0
 FROM users
 WHERE id = @id;
 
+-- name: ActiveUserExists :one
+SELECT COUNT(id) > 0
+FROM users
+WHERE id = @id AND deleted_at IS NULL;
+
 -- name: GetUserById :one
 SELECT *
 FROM users
@@ -26,9 +31,9 @@ WHERE users.id = @id;
 
 -- name: CreateUser :one
 INSERT INTO users
-(full_name, birthday, gender, email, password, avatar_file_name, online)
+(full_name, birthday, gender, email, password, avatar_file_name, avatar_generated, online)
 VALUES
-(@full_name, @birthday, @gender::gender, @email, @password, @avatar_file_name, true)
+(@full_name, @birthday, @gender::gender, @email, @password, @avatar_file_name, @avatar_generated, true)
 RETURNING *;
 
 -- name: UpdateUser :one
@@ -41,16 +46,80 @@ SET
     email = coalesce(sqlc.narg('email'), email),
     password = coalesce(sqlc.narg('password'), password),
     avatar_file_name = coalesce(sqlc.narg('avatar_file_name'), avatar_file_name),
+    avatar_generated = coalesce(sqlc.narg('avatar_generated'), avatar_generated),
     role = coalesce(sqlc.narg('role'), role),
     email_verified = case
         when sqlc.narg('email') is null then email_verified
         else false
     end,
     updated_at = now()
-WHERE users.id = @id
+WHERE
+    users.id = @id
+  AND
+    (sqlc.narg('expected_versions')::timestamptz[] IS NULL OR users.updated_at = ANY(sqlc.narg('expected_versions')::timestamptz[]))
 RETURNING *;
 
--- name: RemoveUser :exec
+-- name: LockUserToPurge :one
+SELECT users.id
+FROM users
+WHERE
+    users.id = @id
+  AND
+    users.purge_after IS NOT NULL
+  AND
+    users.purge_after <= now()
+FOR UPDATE;
+
+-- name: RemoveUser :execrows
 DELETE FROM users
-WHERE users.id = @id;
+WHERE
+    users.id = @id
+  AND
+    users.purge_after IS NOT NULL
+  AND
+    users.purge_after <= now();
+
+
+-- name: SoftDeleteUser :one
+UPDATE users
+SET
+    deleted_at = now(),
+    purge_after = @purge_after,
+    online = false,
+    updated_at = now()
+WHERE
+    users.id = @id
+  AND
+    users.deleted_at IS NULL
+  AND
+    (sqlc.narg('expected_versions')::timestamptz[] IS NULL OR users.updated_at = ANY(sqlc.narg('expected_versions')::timestamptz[]))
+RETURNING *;
+
+-- name: RestoreUser :one
+UPDATE users
+SET
+    deleted_at = null,
+    purge_after = null,
+    updated_at = now()
+WHERE
+    users.id = @id
+  AND
+    users.deleted_at IS NOT NULL
+  AND
+    users.purge_after > now()
+RETURNING *;
 
+-- name: GetUsersToPurge :many
+SELECT *
+FROM users
+WHERE
+    users.purge_after IS NOT NULL
+  AND
+    users.purge_after <= now()
+ORDER BY users.purge_after
+LIMIT @max_count;
+
+-- name: UpdateUserPassword :exec
+UPDATE users
+SET password = @password
+WHERE users.id = @id;
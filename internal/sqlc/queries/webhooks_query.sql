@@ -0,0 +1,81 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions
+(url, event_types, secret, created_by)
+VALUES
+(@url, @event_types::varchar[], @secret, @created_by)
+RETURNING *;
+
+-- name: GetWebhookSubscriptions :many
+SELECT *
+FROM webhook_subscriptions
+ORDER BY webhook_subscriptions.created_at;
+
+-- name: WebhookSubscriptionExists :one
+SELECT COUNT(id) > 0
+FROM webhook_subscriptions
+WHERE id = @id;
+
+-- name: RemoveWebhookSubscription :exec
+DELETE FROM webhook_subscriptions
+WHERE webhook_subscriptions.id = @id;
+
+-- name: EnqueueWebhookDeliveries :exec
+INSERT INTO webhook_deliveries
+(subscription_id, event_type, payload)
+SELECT webhook_subscriptions.id, @event_type::varchar, @payload::jsonb
+FROM webhook_subscriptions
+WHERE webhook_subscriptions.active AND @event_type::varchar = ANY (webhook_subscriptions.event_types);
+
+-- name: ClaimDueWebhookDeliveries :many
+WITH claimed AS (
+    SELECT webhook_deliveries.id
+    FROM webhook_deliveries
+    WHERE
+        webhook_deliveries.status IN ('PENDING', 'FAILED')
+      AND
+        webhook_deliveries.next_attempt_at <= now()
+    ORDER BY webhook_deliveries.next_attempt_at
+    LIMIT @max_count
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE webhook_deliveries
+SET next_attempt_at = now() + make_interval(secs => @lease_seconds::float8)
+FROM claimed, webhook_subscriptions
+WHERE webhook_deliveries.id = claimed.id AND webhook_subscriptions.id = webhook_deliveries.subscription_id
+RETURNING
+    webhook_deliveries.id,
+    webhook_deliveries.event_type,
+    webhook_deliveries.payload,
+    webhook_deliveries.attempts,
+    webhook_subscriptions.url,
+    webhook_subscriptions.secret;
+
+-- name: MarkWebhookDeliveryDelivered :exec
+UPDATE webhook_deliveries
+SET
+    status = 'DELIVERED'::webhook_delivery_status,
+    attempts = attempts + 1,
+    last_attempt_at = now(),
+    last_response_code = @last_response_code,
+    last_error = null,
+    updated_at = now()
+WHERE webhook_deliveries.id = @id;
+
+-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET
+    status = @status::webhook_delivery_status,
+    attempts = attempts + 1,
+    next_attempt_at = @next_attempt_at,
+    last_attempt_at = now(),
+    last_response_code = @last_response_code,
+    last_error = @last_error,
+    updated_at = now()
+WHERE webhook_deliveries.id = @id;
+
+-- name: GetWebhookDeliveries :many
+SELECT *
+FROM webhook_deliveries
+WHERE webhook_deliveries.subscription_id = @subscription_id
+ORDER BY webhook_deliveries.created_at DESC
+LIMIT @max_count OFFSET @skip_count;
@@ -105,24 +105,27 @@ func handleValidation(fieldType reflect.StructField, value reflect.Value, tag *v
 			}
 			break
 		case validator.OneOf:
+			// the pointer is dereferenced into a copy, so the next validations of the field still get the pointer
+			fieldValue := value
 			if fieldType.Type.Kind() == reflect.Pointer || fieldType.Type.Kind() == reflect.UnsafePointer || fieldType.Type.Kind() == reflect.Uintptr {
-				if value.IsNil() {
+				if fieldValue.IsNil() {
 					break
 				}
-				value = value.Elem()
+				fieldValue = fieldValue.Elem()
 			}
-			if err := handleOneOf(value, fieldType.Name, validation.Arguments); err != nil {
+			if err := handleOneOf(fieldValue, fieldType.Name, validation.Arguments); err != nil {
 				errs = append(errs, err)
 			}
 			break
 		case validator.Greater, validator.GreaterOrEqual, validator.Equal, validator.LessOrEqual, validator.Less:
+			fieldValue := value
 			if fieldType.Type.Kind() == reflect.Pointer || fieldType.Type.Kind() == reflect.UnsafePointer || fieldType.Type.Kind() == reflect.Uintptr {
-				if value.IsNil() {
+				if fieldValue.IsNil() {
 					break
 				}
-				value = value.Elem()
+				fieldValue = fieldValue.Elem()
 			}
-			if err := handleComparison(value, fieldType, validation.ValidationType, validation.Arguments[0]); err != nil {
+			if err := handleComparison(fieldValue, fieldType, validation.ValidationType, validation.Arguments[0]); err != nil {
 				errs = append(errs, err)
 			}
 			break
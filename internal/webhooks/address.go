@@ -0,0 +1,112 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress is returned for the receivers in the local networks of the service, delivering to
+// them would let the subscribers reach the internal services.
+var ErrForbiddenAddress = errors.New("webhook receiver address is not public")
+
+// reservedPrefixes are the special purpose ranges which netip doesn't classify.
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// IsPublicAddress reports whether the webhooks can be delivered to the address. The loopback, private,
+// link-local, multicast and reserved addresses are not public.
+func IsPublicAddress(address netip.Addr) bool {
+	address = address.Unmap()
+
+	if !address.IsValid() ||
+		address.IsUnspecified() ||
+		address.IsLoopback() ||
+		address.IsPrivate() ||
+		address.IsLinkLocalUnicast() ||
+		address.IsLinkLocalMulticast() ||
+		address.IsInterfaceLocalMulticast() ||
+		address.IsMulticast() {
+		return false
+	}
+
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(address) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CheckUrl fails for the urls which aren't http(s) or whose host resolves to an address which isn't
+// public. The host can resolve to another address later, so the addresses are checked again on delivery.
+func CheckUrl(ctx context.Context, resolver *net.Resolver, rawUrl string) error {
+	parsed, parsingError := url.Parse(rawUrl)
+	if parsingError != nil {
+		return parsingError
+	}
+
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		return fmt.Errorf("webhook url %s should be an absolute http(s) url", rawUrl)
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrForbiddenAddress
+	}
+
+	if address, addressParsingError := netip.ParseAddr(host); addressParsingError == nil {
+		if !IsPublicAddress(address) {
+			return ErrForbiddenAddress
+		}
+
+		return nil
+	}
+
+	addresses, resolvingError := resolver.LookupNetIP(ctx, "ip", host)
+	if resolvingError != nil {
+		return resolvingError
+	}
+
+	for _, address := range addresses {
+		if !IsPublicAddress(address) {
+			return ErrForbiddenAddress
+		}
+	}
+
+	return nil
+}
+
+// createPublicDialer returns the dialer which refuses to connect to the addresses which aren't public.
+// The check runs after the host is resolved, so it also covers the hosts resolving to other addresses
+// than during validation and the redirects.
+func createPublicDialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{
+		Timeout: timeout,
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			addressPort, parsingError := netip.ParseAddrPort(address)
+			if parsingError != nil {
+				return parsingError
+			}
+
+			if !IsPublicAddress(addressPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, addressPort.Addr())
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package webhooks
+
+import "time"
+
+type WebhooksConfig struct {
+	DeliveryInterval string `env:"DELIVERY_INTERVAL"`
+	RequestTimeout   string `env:"REQUEST_TIMEOUT"`
+	BaseRetryDelay   string `env:"BASE_RETRY_DELAY"`
+	MaxRetryDelay    string `env:"MAX_RETRY_DELAY"`
+	MaxAttempts      int32  `env:"MAX_ATTEMPTS"`
+	BatchSize        int32  `env:"BATCH_SIZE"`
+}
+
+func (cfg *WebhooksConfig) GetDeliveryInterval() (time.Duration, error) {
+	return time.ParseDuration(cfg.DeliveryInterval)
+}
+
+func (cfg *WebhooksConfig) GetRequestTimeout() (time.Duration, error) {
+	return time.ParseDuration(cfg.RequestTimeout)
+}
+
+func (cfg *WebhooksConfig) GetBaseRetryDelay() (time.Duration, error) {
+	return time.ParseDuration(cfg.BaseRetryDelay)
+}
+
+func (cfg *WebhooksConfig) GetMaxRetryDelay() (time.Duration, error) {
+	return time.ParseDuration(cfg.MaxRetryDelay)
+}
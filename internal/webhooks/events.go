@@ -0,0 +1,37 @@
+package webhooks
+
+import "slices"
+
+const (
+	EventMessageCreated          = "message.created"
+	EventChatMemberAdded         = "chat.member_added"
+	EventUserRegistered          = "user.registered"
+	EventUserInterestsUpdated    = "user.interests_updated"
+	EventInterestCreated         = "interest.created"
+	EventInterestUpdated         = "interest.updated"
+	EventInterestDeleted         = "interest.deleted"
+	EventContactRequestSent      = "contact.request_sent"
+	EventContactRequestAccepted  = "contact.request_accepted"
+	EventContactRequestDeclined  = "contact.request_declined"
+	EventContactRequestCancelled = "contact.request_cancelled"
+	EventContactRemoved          = "contact.removed"
+)
+
+var AllEventTypes = []string{
+	EventMessageCreated,
+	EventChatMemberAdded,
+	EventUserRegistered,
+	EventUserInterestsUpdated,
+	EventInterestCreated,
+	EventInterestUpdated,
+	EventInterestDeleted,
+	EventContactRequestSent,
+	EventContactRequestAccepted,
+	EventContactRequestDeclined,
+	EventContactRequestCancelled,
+	EventContactRemoved,
+}
+
+func IsKnownEventType(eventType string) bool {
+	return slices.Contains(AllEventTypes, eventType)
+}
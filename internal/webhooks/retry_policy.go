@@ -0,0 +1,27 @@
+package webhooks
+
+import "time"
+
+type RetryPolicy struct {
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	MaxAttempts int32
+}
+
+// NextDelay returns the delay before the next attempt after the given amount of failed attempts,
+// the delay doubles every attempt and is capped by MaxDelay.
+func (p RetryPolicy) NextDelay(failedAttempts int32) time.Duration {
+	delay := p.BaseDelay
+	for range max(failedAttempts-1, 0) {
+		delay *= 2
+		if delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+
+	return min(delay, p.MaxDelay)
+}
+
+func (p RetryPolicy) ShouldGiveUp(failedAttempts int32) bool {
+	return failedAttempts >= p.MaxAttempts
+}
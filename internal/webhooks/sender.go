@@ -0,0 +1,123 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const maxResponseBodySize = 1024
+
+type Delivery struct {
+	ID        string
+	Url       string
+	Secret    string
+	EventType string
+	Payload   []byte
+}
+
+type Result struct {
+	StatusCode *int32
+	Err        error
+}
+
+func (r Result) Succeeded() bool {
+	return r.Err == nil
+}
+
+type ISender interface {
+	Send(ctx context.Context, delivery Delivery) Result
+	GetRetryPolicy() RetryPolicy
+}
+
+type Sender struct {
+	client      *http.Client
+	retryPolicy RetryPolicy
+}
+
+func (s *Sender) GetRetryPolicy() RetryPolicy {
+	return s.retryPolicy
+}
+
+// Send makes a single delivery attempt, any non 2xx response is treated as a failure.
+func (s *Sender) Send(ctx context.Context, delivery Delivery) Result {
+	request, requestCreationError := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		delivery.Url,
+		bytes.NewReader(delivery.Payload),
+	)
+	if requestCreationError != nil {
+		return Result{Err: requestCreationError}
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventHeader, delivery.EventType)
+	request.Header.Set(DeliveryHeader, delivery.ID)
+	request.Header.Set(SignatureHeader, Sign(delivery.Secret, time.Now(), delivery.Payload))
+
+	response, sendingError := s.client.Do(request)
+	if sendingError != nil {
+		return Result{Err: sendingError}
+	}
+	defer response.Body.Close()
+
+	statusCode := int32(response.StatusCode)
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, maxResponseBodySize))
+		return Result{
+			StatusCode: &statusCode,
+			Err:        fmt.Errorf("receiver responded with %d: %s", response.StatusCode, body),
+		}
+	}
+
+	return Result{StatusCode: &statusCode}
+}
+
+func CreateSenderWithClient(client *http.Client, retryPolicy RetryPolicy) *Sender {
+	return &Sender{client: client, retryPolicy: retryPolicy}
+}
+
+func CreateSender(cfg *WebhooksConfig) (*Sender, error) {
+	timeout, timeoutParseError := cfg.GetRequestTimeout()
+	if timeoutParseError != nil {
+		return nil, timeoutParseError
+	}
+
+	baseDelay, baseDelayParseError := cfg.GetBaseRetryDelay()
+	if baseDelayParseError != nil {
+		return nil, baseDelayParseError
+	}
+
+	maxDelay, maxDelayParseError := cfg.GetMaxRetryDelay()
+	if maxDelayParseError != nil {
+		return nil, maxDelayParseError
+	}
+
+	if cfg.MaxAttempts <= 0 {
+		return nil, errors.New("max webhook delivery attempts should be positive")
+	}
+
+	// the proxies are not used, otherwise the dialer would check the address of the proxy instead of
+	// the receiver
+	transport := &http.Transport{
+		DialContext:         createPublicDialer(timeout).DialContext,
+		TLSHandshakeTimeout: timeout,
+		ForceAttemptHTTP2:   true,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+	}
+
+	return CreateSenderWithClient(
+		&http.Client{Timeout: timeout, Transport: transport},
+		RetryPolicy{
+			BaseDelay:   baseDelay,
+			MaxDelay:    maxDelay,
+			MaxAttempts: cfg.MaxAttempts,
+		},
+	), nil
+}
@@ -0,0 +1,71 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const SignatureHeader = "X-Webhook-Signature"
+const EventHeader = "X-Webhook-Event"
+const DeliveryHeader = "X-Webhook-Delivery"
+
+const signatureVersion = "v1"
+
+func computeSignature(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sign builds the signature header value in the "t=<unix timestamp>,v1=<hex hmac>" format. The timestamp
+// is part of the signed content, so receivers can reject replayed deliveries.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	unix := timestamp.Unix()
+	return fmt.Sprintf("t=%d,%s=%s", unix, signatureVersion, computeSignature(secret, unix, body))
+}
+
+// VerifySignature is the receiver side of Sign, tolerance limits how old the signature can be.
+func VerifySignature(secret string, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var timestamp int64
+	var signature string
+
+	for _, part := range strings.Split(header, ",") {
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			return errors.New("signature header is malformed")
+		}
+
+		switch key {
+		case "t":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("can't parse signature timestamp: %w", err)
+			}
+			timestamp = parsed
+		case signatureVersion:
+			signature = value
+		}
+	}
+
+	if timestamp == 0 || signature == "" {
+		return errors.New("signature header is incomplete")
+	}
+
+	if now.Sub(time.Unix(timestamp, 0)).Abs() > tolerance {
+		return errors.New("signature timestamp is outside of the tolerance")
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(computeSignature(secret, timestamp, body))) {
+		return errors.New("signature does not match")
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package api_keys_tests
+
+import (
+	"chat_app_backend/internal/api_keys"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateKey_ShouldProduceParsableAndVerifiableKey(t *testing.T) {
+	generated, err := api_keys.GenerateKey()
+	require.NoError(t, err)
+
+	require.True(t, strings.HasPrefix(generated.Key, api_keys.KeyPrefix+api_keys.Separator))
+
+	prefix, err := api_keys.ParseKey(generated.Key)
+	require.NoError(t, err)
+	require.Equal(t, generated.Prefix, prefix)
+
+	require.True(t, api_keys.VerifyKey(generated.Key, generated.Hash))
+	require.False(t, api_keys.VerifyKey(generated.Key+"x", generated.Hash))
+}
+
+func TestGenerateKey_ShouldProduceUniqueKeys(t *testing.T) {
+	first, err := api_keys.GenerateKey()
+	require.NoError(t, err)
+
+	second, err := api_keys.GenerateKey()
+	require.NoError(t, err)
+
+	require.NotEqual(t, first.Key, second.Key)
+	require.NotEqual(t, first.Prefix, second.Prefix)
+}
+
+func TestParseKey_ShouldRejectMalformedKeys(t *testing.T) {
+	malformed := []string{
+		"",
+		"cab",
+		"cab_abc",
+		"xyz_0123456789ab_" + strings.Repeat("a", 43),
+		"cab_0123_" + strings.Repeat("a", 43),
+		"cab_0123456789ab_short",
+	}
+
+	for _, key := range malformed {
+		_, err := api_keys.ParseKey(key)
+		require.Error(t, err, key)
+	}
+}
+
+func TestIsKnownScope(t *testing.T) {
+	require.True(t, api_keys.IsKnownScope(api_keys.ScopeMessagesWrite))
+	require.False(t, api_keys.IsKnownScope("admin:everything"))
+}
@@ -0,0 +1,54 @@
+package audit_tests
+
+import (
+	"chat_app_backend/application/handlers/admin"
+	"chat_app_backend/application/models/admin/suspend_user"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/test/fakes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuspendUser_ShouldRecordActionInAuditLogOnly(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	adminUser := db_queries.User{ID: extensions.UUID{UUID: uuid.New()}, Role: db_queries.RoleTypeADMIN}
+	user := db_queries.User{ID: extensions.UUID{UUID: uuid.New()}}
+	suspendedUntil := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	reason := "spam"
+	suspendedUser := user
+	suspendedUser.SuspendedUntil = &suspendedUntil
+	suspendedUser.SuspensionReason = &reason
+
+	services := fakes.CreateServices(
+		map[string][]interface{}{"GetUserById": {user}, "SuspendUser": {suspendedUser}},
+		nil,
+	)
+
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/admin/users/suspend", nil)
+
+	_, handlingError := admin.SuspendUserHandler{}.Handle(
+		&suspend_user.SuspendUserRequestDto{ID: user.ID, SuspendedUntil: suspendedUntil, Reason: reason},
+		services,
+		ctx,
+		&request_env.RequestEnv{User: &adminUser},
+	)
+
+	require.Nil(t, handlingError)
+	require.Equal(t, []string{"GetUserById", "SuspendUser", "CreateAuditLogEntry"}, services.Db.Names())
+
+	entry := services.Db.Find("CreateAuditLogEntry").Args
+	require.Contains(t, entry, audit.ActionUserSuspended)
+	require.Contains(t, entry, &adminUser.ID)
+	require.Contains(t, entry, user.ID.String())
+}
@@ -0,0 +1,71 @@
+package audit_tests
+
+import (
+	"chat_app_backend/internal/audit"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type auditedEntity struct {
+	ID           int
+	Title        string
+	IconFileName string
+	Password     []byte
+	Email        string
+	FullName     string
+}
+
+func TestDiff_ShouldReturnOnlyChangedFields(t *testing.T) {
+	changes, diffError := audit.Diff(
+		auditedEntity{ID: 1, Title: "old", IconFileName: "icon.png"},
+		auditedEntity{ID: 1, Title: "new", IconFileName: "icon.png"},
+	)
+
+	require.NoError(t, diffError)
+	require.Equal(t, map[string]audit.Change{"title": {Before: "old", After: "new"}}, changes)
+}
+
+func TestDiff_ShouldConvertFieldNamesToSnakeCase(t *testing.T) {
+	changes, diffError := audit.Diff(
+		auditedEntity{IconFileName: "old.png"},
+		auditedEntity{IconFileName: "new.png"},
+	)
+
+	require.NoError(t, diffError)
+	require.Contains(t, changes, "icon_file_name")
+}
+
+func TestDiff_ShouldRedactPasswords(t *testing.T) {
+	changes, diffError := audit.Diff(
+		auditedEntity{Password: []byte("old")},
+		auditedEntity{Password: []byte("new")},
+	)
+
+	require.NoError(t, diffError)
+	require.Equal(t, audit.Change{Before: audit.RedactedValue, After: audit.RedactedValue}, changes["password"])
+}
+
+func TestDiff_ShouldRedactPersonalData(t *testing.T) {
+	changes, diffError := audit.Diff(
+		auditedEntity{Email: "old@example.com", FullName: "Old Name"},
+		auditedEntity{Email: "new@example.com", FullName: "Old Name"},
+	)
+
+	require.NoError(t, diffError)
+	require.Equal(t, map[string]audit.Change{"email": {Before: audit.RedactedValue, After: audit.RedactedValue}}, changes)
+
+	created, creationError := audit.Diff(nil, auditedEntity{FullName: "New Name"})
+	require.NoError(t, creationError)
+	require.Equal(t, audit.Change{Before: nil, After: audit.RedactedValue}, created["full_name"])
+}
+
+func TestDiff_ShouldHandleCreationsAndDeletions(t *testing.T) {
+	created, creationError := audit.Diff(nil, auditedEntity{ID: 1})
+	require.NoError(t, creationError)
+	require.Equal(t, audit.Change{Before: nil, After: float64(1)}, created["id"])
+
+	deleted, deletionError := audit.Diff(auditedEntity{ID: 1}, nil)
+	require.NoError(t, deletionError)
+	require.Equal(t, audit.Change{Before: float64(1), After: nil}, deleted["id"])
+}
@@ -0,0 +1,28 @@
+package audit_tests
+
+import (
+	"chat_app_backend/internal/audit"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnonymizeIp_ShouldKeepOnlyNetwork(t *testing.T) {
+	testCases := []struct {
+		ip       string
+		expected string
+	}{
+		{"203.0.113.42", "203.0.113.0"},
+		{"::ffff:203.0.113.42", "203.0.113.0"},
+		{"2001:db8:abcd:12:34:56:78:9a", "2001:db8:abcd::"},
+		{"fe80::1%eth0", "fe80::"},
+		{"", ""},
+		{"not an ip", ""},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.ip, func(t *testing.T) {
+			require.Equal(t, testCase.expected, audit.AnonymizeIp(testCase.ip))
+		})
+	}
+}
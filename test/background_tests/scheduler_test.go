@@ -0,0 +1,62 @@
+package background_tests
+
+import (
+	"chat_app_backend/internal/background"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/logger"
+	"context"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_ShouldRunScheduledJobsUntilStopped(t *testing.T) {
+	var runs atomic.Int64
+
+	scheduler := background.CreateScheduler(logger.CreateLogger(io.Discard)).
+		Schedule(
+			"counter",
+			5*time.Millisecond,
+			func(ctx context.Context) exceptions.ITrackableException {
+				runs.Add(1)
+				return nil
+			},
+		)
+
+	scheduler.Start()
+	require.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, time.Millisecond)
+	scheduler.Stop()
+
+	stoppedAt := runs.Load()
+	time.Sleep(20 * time.Millisecond)
+	require.Equal(t, stoppedAt, runs.Load())
+}
+
+func TestScheduler_ShouldKeepRunningJobAfterError(t *testing.T) {
+	var runs atomic.Int64
+
+	scheduler := background.CreateScheduler(logger.CreateLogger(io.Discard)).
+		Schedule(
+			"failing",
+			5*time.Millisecond,
+			func(ctx context.Context) exceptions.ITrackableException {
+				runs.Add(1)
+				return exceptions.CreateTrackableExceptionFromStringF("job failed")
+			},
+		)
+
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	require.Eventually(t, func() bool { return runs.Load() >= 2 }, time.Second, time.Millisecond)
+}
+
+func TestScheduler_ShouldPanicOnNonPositiveInterval(t *testing.T) {
+	require.Panics(t, func() {
+		background.CreateScheduler(logger.CreateLogger(io.Discard)).
+			Schedule("invalid", 0, func(ctx context.Context) exceptions.ITrackableException { return nil })
+	})
+}
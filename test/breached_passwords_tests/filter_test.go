@@ -0,0 +1,87 @@
+package breached_passwords_tests
+
+import (
+	"bytes"
+	"chat_app_backend/internal/breached_passwords"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeHashList(t *testing.T, passwords []string) string {
+	var builder strings.Builder
+	for idx, password := range passwords {
+		digest := sha1.Sum([]byte(password))
+		builder.WriteString(fmt.Sprintf("%s:%d\n", strings.ToUpper(hex.EncodeToString(digest[:])), idx+1))
+	}
+
+	path := filepath.Join(t.TempDir(), "hashes.txt")
+	require.NoError(t, os.WriteFile(path, []byte(builder.String()), 0o600))
+
+	return path
+}
+
+func TestFilter_ShouldContainEveryListedPassword(t *testing.T) {
+	passwords := make([]string, 1000)
+	for idx := range passwords {
+		passwords[idx] = fmt.Sprintf("breached-%d", idx)
+	}
+
+	filter, err := breached_passwords.BuildFilterFromHashList(writeHashList(t, passwords), 0.001)
+	require.NoError(t, err)
+
+	for _, password := range passwords {
+		require.True(t, filter.ContainsPassword(password), password)
+	}
+
+	falsePositives := 0
+	for idx := range 10000 {
+		if filter.ContainsPassword(fmt.Sprintf("safe-%d", idx)) {
+			falsePositives++
+		}
+	}
+
+	require.Less(t, falsePositives, 50)
+}
+
+func TestFilter_ShouldSurviveSerialization(t *testing.T) {
+	filter, err := breached_passwords.BuildFilterFromHashList(writeHashList(t, []string{"P@ssw0rd", "Qwerty_123"}), 0.01)
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	_, err = filter.WriteTo(&buffer)
+	require.NoError(t, err)
+
+	loaded, err := breached_passwords.ReadFilter(&buffer)
+	require.NoError(t, err)
+
+	require.True(t, loaded.ContainsPassword("P@ssw0rd"))
+	require.True(t, loaded.ContainsPassword("Qwerty_123"))
+}
+
+func TestFilter_ShouldRejectMalformedInput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hashes.txt")
+	require.NoError(t, os.WriteFile(path, []byte("not-a-hash\n"), 0o600))
+
+	_, err := breached_passwords.BuildFilterFromHashList(path, 0.01)
+	require.Error(t, err)
+
+	_, err = breached_passwords.ReadFilter(bytes.NewReader([]byte("garbage")))
+	require.Error(t, err)
+
+	_, err = breached_passwords.CreateEmptyFilter(10, 1.5)
+	require.Error(t, err)
+}
+
+func TestCreateFilter_ShouldBeDisabledWithoutPath(t *testing.T) {
+	filter, err := breached_passwords.CreateFilter(&breached_passwords.BreachedPasswordsConfig{})
+	require.NoError(t, err)
+
+	require.False(t, filter.ContainsPassword("password"))
+}
@@ -0,0 +1,80 @@
+package etag_tests
+
+import (
+	"chat_app_backend/internal/etag"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+var version = time.Date(2026, 1, 2, 3, 4, 5, 123456000, time.UTC)
+
+func createContext(header string, value string) *gin.Context {
+	gin.SetMode(gin.TestMode)
+
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/items/1", nil)
+	if value != "" {
+		ctx.Request.Header.Set(header, value)
+	}
+
+	return ctx
+}
+
+func TestExpectedVersions_ShouldReadVersionsFromTags(t *testing.T) {
+	other := version.Add(time.Hour)
+
+	ctx := createContext(etag.IfMatchHeader, etag.FromVersion(version)+`, W/"weak", "not a version", `+etag.FromVersion(other))
+	versions := etag.ExpectedVersions(ctx)
+
+	require.Len(t, versions, 2)
+	require.True(t, version.Equal(versions[0]))
+	require.True(t, other.Equal(versions[1]))
+}
+
+func TestExpectedVersions_ShouldAllowAnyVersion(t *testing.T) {
+	for _, value := range []string{"", "*"} {
+		require.Nil(t, etag.ExpectedVersions(createContext(etag.IfMatchHeader, value)))
+	}
+}
+
+func TestExpectedVersions_ShouldMatchNothingWithoutStrongTags(t *testing.T) {
+	for _, value := range []string{`W/` + etag.FromVersion(version), `"not a version"`, "malformed"} {
+		versions := etag.ExpectedVersions(createContext(etag.IfMatchHeader, value))
+
+		require.NotNil(t, versions)
+		require.Empty(t, versions)
+	}
+}
+
+func TestIsNotModified_ShouldCompareTagsWeakly(t *testing.T) {
+	testCases := []struct {
+		name        string
+		ifNoneMatch string
+		notModified bool
+	}{
+		{"same tag", etag.FromVersion(version), true},
+		{"weak tag", "W/" + etag.FromVersion(version), true},
+		{"one of the tags", `"other", ` + etag.FromVersion(version), true},
+		{"any tag", "*", true},
+		{"other tag", etag.FromVersion(version.Add(time.Microsecond)), false},
+		{"no header", "", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := createContext(etag.IfNoneMatchHeader, testCase.ifNoneMatch)
+			etag.Set(ctx, version)
+
+			require.Equal(t, testCase.notModified, etag.IsNotModified(ctx))
+		})
+	}
+}
+
+func TestIsNotModified_ShouldIgnoreUntaggedResponses(t *testing.T) {
+	require.False(t, etag.IsNotModified(createContext(etag.IfNoneMatchHeader, "*")))
+}
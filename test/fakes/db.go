@@ -0,0 +1,183 @@
+package fakes
+
+import (
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/sqlc/db"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type Statement struct {
+	Name string
+	Args []interface{}
+}
+
+// Db returns the rows by the name of the sqlc query and records the statements it runs. The rows are the
+// generated structs, which are scanned field by field, as the queries select the whole tables. The other
+// statements change a single row unless Affected says otherwise.
+type Db struct {
+	Rows       map[string][]interface{}
+	Affected   map[string]int64
+	Failing    map[string]error
+	Statements []Statement
+}
+
+func CreateDb(rows map[string][]interface{}) *Db {
+	return &Db{Rows: rows, Affected: map[string]int64{}, Failing: map[string]error{}}
+}
+
+func (d *Db) record(sql string, args []interface{}) string {
+	name := strings.Fields(strings.SplitN(sql, "\n", 2)[0])[2]
+	d.Statements = append(d.Statements, Statement{Name: name, Args: args})
+	return name
+}
+
+func (d *Db) Exec(_ context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	name := d.record(sql, args)
+
+	affected, exists := d.Affected[name]
+	if !exists {
+		affected = 1
+	}
+
+	return pgconn.NewCommandTag(fmt.Sprintf("EXEC %d", affected)), d.Failing[name]
+}
+
+func (d *Db) Query(_ context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	name := d.record(sql, args)
+	return &rows{rows: d.Rows[name]}, d.Failing[name]
+}
+
+func (d *Db) QueryRow(_ context.Context, sql string, args ...interface{}) pgx.Row {
+	name := d.record(sql, args)
+	if len(d.Rows[name]) == 0 {
+		return row{err: pgx.ErrNoRows}
+	}
+
+	return row{value: d.Rows[name][0], err: d.Failing[name]}
+}
+
+// Names returns the names of the run statements in order.
+func (d *Db) Names() []string {
+	names := make([]string, 0, len(d.Statements))
+	for _, statement := range d.Statements {
+		names = append(names, statement.Name)
+	}
+
+	return names
+}
+
+// Find returns the first run statement with the name, nil when there is none.
+func (d *Db) Find(name string) *Statement {
+	for idx := range d.Statements {
+		if d.Statements[idx].Name == name {
+			return &d.Statements[idx]
+		}
+	}
+
+	return nil
+}
+
+// Embed returns the row of the queries which embed the model with sqlc.embed, their columns are the fields
+// of the model followed by the other columns.
+func Embed(model interface{}, columns ...interface{}) []interface{} {
+	fields := reflect.ValueOf(model)
+
+	row := make([]interface{}, 0, fields.NumField()+len(columns))
+	for idx := range fields.NumField() {
+		row = append(row, fields.Field(idx).Interface())
+	}
+
+	return append(row, columns...)
+}
+
+func scan(value interface{}, dest []interface{}) error {
+	if columns, isRow := value.([]interface{}); isRow {
+		if len(columns) != len(dest) {
+			return fmt.Errorf("can't scan %d columns into %d values", len(columns), len(dest))
+		}
+
+		for idx, target := range dest {
+			reflect.ValueOf(target).Elem().Set(reflect.ValueOf(columns[idx]))
+		}
+
+		return nil
+	}
+
+	fields := reflect.ValueOf(value)
+
+	// the single column rows are the values themselves, which can be structs too, e.g. the ids
+	if len(dest) == 1 && reflect.TypeOf(dest[0]).Elem() == fields.Type() {
+		reflect.ValueOf(dest[0]).Elem().Set(fields)
+		return nil
+	}
+
+	if fields.Kind() != reflect.Struct {
+		return fmt.Errorf("can't scan a value into %d values", len(dest))
+	}
+
+	if fields.NumField() != len(dest) {
+		return fmt.Errorf("can't scan %d fields into %d values", fields.NumField(), len(dest))
+	}
+
+	for idx, target := range dest {
+		reflect.ValueOf(target).Elem().Set(fields.Field(idx))
+	}
+
+	return nil
+}
+
+type row struct {
+	value interface{}
+	err   error
+}
+
+func (r row) Scan(dest ...interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	return scan(r.value, dest)
+}
+
+type rows struct {
+	pgx.Rows
+	rows    []interface{}
+	current int
+}
+
+func (r *rows) Next() bool {
+	r.current++
+	return r.current <= len(r.rows)
+}
+
+func (r *rows) Scan(dest ...interface{}) error {
+	return scan(r.rows[r.current-1], dest)
+}
+
+func (r *rows) Close() {}
+
+func (r *rows) Err() error {
+	return nil
+}
+
+// Connection runs the transactions on the same fake without rolling them back.
+type Connection struct {
+	Db *Db
+}
+
+func (c Connection) CreateTransaction(_ context.Context, transaction db.Transaction) exceptions.ITrackableException {
+	return transaction(c.GetQueries())
+}
+
+func (c Connection) GetQueries() *db_queries.Queries {
+	return db_queries.New(c.Db)
+}
+
+func (c Connection) Close() {}
@@ -0,0 +1,56 @@
+package fakes
+
+import (
+	"chat_app_backend/application/application_config"
+	"chat_app_backend/internal/configuration"
+	"chat_app_backend/internal/images"
+	"chat_app_backend/internal/logger"
+	"chat_app_backend/internal/s3"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db"
+	"io"
+)
+
+// Services provides the fakes, the other services panic when used.
+type Services struct {
+	service_wrapper.IServiceWrapper
+	Db            *Db
+	Storage       *Storage
+	Configuration configuration.IConfiguration
+}
+
+func CreateServices(rows map[string][]interface{}, files map[string][]byte) Services {
+	return Services{
+		Db:      CreateDb(rows),
+		Storage: &Storage{Files: files},
+		Configuration: configuration.CreateConfiguration().
+			AddConfiguration(&application_config.LocalizationConfig{DefaultLocale: "en"}),
+	}
+}
+
+func (s Services) GetDbConnection() db.IDbConnection {
+	return Connection{Db: s.Db}
+}
+
+func (s Services) GetConfiguration() configuration.IConfiguration {
+	return s.Configuration
+}
+
+func (s Services) GetS3Client() s3.IClient {
+	return s.Storage
+}
+
+func (s Services) GetLogger() logger.ILogger {
+	return logger.CreateLogger(io.Discard)
+}
+
+func (s Services) GetImageProcessor() images.IProcessor {
+	processor, _ := images.CreateProcessor(&images.ImageProcessingConfig{
+		MaxDimension:   1024,
+		MaxPixels:      1024 * 1024,
+		ThumbnailSizes: "64",
+		JpegQuality:    85,
+	})
+
+	return processor
+}
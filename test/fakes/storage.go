@@ -0,0 +1,51 @@
+package fakes
+
+import (
+	"bytes"
+	"chat_app_backend/internal/s3"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Storage keeps the files in memory by their StorageKey and records the removed ones.
+type Storage struct {
+	s3.IClient
+	Files   map[string][]byte
+	Deleted []string
+}
+
+func StorageKey(filename string, bucketName s3.Buckets) string {
+	return fmt.Sprintf("%v/%s", bucketName, filename)
+}
+
+// GetDownloadUrl returns the url of the file whether it exists or not, as presigning does.
+func (s *Storage) GetDownloadUrl(_ context.Context, filename string, bucketName s3.Buckets) (string, error) {
+	return "https://storage.test/" + StorageKey(filename, bucketName), nil
+}
+
+func (s *Storage) GetFile(_ context.Context, filename string, bucketName s3.Buckets) (io.ReadCloser, error) {
+	data, exists := s.Files[StorageKey(filename, bucketName)]
+	if !exists {
+		return nil, errors.New("the object does not exist")
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *Storage) UploadBytes(_ context.Context, data []byte, _ string, filename string, bucketName s3.Buckets) (string, error) {
+	s.Files[StorageKey(filename, bucketName)] = data
+	return filename, nil
+}
+
+func (s *Storage) FileExists(_ context.Context, filename string, bucketName s3.Buckets) (bool, error) {
+	_, exists := s.Files[StorageKey(filename, bucketName)]
+	return exists, nil
+}
+
+func (s *Storage) DeleteFile(_ context.Context, filename string, bucketName s3.Buckets) error {
+	delete(s.Files, StorageKey(filename, bucketName))
+	s.Deleted = append(s.Deleted, StorageKey(filename, bucketName))
+	return nil
+}
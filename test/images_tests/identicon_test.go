@@ -0,0 +1,29 @@
+package images_tests
+
+import (
+	"chat_app_backend/internal/images"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateIdenticon_ShouldBeDeterministic(t *testing.T) {
+	first := images.GenerateIdenticon("john doe", 64)
+	second := images.GenerateIdenticon("john doe", 64)
+	other := images.GenerateIdenticon("jane doe", 64)
+
+	require.Equal(t, 64, first.Bounds().Dx())
+	require.Equal(t, 64, first.Bounds().Dy())
+	require.Equal(t, first.Pix, second.Pix)
+	require.NotEqual(t, first.Pix, other.Pix)
+}
+
+func TestGenerateIdenticon_ShouldBeSymmetric(t *testing.T) {
+	img := images.GenerateIdenticon("john doe", 50)
+
+	for y := range 50 {
+		for x := range 25 {
+			require.Equal(t, img.At(x, y), img.At(49-x, y))
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package images_tests
+
+import (
+	"bytes"
+	"chat_app_backend/internal/images"
+	"chat_app_backend/internal/s3"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createProcessor(t *testing.T) *images.Processor {
+	processor, err := images.CreateProcessor(
+		&images.ImageProcessingConfig{
+			MaxDimension:   200,
+			MaxPixels:      1000 * 1000,
+			ThumbnailSizes: "32, 64",
+			JpegQuality:    85,
+		},
+	)
+	require.NoError(t, err)
+
+	return processor
+}
+
+func createImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	return img
+}
+
+func encodePng(t *testing.T, img image.Image) []byte {
+	var buffer bytes.Buffer
+	require.NoError(t, png.Encode(&buffer, img))
+	return buffer.Bytes()
+}
+
+// encodeJpegWithExif inserts an APP1 segment with gps data right after the SOI marker, like cameras do.
+func encodeJpegWithExif(t *testing.T, img image.Image) []byte {
+	var buffer bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buffer, img, nil))
+
+	payload := append([]byte("Exif\x00\x00"), []byte("GPSLatitude=55.7558")...)
+	segment := []byte{0xFF, 0xE1, byte((len(payload) + 2) >> 8), byte(len(payload) + 2)}
+	segment = append(segment, payload...)
+
+	encoded := buffer.Bytes()
+	return append(append(append([]byte{}, encoded[:2]...), segment...), encoded[2:]...)
+}
+
+func TestDetectFileType_ShouldUseContentInsteadOfName(t *testing.T) {
+	pngData := encodePng(t, createImage(2, 2))
+
+	fileType, err := images.DetectFileType(pngData)
+	require.NoError(t, err)
+	require.Equal(t, s3.Png, fileType)
+
+	fileType, err = images.DetectFileType(encodeJpegWithExif(t, createImage(2, 2)))
+	require.NoError(t, err)
+	require.Equal(t, s3.Jpeg, fileType)
+
+	fileType, err = images.DetectFileType([]byte("<?xml version=\"1.0\"?>\n<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"))
+	require.NoError(t, err)
+	require.Equal(t, s3.Svg, fileType)
+
+	_, err = images.DetectFileType([]byte("MZ\x90\x00 definitely not an image"))
+	require.ErrorIs(t, err, images.ErrUnsupportedFormat)
+}
+
+func TestProcess_ShouldStripMetadataAndCapDimensions(t *testing.T) {
+	processor := createProcessor(t)
+	source := encodeJpegWithExif(t, createImage(400, 100))
+	require.True(t, bytes.Contains(source, []byte("GPSLatitude")))
+
+	processed, err := processor.Process(source, s3.Png, s3.Jpeg)
+	require.NoError(t, err)
+	require.Equal(t, s3.Jpeg, processed.Original.FileType)
+	require.False(t, bytes.Contains(processed.Original.Data, []byte("Exif")))
+	require.False(t, bytes.Contains(processed.Original.Data, []byte("GPSLatitude")))
+
+	config, err := jpeg.DecodeConfig(bytes.NewReader(processed.Original.Data))
+	require.NoError(t, err)
+	require.Equal(t, 200, config.Width)
+	require.Equal(t, 50, config.Height)
+
+	require.Len(t, processed.Thumbnails, 2)
+	for _, thumbnail := range processed.Thumbnails {
+		thumbnailConfig, thumbnailDecodingError := jpeg.DecodeConfig(bytes.NewReader(thumbnail.Data))
+		require.NoError(t, thumbnailDecodingError)
+		require.Equal(t, thumbnail.Size, thumbnailConfig.Width)
+		require.False(t, bytes.Contains(thumbnail.Data, []byte("GPSLatitude")))
+	}
+}
+
+func TestProcess_ShouldKeepSmallImagesSize(t *testing.T) {
+	processed, err := createProcessor(t).Process(encodePng(t, createImage(20, 10)), s3.Png)
+	require.NoError(t, err)
+
+	for _, encoded := range []images.EncodedImage{processed.Original, processed.Thumbnails[0].EncodedImage} {
+		config, decodingError := png.DecodeConfig(bytes.NewReader(encoded.Data))
+		require.NoError(t, decodingError)
+		require.Equal(t, 20, config.Width)
+		require.Equal(t, 10, config.Height)
+	}
+}
+
+func TestProcess_ShouldRejectDisallowedAndOversizedImages(t *testing.T) {
+	processor := createProcessor(t)
+
+	_, err := processor.Process(encodePng(t, createImage(10, 10)), s3.Jpeg)
+	require.ErrorIs(t, err, images.ErrUnsupportedFormat)
+
+	_, err = processor.Process(encodePng(t, createImage(1001, 1000)), s3.Png)
+	require.ErrorIs(t, err, images.ErrUnsupportedFormat)
+
+	truncated := encodePng(t, createImage(10, 10))
+	_, err = processor.Process(truncated[:len(truncated)/2], s3.Png)
+	require.ErrorIs(t, err, images.ErrUnsupportedFormat)
+}
+
+func TestProcess_ShouldSanitizeSvgWithoutThumbnails(t *testing.T) {
+	svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="10" height="10" onload="alert(1)"></svg>`)
+
+	processed, err := createProcessor(t).Process(svg, s3.Png, s3.Svg)
+	require.NoError(t, err)
+	require.Equal(t, s3.Svg, processed.Original.FileType)
+	require.NotContains(t, string(processed.Original.Data), "onload")
+	require.Empty(t, processed.Thumbnails)
+}
+
+func TestThumbnailFileName_ShouldKeepFileType(t *testing.T) {
+	require.Equal(t, "avatar_64.png", images.ThumbnailFileName("avatar.png", 64))
+	require.Equal(t, "avatar_64", images.ThumbnailFileName("avatar", 64))
+	require.Equal(t, "icon_64.png", images.ThumbnailFileName("icon.svg", 64))
+}
+
+func TestCreateProcessor_ShouldValidateConfig(t *testing.T) {
+	_, err := images.CreateProcessor(&images.ImageProcessingConfig{MaxDimension: 10, MaxPixels: 10, ThumbnailSizes: "a", JpegQuality: 80})
+	require.Error(t, err)
+
+	_, err = images.CreateProcessor(&images.ImageProcessingConfig{MaxDimension: 10, MaxPixels: 10, ThumbnailSizes: "", JpegQuality: 0})
+	require.Error(t, err)
+}
@@ -0,0 +1,121 @@
+package images_tests
+
+import (
+	"bytes"
+	"chat_app_backend/internal/images"
+	"chat_app_backend/internal/s3"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizeSvg_ShouldRemoveScriptsAndExternalReferences(t *testing.T) {
+	svg := []byte(`<?xml version="1.0"?>
+<!DOCTYPE svg [<!ENTITY x "y">]>
+<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="0 0 10 10" onload="alert(1)">
+	<script>alert(1)</script>
+	<foreignObject><iframe src="https://evil.example"/></foreignObject>
+	<a href="javascript:alert(1)"><rect width="5" height="5"/></a>
+	<use xlink:href="https://evil.example/sprite.svg#icon"/>
+	<use xlink:href="#shape"/>
+	<circle id="shape" cx="5" cy="5" r="4" fill="url(https://evil.example/paint)" stroke="#000" onclick="alert(1)"/>
+	<rect width="1" height="1" style="fill: red; background: url(javascript:alert(1))"/>
+</svg>`)
+
+	sanitized, err := images.SanitizeSvg(svg)
+	require.NoError(t, err)
+
+	output := string(sanitized)
+	for _, forbidden := range []string{"script", "onload", "onclick", "foreignObject", "iframe", "javascript", "evil.example", "ENTITY"} {
+		require.NotContains(t, output, forbidden)
+	}
+
+	require.Contains(t, output, `href="#shape"`)
+	require.Contains(t, output, `<circle`)
+	require.Contains(t, output, `stroke="#000"`)
+
+	_, err = images.DetectFileType(sanitized)
+	require.NoError(t, err)
+}
+
+func TestSanitizeSvg_ShouldRejectInvalidDocuments(t *testing.T) {
+	for _, svg := range []string{
+		`<html><svg xmlns="http://www.w3.org/2000/svg"></svg></html>`,
+		`<svg xmlns="http://www.w3.org/2000/svg"><rect></svg>`,
+		`<svg xmlns="http://www.w3.org/2000/svg"></svg><svg xmlns="http://www.w3.org/2000/svg"></svg>`,
+		`not an image`,
+	} {
+		_, err := images.SanitizeSvg([]byte(svg))
+		require.ErrorIs(t, err, images.ErrUnsafeSvg, svg)
+		require.ErrorIs(t, err, images.ErrUnsupportedFormat, svg)
+	}
+}
+
+func TestRasterizeSvg_ShouldFitViewBoxAndPaintShapes(t *testing.T) {
+	svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 20 10">
+	<rect width="10" height="10" fill="#ff0000"/>
+	<g transform="translate(10 0)"><path d="M0 0 h10 v10 h-10 z" fill="blue"/></g>
+</svg>`)
+
+	img, err := images.RasterizeSvg(svg, 40)
+	require.NoError(t, err)
+	require.Equal(t, 40, img.Bounds().Dx())
+	require.Equal(t, 20, img.Bounds().Dy())
+
+	r, g, b, a := img.At(5, 10).RGBA()
+	require.Equal(t, [4]uint32{0xFFFF, 0, 0, 0xFFFF}, [4]uint32{r, g, b, a})
+
+	r, g, b, a = img.At(35, 10).RGBA()
+	require.Equal(t, [4]uint32{0, 0, 0xFFFF, 0xFFFF}, [4]uint32{r, g, b, a})
+}
+
+func TestRasterizeSvg_ShouldKeepUnpaintedAreasTransparent(t *testing.T) {
+	svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="10" height="10">
+	<circle cx="5" cy="5" r="2" fill="none" stroke="black" stroke-width="1"/>
+	<rect width="10" height="10" fill="red" display="none"/>
+</svg>`)
+
+	img, err := images.RasterizeSvg(svg, 100)
+	require.NoError(t, err)
+
+	_, _, _, centerAlpha := img.At(50, 50).RGBA()
+	require.Zero(t, centerAlpha)
+
+	_, _, _, strokeAlpha := img.At(70, 50).RGBA()
+	require.Equal(t, uint32(0xFFFF), strokeAlpha)
+
+	_, _, _, cornerAlpha := img.At(2, 2).RGBA()
+	require.Zero(t, cornerAlpha)
+}
+
+func TestProcess_ShouldRasterizeSvgThumbnailsWhenEnabled(t *testing.T) {
+	processor, err := images.CreateProcessor(
+		&images.ImageProcessingConfig{
+			MaxDimension:   200,
+			MaxPixels:      1000 * 1000,
+			ThumbnailSizes: "32, 64",
+			JpegQuality:    85,
+			RasterizeSvg:   true,
+		},
+	)
+	require.NoError(t, err)
+
+	svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24"><circle cx="12" cy="12" r="10"/></svg>`)
+
+	processed, err := processor.Process(svg, s3.Svg)
+	require.NoError(t, err)
+	require.Equal(t, s3.Svg, processed.Original.FileType)
+	require.Len(t, processed.Thumbnails, 2)
+
+	for idx, size := range []int{32, 64} {
+		thumbnail := processed.Thumbnails[idx]
+		require.Equal(t, s3.Png, thumbnail.FileType)
+		require.Equal(t, "image/png", thumbnail.ContentType)
+
+		config, decodingError := png.DecodeConfig(bytes.NewReader(thumbnail.Data))
+		require.NoError(t, decodingError)
+		require.Equal(t, size, config.Width)
+		require.Equal(t, size, config.Height)
+	}
+}
@@ -0,0 +1,106 @@
+package interest_archive_tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/interest_archive"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createZip(t *testing.T, files map[string]string) []byte {
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+
+	for name, content := range files {
+		fileWriter, creationError := writer.Create(name)
+		require.NoError(t, creationError)
+
+		_, writingError := fileWriter.Write([]byte(content))
+		require.NoError(t, writingError)
+	}
+
+	require.NoError(t, writer.Close())
+	return buffer.Bytes()
+}
+
+func readArchive(data []byte, maxSize int64) (*interest_archive.Archive, error) {
+	return interest_archive.Read(bytes.NewReader(data), int64(len(data)), maxSize)
+}
+
+func TestWrite_ShouldBeReadBackInBothFormats(t *testing.T) {
+	categoryId := extensions.NewUUID()
+	rows := []interest_archive.Row{
+		{Title: "Chess", Description: "Board game, \"classic\"", Icon: "icons/chess.png", CategoryID: &categoryId, Position: 2},
+		{Title: "Hiking", Description: "", Icon: "icons/hiking.svg"},
+	}
+	files := map[string][]byte{
+		"icons/chess.png":  []byte("png"),
+		"icons/hiking.svg": []byte("svg"),
+	}
+
+	for _, format := range []string{interest_archive.FormatJson, interest_archive.FormatCsv} {
+		var buffer bytes.Buffer
+		require.NoError(t, interest_archive.Write(&buffer, format, rows, files))
+
+		archive, readingError := readArchive(buffer.Bytes(), 1<<20)
+		require.NoError(t, readingError, format)
+		require.Equal(t, rows, archive.Rows, format)
+		require.Equal(t, files, archive.Files, format)
+	}
+}
+
+func TestRead_ShouldRequireManifest(t *testing.T) {
+	_, readingError := readArchive(createZip(t, map[string]string{"icons/chess.png": "png"}), 1<<20)
+
+	require.ErrorIs(t, readingError, interest_archive.ErrManifestNotFound)
+}
+
+func TestRead_ShouldRejectBothManifests(t *testing.T) {
+	data := createZip(t, map[string]string{
+		"manifest.json": "[]",
+		"manifest.csv":  "title,description,icon,category_id,position\n",
+	})
+
+	_, readingError := readArchive(data, 1<<20)
+
+	require.ErrorIs(t, readingError, interest_archive.ErrInvalidManifest)
+}
+
+func TestRead_ShouldRejectCsvWithoutRequiredColumns(t *testing.T) {
+	data := createZip(t, map[string]string{"manifest.csv": "title,icon\nChess,icons/chess.png\n"})
+
+	_, readingError := readArchive(data, 1<<20)
+
+	require.ErrorIs(t, readingError, interest_archive.ErrInvalidManifest)
+}
+
+func TestRead_ShouldReportLineOfInvalidCsvValue(t *testing.T) {
+	data := createZip(t, map[string]string{
+		"manifest.csv": "title,description,icon,category_id,position\nChess,,icons/chess.png,,first\n",
+	})
+
+	_, readingError := readArchive(data, 1<<20)
+
+	require.ErrorIs(t, readingError, interest_archive.ErrInvalidManifest)
+	require.ErrorContains(t, readingError, "line 2")
+}
+
+func TestRead_ShouldStopAtUncompressedSizeLimit(t *testing.T) {
+	data := createZip(t, map[string]string{
+		"manifest.json":   "[]",
+		"icons/large.png": string(bytes.Repeat([]byte{0}, 4096)),
+	})
+
+	_, readingError := readArchive(data, 1024)
+
+	require.ErrorIs(t, readingError, interest_archive.ErrArchiveTooLarge)
+}
+
+func TestRead_ShouldRejectNotZip(t *testing.T) {
+	_, readingError := readArchive([]byte("not a zip"), 1<<20)
+
+	require.ErrorIs(t, readingError, interest_archive.ErrInvalidArchive)
+}
@@ -0,0 +1,129 @@
+package interest_categories_tests
+
+import (
+	"chat_app_backend/application/handlers/interest_categories"
+	"chat_app_backend/application/models/interest_categories/update"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/test/fakes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newId() extensions.UUID {
+	return extensions.UUID{UUID: uuid.New()}
+}
+
+func handle(
+	request update.UpdateInterestCategoryRequestDto,
+	services fakes.Services,
+) (*update.UpdateInterestCategoryResponseDto, error) {
+	gin.SetMode(gin.TestMode)
+
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodPatch, "/interest-categories/"+request.ID.String(), nil)
+
+	response, handlingError := interest_categories.UpdateInterestCategoryHandler{}.Handle(
+		&request,
+		services,
+		ctx,
+		&request_env.RequestEnv{User: &db_queries.User{ID: newId(), Role: db_queries.RoleTypeADMIN}},
+	)
+	if handlingError != nil {
+		return nil, handlingError
+	}
+
+	return response, nil
+}
+
+func TestUpdateInterestCategory_ShouldRejectMovingIntoSubtree(t *testing.T) {
+	category := db_queries.InterestCategory{ID: newId(), Title: "music"}
+	subcategory := newId()
+
+	services := fakes.CreateServices(
+		map[string][]interface{}{
+			"GetInterestCategoryById":     {category},
+			"IsInterestCategoryInSubtree": {true},
+		},
+		nil,
+	)
+
+	_, handlingError := handle(update.UpdateInterestCategoryRequestDto{ID: category.ID, ParentID: &subcategory}, services)
+
+	require.IsType(t, common_exceptions.InvalidBodyException{}, handlingError)
+	require.Equal(
+		t,
+		[]string{"LockInterestCategories", "GetInterestCategoryById", "IsInterestCategoryInSubtree"},
+		services.Db.Names(),
+	)
+	require.Equal(t, []interface{}{subcategory, category.ID}, services.Db.Find("IsInterestCategoryInSubtree").Args)
+}
+
+func TestUpdateInterestCategory_ShouldLockCategoriesBeforeMoving(t *testing.T) {
+	parent := newId()
+	category := db_queries.InterestCategory{ID: newId(), Title: "rock"}
+	moved := category
+	moved.ParentID = &parent
+
+	services := fakes.CreateServices(
+		map[string][]interface{}{
+			"GetInterestCategoryById":     {category},
+			"IsInterestCategoryInSubtree": {false},
+			"UpdateInterestCategory":      {moved},
+		},
+		nil,
+	)
+
+	response, handlingError := handle(update.UpdateInterestCategoryRequestDto{ID: category.ID, ParentID: &parent}, services)
+
+	require.NoError(t, handlingError)
+	require.Equal(t, &parent, response.ParentID)
+	require.Equal(
+		t,
+		[]string{
+			"LockInterestCategories",
+			"GetInterestCategoryById",
+			"IsInterestCategoryInSubtree",
+			"UpdateInterestCategory",
+			"CreateAuditLogEntry",
+		},
+		services.Db.Names(),
+	)
+}
+
+func TestUpdateInterestCategory_ShouldNotLockCategoriesWithoutMoving(t *testing.T) {
+	parent := newId()
+	title := "classic rock"
+	category := db_queries.InterestCategory{ID: newId(), ParentID: &parent, Title: "rock"}
+	renamed := category
+	renamed.Title = title
+
+	for _, request := range []update.UpdateInterestCategoryRequestDto{
+		{ID: category.ID, Title: &title},
+		{ID: category.ID, ParentID: &parent, ClearParent: true},
+	} {
+		services := fakes.CreateServices(
+			map[string][]interface{}{
+				"GetInterestCategoryById": {category},
+				"UpdateInterestCategory":  {renamed},
+			},
+			nil,
+		)
+
+		_, handlingError := handle(request, services)
+
+		require.NoError(t, handlingError)
+		require.Equal(
+			t,
+			[]string{"GetInterestCategoryById", "UpdateInterestCategory", "CreateAuditLogEntry"},
+			services.Db.Names(),
+		)
+	}
+}
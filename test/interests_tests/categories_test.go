@@ -0,0 +1,85 @@
+package interests_tests
+
+import (
+	shared_interests "chat_app_backend/application/handlers/shared/interests"
+	"chat_app_backend/application/models/interests/get"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func category(title string, parent *db_queries.InterestCategory) db_queries.InterestCategory {
+	created := db_queries.InterestCategory{ID: extensions.UUID{UUID: uuid.New()}, Title: title}
+	if parent != nil {
+		created.ParentID = &parent.ID
+	}
+
+	return created
+}
+
+func interest(title string, category *db_queries.InterestCategory) get.GetInterestResponseDto {
+	created := get.GetInterestResponseDto{ID: extensions.UUID{UUID: uuid.New()}, Title: title}
+	if category != nil {
+		created.CategoryID = &category.ID
+	}
+
+	return created
+}
+
+func titles(nodes []get.InterestCategoryNodeDto) []string {
+	result := make([]string, len(nodes))
+	for idx, node := range nodes {
+		result[idx] = node.Title
+	}
+
+	return result
+}
+
+func TestBuildCategoryTree_ShouldNestCategoriesAndInterests(t *testing.T) {
+	music := category("music", nil)
+	rock := category("rock", &music)
+	jazz := category("jazz", &music)
+	punk := category("punk", &rock)
+	sports := category("sports", nil)
+
+	guitar := interest("guitar", &rock)
+	drums := interest("drums", &rock)
+	chess := interest("chess", nil)
+
+	// the children come before their parents, so the order of the levels doesn't depend on the parents
+	roots, uncategorized := shared_interests.BuildCategoryTree(
+		[]db_queries.InterestCategory{punk, rock, sports, jazz, music},
+		[]get.GetInterestResponseDto{guitar, chess, drums},
+	)
+
+	require.Equal(t, []string{"sports", "music"}, titles(roots))
+	require.Equal(t, []get.GetInterestResponseDto{chess}, uncategorized)
+
+	musicNode := roots[1]
+	require.Equal(t, []string{"rock", "jazz"}, titles(musicNode.Subcategories))
+	require.Empty(t, musicNode.Interests)
+	require.NotNil(t, musicNode.Interests)
+
+	rockNode := musicNode.Subcategories[0]
+	require.Equal(t, &music.ID, rockNode.ParentID)
+	require.Equal(t, []get.GetInterestResponseDto{guitar, drums}, rockNode.Interests)
+	require.Equal(t, []string{"punk"}, titles(rockNode.Subcategories))
+	require.Empty(t, rockNode.Subcategories[0].Subcategories)
+	require.NotNil(t, rockNode.Subcategories[0].Subcategories)
+}
+
+func TestBuildCategoryTree_ShouldSkipOrphans(t *testing.T) {
+	removed := category("removed", nil)
+	orphan := category("orphan", &removed)
+
+	roots, uncategorized := shared_interests.BuildCategoryTree(
+		[]db_queries.InterestCategory{orphan},
+		[]get.GetInterestResponseDto{interest("lost", &orphan)},
+	)
+
+	require.Empty(t, roots)
+	require.Empty(t, uncategorized)
+}
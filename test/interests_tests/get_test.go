@@ -0,0 +1,138 @@
+package interests_tests
+
+import (
+	"chat_app_backend/application/handlers/interests"
+	"chat_app_backend/application/models/interests/get"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/s3"
+	"chat_app_backend/internal/search"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/test/fakes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func listedInterest(title string, position int32) db_queries.Interest {
+	return db_queries.Interest{
+		ID:           extensions.UUID{UUID: uuid.New()},
+		Title:        title,
+		Position:     position,
+		IconFileName: title + ".png",
+	}
+}
+
+func getInterests(t *testing.T, request get.GetInterestsRequestDto, services fakes.Services) *get.GetInterestsResponseDto {
+	gin.SetMode(gin.TestMode)
+
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/interests", nil)
+	ctx.Set(request_env.Key, &request_env.RequestEnv{})
+
+	response, handlingError := interests.GetInterestsHandler{}.Handle(&request, services, ctx, &request_env.RequestEnv{})
+	require.Nil(t, handlingError)
+
+	return response
+}
+
+func TestGetInterests_ShouldSortByPositionWithinCategories(t *testing.T) {
+	categoryId := extensions.UUID{UUID: uuid.New()}
+
+	testCases := []struct {
+		name    string
+		request get.GetInterestsRequestDto
+		query   string
+	}{
+		{"by default", get.GetInterestsRequestDto{}, "GetInterestsByTitle"},
+		{"in category", get.GetInterestsRequestDto{CategoryID: &categoryId}, "GetInterestsByPosition"},
+		{"in tree", get.GetInterestsRequestDto{Tree: true}, "GetInterestsByPosition"},
+		{"in category by title", get.GetInterestsRequestDto{CategoryID: &categoryId, SortBy: ptrTo(get.SortByTitle)}, "GetInterestsByTitle"},
+		{"descending", get.GetInterestsRequestDto{Order: ptrTo(get.OrderDesc)}, "GetInterestsByTitleDesc"},
+		{"by popularity", get.GetInterestsRequestDto{SortBy: ptrTo(get.SortByPopularity)}, "GetInterestsByPopularity"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			services := fakes.CreateServices(map[string][]interface{}{}, nil)
+			if testCase.request.Tree {
+				services.Db.Rows["GetInterestCategories"] = []interface{}{}
+			}
+
+			getInterests(t, testCase.request, services)
+
+			require.Equal(t, testCase.query, services.Db.Names()[0])
+		})
+	}
+}
+
+func TestGetInterests_ShouldContinueFromCursorOfPositionSort(t *testing.T) {
+	first := listedInterest("guitar", 1)
+	second := listedInterest("drums", 2)
+	categoryId := extensions.UUID{UUID: uuid.New()}
+
+	services := fakes.CreateServices(
+		map[string][]interface{}{"GetInterestsByPosition": {fakes.Embed(first, int64(0)), fakes.Embed(second, int64(3))}},
+		nil,
+	)
+
+	firstPage := getInterests(t, get.GetInterestsRequestDto{CategoryID: &categoryId, Limit: ptrTo(int32(1))}, services)
+
+	require.Len(t, firstPage.Interests, 1)
+	require.Equal(t, first.ID, firstPage.Interests[0].ID)
+	require.True(t, firstPage.Pagination.HasMore)
+	require.NotNil(t, firstPage.Pagination.NextCursor)
+
+	services.Db.Statements = nil
+	getInterests(
+		t,
+		get.GetInterestsRequestDto{CategoryID: &categoryId, Limit: ptrTo(int32(1)), Cursor: firstPage.Pagination.NextCursor},
+		services,
+	)
+
+	args := services.Db.Find("GetInterestsByPosition").Args
+	require.Contains(t, args, &first.ID)
+	require.Contains(t, args, &first.Position)
+	require.Contains(t, args, &first.Title)
+}
+
+func TestGetInterests_ShouldSearchByDeprecatedName(t *testing.T) {
+	services := fakes.CreateServices(map[string][]interface{}{}, nil)
+
+	getInterests(t, get.GetInterestsRequestDto{Name: ptrTo("gui")}, services)
+
+	require.Contains(t, services.Db.Find("GetInterestsByTitle").Args, search.PrefixQuery("gui"))
+}
+
+func TestGetInterests_ShouldLinkThumbnailsByStoredSizes(t *testing.T) {
+	withThumbnail := listedInterest("guitar", 1)
+	withThumbnail.IconThumbnailSizes = []int32{64}
+	withoutThumbnail := listedInterest("drums", 2)
+	withoutThumbnail.IconThumbnailSizes = []int32{}
+	storedBefore := listedInterest("piano", 3)
+
+	// only the thumbnail of the icon stored before the sizes were recorded is in the storage
+	services := fakes.CreateServices(
+		map[string][]interface{}{"GetInterestsByTitle": {
+			fakes.Embed(withThumbnail, int64(0)),
+			fakes.Embed(withoutThumbnail, int64(0)),
+			fakes.Embed(storedBefore, int64(0)),
+		}},
+		map[string][]byte{fakes.StorageKey("piano_64.png", s3.InterestsIconBucket): {}},
+	)
+
+	response := getInterests(t, get.GetInterestsRequestDto{}, services)
+
+	require.Len(t, response.Interests, 3)
+	require.Equal(t, "https://storage.test/"+fakes.StorageKey("guitar_64.png", s3.InterestsIconBucket), response.Interests[0].IconThumbnailLinks["64"])
+	require.Equal(t, response.Interests[1].IconDownloadLink, response.Interests[1].IconThumbnailLinks["64"])
+	require.Equal(t, "https://storage.test/"+fakes.StorageKey("piano_64.png", s3.InterestsIconBucket), response.Interests[2].IconThumbnailLinks["64"])
+}
+
+func ptrTo[T interface{}](value T) *T {
+	return &value
+}
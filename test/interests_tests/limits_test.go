@@ -0,0 +1,46 @@
+package interests_tests
+
+import (
+	shared_interests "chat_app_backend/application/handlers/shared/interests"
+	"chat_app_backend/internal/exceptions"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitInterestsChange(t *testing.T) {
+	const maxInterests = 5
+
+	testCases := []struct {
+		name        string
+		countBefore int64
+		countAfter  int64
+		allowed     bool
+	}{
+		{"adding within the limit", 3, 5, true},
+		{"adding beyond the limit", 4, 6, false},
+		{"adding above the lowered limit", 7, 8, false},
+		{"removing above the lowered limit", 8, 7, true},
+		{"removing nothing above the lowered limit", 8, 8, true},
+		{"replacing with a smaller set above the lowered limit", 8, 6, true},
+		{"replacing with a larger set", 2, 6, false},
+		{"registering with too many interests", 0, 6, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			limitError := shared_interests.LimitInterestsChange(maxInterests, testCase.countBefore, testCase.countAfter)
+
+			if testCase.allowed {
+				require.Nil(t, limitError)
+				return
+			}
+
+			var restException exceptions.IRestException
+			require.True(t, errors.As(limitError, &restException))
+			require.Equal(t, http.StatusBadRequest, restException.GetHttpStatusCode())
+		})
+	}
+}
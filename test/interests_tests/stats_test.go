@@ -0,0 +1,101 @@
+package interests_tests
+
+import (
+	"bytes"
+	shared_interests "chat_app_backend/application/handlers/shared/interests"
+	"chat_app_backend/application/models/interests/stats"
+	"chat_app_backend/internal/extensions"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsWindowStart_ShouldIncludeCurrentUtcDay(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+
+	testCases := []struct {
+		name       string
+		now        time.Time
+		windowDays int32
+		start      time.Time
+	}{
+		{"single day", time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC), 1, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{"week", time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC), 7, time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"across months", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), 3, time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"other timezone", time.Date(2026, 3, 11, 1, 0, 0, 0, moscow), 1, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.start, shared_interests.StatsWindowStart(testCase.now, testCase.windowDays))
+		})
+	}
+}
+
+func TestCreateInterestStats_ShouldComputeGrowthFromWindowStart(t *testing.T) {
+	testCases := []struct {
+		name       string
+		usersCount int64
+		added      int64
+		removed    int64
+		growth     int64
+		growthRate *float64
+	}{
+		{"growing", 15, 10, 5, 5, ptr(0.5)},
+		{"shrinking", 6, 1, 5, -4, ptr(-0.4)},
+		{"unchanged", 4, 0, 0, 0, ptr(0.0)},
+		{"without users at window start", 3, 3, 0, 3, nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			interestStats := shared_interests.CreateInterestStats(
+				extensions.UUID{UUID: uuid.New()},
+				"music",
+				testCase.usersCount,
+				testCase.added,
+				testCase.removed,
+			)
+
+			require.Equal(t, testCase.growth, interestStats.Growth)
+			if testCase.growthRate == nil {
+				require.Nil(t, interestStats.GrowthRate)
+				return
+			}
+
+			require.NotNil(t, interestStats.GrowthRate)
+			require.InDelta(t, *testCase.growthRate, *interestStats.GrowthRate, 1e-9)
+		})
+	}
+}
+
+func TestWriteInterestStatsCsv_ShouldWriteRowPerInterest(t *testing.T) {
+	growing := shared_interests.CreateInterestStats(extensions.UUID{UUID: uuid.New()}, "music, rock", 3, 2, 1)
+	created := shared_interests.CreateInterestStats(extensions.UUID{UUID: uuid.New()}, "chess", 1, 1, 0)
+
+	buffer := new(bytes.Buffer)
+	writingError := shared_interests.WriteInterestStatsCsv(
+		csv.NewWriter(buffer),
+		[]stats.InterestStatsResponseDto{growing, created},
+	)
+	require.NoError(t, writingError)
+
+	records, readingError := csv.NewReader(buffer).ReadAll()
+	require.NoError(t, readingError)
+	require.Equal(
+		t,
+		[][]string{
+			{"interest_id", "title", "users_count", "added", "removed", "growth", "growth_rate"},
+			{growing.InterestID.String(), "music, rock", "3", "2", "1", "1", "0.5000"},
+			{created.InterestID.String(), "chess", "1", "1", "0", "1", ""},
+		},
+		records,
+	)
+}
+
+func ptr(value float64) *float64 {
+	return &value
+}
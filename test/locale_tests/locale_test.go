@@ -0,0 +1,39 @@
+package locale_tests
+
+import (
+	"chat_app_backend/internal/locale"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAcceptLanguage_ShouldOrderByQuality(t *testing.T) {
+	locales := locale.ParseAcceptLanguage("fr;q=0.5, en-GB, de;q=0.8, ru;q=0.5")
+
+	require.Equal(t, []string{"en-gb", "de", "fr", "ru"}, locales)
+}
+
+func TestParseAcceptLanguage_ShouldSkipWildcardAndRejectedLocales(t *testing.T) {
+	locales := locale.ParseAcceptLanguage("*, es;q=0, pt_BR, ;q=0.3, not a locale")
+
+	require.Equal(t, []string{"pt-br"}, locales)
+}
+
+func TestResolve_ShouldFallBackToLanguage(t *testing.T) {
+	resolved := locale.Resolve([]string{"de-at", "en-gb"}, []string{"en", "fr"}, "ru")
+
+	require.Equal(t, "en", resolved)
+}
+
+func TestResolve_ShouldUseDefaultLocaleWithoutMatches(t *testing.T) {
+	require.Equal(t, "en", locale.Resolve([]string{"de"}, []string{"fr"}, "EN"))
+	require.Equal(t, "en", locale.Resolve(nil, nil, "en"))
+}
+
+func TestIsValid(t *testing.T) {
+	require.True(t, locale.IsValid("en"))
+	require.True(t, locale.IsValid("zh-Hant-TW"))
+	require.False(t, locale.IsValid("e"))
+	require.False(t, locale.IsValid("en gb"))
+	require.False(t, locale.IsValid(""))
+}
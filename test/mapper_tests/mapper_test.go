@@ -162,3 +162,24 @@ func TestMapper_SingleArgument_ShouldFailWhenSourceDoesNotHaveEnoughArguments(t
 		`src does not have field V5, which dest has`,
 	)
 }
+
+func TestMapper_SingleArgument_ShouldSkipExcludedDestFields(t *testing.T) {
+	v1 := struct {
+		V1 int
+	}{
+		V1: 1,
+	}
+
+	v2 := struct {
+		V1 int
+		V2 string `mapper:"exclude"`
+	}{
+		V2: "kept",
+	}
+
+	err := mapper.Mapper{}.Map(&v2, v1)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, v2.V1)
+	require.Equal(t, "kept", v2.V2)
+}
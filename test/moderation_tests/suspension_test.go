@@ -0,0 +1,42 @@
+package moderation_tests
+
+import (
+	"chat_app_backend/internal/moderation"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsSuspended_ShouldExpireOnItsOwn(t *testing.T) {
+	now := time.Now()
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
+	require.False(t, moderation.IsSuspended(&db_queries.User{}, now))
+	require.True(t, moderation.IsSuspended(&db_queries.User{SuspendedUntil: &future}, now))
+	require.False(t, moderation.IsSuspended(&db_queries.User{SuspendedUntil: &past}, now))
+}
+
+func TestSuspensionMessage_ShouldContainExpiryAndReason(t *testing.T) {
+	until := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	reason := "spam"
+
+	require.Equal(
+		t,
+		"account is suspended until 2030-01-02T03:04:05Z: spam",
+		moderation.SuspensionMessage(&db_queries.User{SuspendedUntil: &until, SuspensionReason: &reason}),
+	)
+	require.Equal(
+		t,
+		"account is suspended until 2030-01-02T03:04:05Z",
+		moderation.SuspensionMessage(&db_queries.User{SuspendedUntil: &until}),
+	)
+}
+
+func TestIsKnownRole_ShouldRejectUnknownRoles(t *testing.T) {
+	require.True(t, moderation.IsKnownRole(db_queries.RoleTypeUSER))
+	require.True(t, moderation.IsKnownRole(db_queries.RoleTypeADMIN))
+	require.False(t, moderation.IsKnownRole("OWNER"))
+}
@@ -0,0 +1,213 @@
+package openapi_tests
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/openapi"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type itemDto struct {
+	ID        extensions.UUID `json:"id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Parent    *itemDto        `json:"parent,omitempty"`
+}
+
+type listItemsRequestDto struct {
+	Limit int32   `form:"limit,default=20" validator:"gt 0;lte 100"`
+	Sort  *string `form:"sort" validator:"one_of [title,created_at]"`
+}
+
+type listItemsResponseDto struct {
+	Items []itemDto `json:"items"`
+}
+
+type updateItemRequestDto struct {
+	ID    extensions.UUID `uri:"id" validator:"not_empty"`
+	Title string          `json:"title" validator:"not_empty;length lt 255"`
+	Tags  []string        `json:"tags" validator:"length lte 5"`
+}
+
+type uploadItemRequestDto struct {
+	Title string                `form:"title" validator:"not_empty"`
+	Icon  *multipart.FileHeader `form:"icon" validator:"not_empty"`
+}
+
+// serialize returns the document as the generic json, so the tests check what the clients receive.
+func serialize(t *testing.T, document *openapi.Document) map[string]interface{} {
+	serialized, err := json.Marshal(document)
+	require.NoError(t, err)
+
+	var result map[string]interface{}
+	require.NoError(t, json.Unmarshal(serialized, &result))
+	return result
+}
+
+func operation(document map[string]interface{}, path string, method string) map[string]interface{} {
+	return document["paths"].(map[string]interface{})[path].(map[string]interface{})[method].(map[string]interface{})
+}
+
+func TestAddRoute_ShouldDescribeQueryParametersWithConstraints(t *testing.T) {
+	document := openapi.CreateDocument(openapi.Info{Title: "test", Version: "1"})
+	document.AddRoute(openapi.Route{
+		Method:   http.MethodGet,
+		Path:     "/items/",
+		Tag:      "items",
+		Status:   http.StatusOK,
+		Request:  reflect.TypeFor[listItemsRequestDto](),
+		Response: reflect.TypeFor[listItemsResponseDto](),
+	})
+
+	listOperation := operation(serialize(t, document), "/items/", "get")
+
+	require.Equal(t, "listItems", listOperation["operationId"])
+	require.JSONEq(
+		t,
+		`[
+			{"name":"limit","in":"query","schema":{"type":"integer","format":"int32","default":20,"exclusiveMinimum":0,"maximum":100}},
+			{"name":"sort","in":"query","schema":{"type":["string","null"],"enum":["title","created_at",null]}}
+		]`,
+		mustMarshal(t, listOperation["parameters"]),
+	)
+}
+
+func TestAddRoute_ShouldStoreResponsesInComponents(t *testing.T) {
+	document := openapi.CreateDocument(openapi.Info{Title: "test", Version: "1"})
+	document.AddRoute(openapi.Route{
+		Method:   http.MethodGet,
+		Path:     "/items/",
+		Status:   http.StatusOK,
+		Request:  reflect.TypeFor[listItemsRequestDto](),
+		Response: reflect.TypeFor[listItemsResponseDto](),
+	})
+
+	serialized := serialize(t, document)
+	schemas := serialized["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+
+	require.JSONEq(
+		t,
+		`{"$ref":"#/components/schemas/openapi_tests.listItemsResponseDto"}`,
+		mustMarshal(t, operation(serialized, "/items/", "get")["responses"].(map[string]interface{})["200"].(map[string]interface{})["content"].(map[string]interface{})["application/json"].(map[string]interface{})["schema"]),
+	)
+	require.JSONEq(
+		t,
+		`{
+			"type":"object",
+			"properties":{
+				"id":{"type":"string","format":"uuid"},
+				"created_at":{"type":"string","format":"date-time"},
+				"parent":{"anyOf":[{"$ref":"#/components/schemas/openapi_tests.itemDto"},{"type":"null"}]}
+			},
+			"required":["id","created_at"]
+		}`,
+		mustMarshal(t, schemas["openapi_tests.itemDto"]),
+	)
+}
+
+func TestAddRoute_ShouldDescribePathParametersAndJsonBody(t *testing.T) {
+	document := openapi.CreateDocument(openapi.Info{Title: "test", Version: "1"})
+	document.AddRoute(openapi.Route{
+		Method:   http.MethodPut,
+		Path:     "/items/:id",
+		Status:   http.StatusOK,
+		Request:  reflect.TypeFor[updateItemRequestDto](),
+		Response: reflect.TypeFor[itemDto](),
+		Security: []openapi.SecurityRequirement{{openapi.BearerAuth: {}}},
+	})
+
+	updateOperation := operation(serialize(t, document), "/items/{id}", "put")
+
+	require.JSONEq(
+		t,
+		`[{"name":"id","in":"path","required":true,"schema":{"type":"string","format":"uuid"}}]`,
+		mustMarshal(t, updateOperation["parameters"]),
+	)
+	require.JSONEq(
+		t,
+		`{
+			"required":true,
+			"content":{"application/json":{"schema":{
+				"type":"object",
+				"properties":{
+					"title":{"type":"string","minLength":1,"maxLength":254},
+					"tags":{"type":"array","items":{"type":"string"},"maxItems":5}
+				},
+				"required":["title"]
+			}}}
+		}`,
+		mustMarshal(t, updateOperation["requestBody"]),
+	)
+	require.JSONEq(t, `[{"bearerAuth":[]}]`, mustMarshal(t, updateOperation["security"]))
+}
+
+func TestAddRoute_ShouldDescribeFilesAsMultipartBody(t *testing.T) {
+	document := openapi.CreateDocument(openapi.Info{Title: "test", Version: "1"})
+	document.AddRoute(openapi.Route{
+		Method:   http.MethodPost,
+		Path:     "/items/upload",
+		Status:   http.StatusCreated,
+		Request:  reflect.TypeFor[uploadItemRequestDto](),
+		Response: reflect.TypeFor[itemDto](),
+	})
+
+	uploadOperation := operation(serialize(t, document), "/items/upload", "post")
+
+	require.Nil(t, uploadOperation["parameters"])
+	require.JSONEq(
+		t,
+		`{
+			"required":true,
+			"content":{"multipart/form-data":{"schema":{
+				"type":"object",
+				"properties":{
+					"title":{"type":"string","minLength":1},
+					"icon":{"type":["string","null"],"format":"binary"}
+				},
+				"required":["title","icon"]
+			}}}
+		}`,
+		mustMarshal(t, uploadOperation["requestBody"]),
+	)
+	require.Contains(t, uploadOperation["responses"], "201")
+}
+
+func mustMarshal(t *testing.T, value interface{}) string {
+	serialized, err := json.Marshal(value)
+	require.NoError(t, err)
+	return string(serialized)
+}
+
+func TestSwaggerUi_ShouldServeAssetsLocally(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	pageHandler, pageError := openapi.SwaggerUiHandler("/openapi.json", "/docs/assets")
+	require.NoError(t, pageError)
+
+	assets, assetsError := openapi.SwaggerUiAssets()
+	require.NoError(t, assetsError)
+
+	engine := gin.New()
+	engine.GET("/docs", pageHandler)
+	engine.StaticFS("/docs/assets", assets)
+
+	page := httptest.NewRecorder()
+	engine.ServeHTTP(page, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	require.Equal(t, http.StatusOK, page.Code)
+	require.Contains(t, page.Body.String(), `src="/docs/assets/swagger-ui-bundle.js"`)
+	require.NotContains(t, page.Body.String(), "https://")
+
+	for _, asset := range []string{"swagger-ui-bundle.js", "swagger-ui.css"} {
+		response := httptest.NewRecorder()
+		engine.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/docs/assets/"+asset, nil))
+		require.Equal(t, http.StatusOK, response.Code, asset)
+		require.NotEmpty(t, response.Body.Bytes(), asset)
+	}
+}
@@ -0,0 +1,44 @@
+package pagination_tests
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/pagination"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_ShouldRoundTrip(t *testing.T) {
+	cursor := pagination.Cursor{
+		SortBy:     "title",
+		Descending: true,
+		Value:      "Football",
+		ID:         extensions.NewUUID(),
+	}
+
+	encoded, encodingError := cursor.Encode()
+	require.NoError(t, encodingError)
+
+	decoded, decodingError := pagination.DecodeCursor(encoded, "title", true)
+	require.NoError(t, decodingError)
+	require.Equal(t, cursor, *decoded)
+}
+
+func TestCursor_ShouldRejectAnotherSort(t *testing.T) {
+	encoded, encodingError := pagination.Cursor{SortBy: "title", Value: "Football"}.Encode()
+	require.NoError(t, encodingError)
+
+	_, sortByError := pagination.DecodeCursor(encoded, "created_at", false)
+	require.ErrorIs(t, sortByError, pagination.ErrCursorSortMismatch)
+
+	_, orderError := pagination.DecodeCursor(encoded, "title", true)
+	require.ErrorIs(t, orderError, pagination.ErrCursorSortMismatch)
+}
+
+func TestCursor_ShouldRejectMalformedInput(t *testing.T) {
+	_, encodingError := pagination.DecodeCursor("not a cursor", "title", false)
+	require.Error(t, encodingError)
+
+	_, jsonError := pagination.DecodeCursor("bm90IGpzb24", "title", false)
+	require.Error(t, jsonError)
+}
@@ -0,0 +1,96 @@
+package password_tests
+
+import (
+	"chat_app_backend/internal/password"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/argon2"
+)
+
+func createHasher(t *testing.T, iterations uint32, memory uint32) *password.Hasher {
+	hasher, err := password.CreateHasher(&password.HashPasswordConfig{
+		SaltSize:   16,
+		Iterations: iterations,
+		Memory:     memory,
+		Threads:    1,
+		KeyLength:  32,
+	})
+	require.NoError(t, err)
+
+	return hasher
+}
+
+func TestHasher_ShouldProducePhcString(t *testing.T) {
+	hasher := createHasher(t, 1, 1024)
+
+	hash := string(hasher.HashPassword("secret"))
+
+	require.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+	require.Len(t, strings.Split(hash, "$"), 6)
+}
+
+func TestHasher_ShouldVerifyHashesAcrossParameterSets(t *testing.T) {
+	oldHasher := createHasher(t, 1, 1024)
+	newHasher := createHasher(t, 2, 2048)
+
+	hash := oldHasher.HashPassword("secret")
+
+	matches, err := newHasher.ComparePassword("secret", hash)
+	require.NoError(t, err)
+	require.True(t, matches)
+
+	matches, err = newHasher.ComparePassword("wrong", hash)
+	require.NoError(t, err)
+	require.False(t, matches)
+
+	require.True(t, newHasher.NeedsRehash(hash))
+	require.False(t, oldHasher.NeedsRehash(hash))
+}
+
+func TestHasher_ShouldVerifyLegacyHashes(t *testing.T) {
+	hasher := createHasher(t, 1, 1024)
+
+	salt := []byte("0123456789abcdef")
+	rawHash := argon2.IDKey([]byte("secret"), salt, 3, 64*1024, 2, 32)
+	legacyHash := []byte(
+		base64.RawStdEncoding.EncodeToString(salt) + "$" + base64.RawStdEncoding.EncodeToString(rawHash),
+	)
+
+	matches, err := hasher.ComparePassword("secret", legacyHash)
+	require.NoError(t, err)
+	require.True(t, matches)
+	require.True(t, hasher.NeedsRehash(legacyHash))
+}
+
+func TestHasher_ShouldReturnErrorOnMalformedHashes(t *testing.T) {
+	hasher := createHasher(t, 1, 1024)
+
+	malformed := []string{
+		"",
+		"no-separators",
+		"a$b$c",
+		"$bcrypt$v=19$m=1024,t=1,p=1$c2FsdA$aGFzaA",
+		"$argon2id$v=1$m=1024,t=1,p=1$c2FsdA$aGFzaA",
+		"$argon2id$v=19$garbage$c2FsdA$aGFzaA",
+		"$argon2id$v=19$m=0,t=1,p=1$c2FsdA$aGFzaA",
+		"$argon2id$v=19$m=1024,t=1,p=1$!!!$aGFzaA",
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$",
+		"!!!$!!!",
+	}
+
+	for _, hash := range malformed {
+		require.NotPanics(t, func() {
+			matches, err := hasher.ComparePassword("secret", []byte(hash))
+			require.Error(t, err, hash)
+			require.False(t, matches)
+		})
+	}
+}
+
+func TestCreateHasher_ShouldRejectZeroParameters(t *testing.T) {
+	_, err := password.CreateHasher(&password.HashPasswordConfig{})
+	require.Error(t, err)
+}
@@ -0,0 +1,33 @@
+package privacy_tests
+
+import (
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/privacy"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermits_ShouldRespectVisibilityLevel(t *testing.T) {
+	require.True(t, privacy.Permits(db_queries.VisibilityLevelEVERYONE, false))
+	require.True(t, privacy.Permits(db_queries.VisibilityLevelCONTACTS, true))
+	require.False(t, privacy.Permits(db_queries.VisibilityLevelCONTACTS, false))
+	require.False(t, privacy.Permits(db_queries.VisibilityLevelNOBODY, true))
+	require.False(t, privacy.Permits("UNKNOWN", true))
+}
+
+func TestIsVisibleTo_ShouldAlwaysAllowOwnerAndAdmins(t *testing.T) {
+	ownerId := extensions.UUID{UUID: uuid.New()}
+	owner := &db_queries.User{ID: ownerId, Role: db_queries.RoleTypeUSER}
+	admin := &db_queries.User{ID: extensions.UUID{UUID: uuid.New()}, Role: db_queries.RoleTypeADMIN}
+	stranger := &db_queries.User{ID: extensions.UUID{UUID: uuid.New()}, Role: db_queries.RoleTypeUSER}
+
+	require.True(t, privacy.IsVisibleTo(db_queries.VisibilityLevelNOBODY, owner, ownerId, false))
+	require.True(t, privacy.IsVisibleTo(db_queries.VisibilityLevelNOBODY, admin, ownerId, false))
+	require.False(t, privacy.IsVisibleTo(db_queries.VisibilityLevelNOBODY, stranger, ownerId, true))
+	require.False(t, privacy.IsVisibleTo(db_queries.VisibilityLevelCONTACTS, stranger, ownerId, false))
+	require.True(t, privacy.IsVisibleTo(db_queries.VisibilityLevelCONTACTS, stranger, ownerId, true))
+	require.False(t, privacy.IsVisibleTo(db_queries.VisibilityLevelCONTACTS, nil, ownerId, false))
+}
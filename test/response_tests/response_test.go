@@ -0,0 +1,318 @@
+package response_tests
+
+import (
+	"chat_app_backend/internal/etag"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/openapi"
+	"chat_app_backend/internal/response"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type ownerDto struct {
+	Name string `json:"name"`
+}
+
+type itemDto struct {
+	ID        extensions.UUID `json:"id"`
+	Title     string          `json:"title"`
+	Count     int64           `json:"count"`
+	Owner     *ownerDto       `json:"owner"`
+	CreatedAt time.Time       `json:"created_at"`
+	Secret    string          `json:"-"`
+}
+
+type listItemsResponseDto struct {
+	Items []itemDto `json:"items" csv:"rows"`
+	Total int64     `json:"total"`
+}
+
+type getItemResponseDto struct {
+	Title string `json:"title"`
+}
+
+var (
+	itemId    = extensions.UUID{UUID: uuid.MustParse("7f0c3a4e-2d0e-4e8a-9a55-0c6f1f6b2a10")}
+	createdAt = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+)
+
+func createList() *listItemsResponseDto {
+	return &listItemsResponseDto{
+		Items: []itemDto{
+			{ID: itemId, Title: "first, quoted", Count: 3, Owner: &ownerDto{Name: "owner"}, CreatedAt: createdAt, Secret: "secret"},
+			{ID: itemId, Title: "second", Count: 1, CreatedAt: createdAt},
+		},
+		Total: 2,
+	}
+}
+
+func write(accept string, preferredStatus int, body interface{}) (*httptest.ResponseRecorder, exceptions.ITrackableException) {
+	gin.SetMode(gin.TestMode)
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/items", nil)
+	if accept != "" {
+		ctx.Request.Header.Set("Accept", accept)
+	}
+
+	writingError := response.Write(ctx, preferredStatus, body)
+	return recorder, writingError
+}
+
+func TestWrite_ShouldEncodeJsonByDefault(t *testing.T) {
+	for _, accept := range []string{"", "*/*", "application/json", "text/html, */*;q=0.8"} {
+		recorder, err := write(accept, http.StatusOK, &getItemResponseDto{Title: "item"})
+		require.Nil(t, err)
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Contains(t, recorder.Header().Get("Content-Type"), "application/json")
+		require.JSONEq(t, `{"title":"item"}`, recorder.Body.String())
+	}
+}
+
+func TestWrite_ShouldEncodeMsgPackAsTheJsonRepresentation(t *testing.T) {
+	recorder, err := write("application/msgpack", http.StatusOK, createList())
+	require.Nil(t, err)
+
+	require.Contains(t, recorder.Header().Get("Content-Type"), "application/msgpack")
+
+	var decoded map[string]interface{}
+	require.NoError(t, binding.MsgPack.BindBody(recorder.Body.Bytes(), &decoded))
+
+	require.EqualValues(t, 2, decoded["total"])
+
+	items := decoded["items"].([]interface{})
+	first := items[0].(map[interface{}]interface{})
+	// the decoder of gin reads the strings as bytes
+	require.Equal(t, itemId.String(), string(first["id"].([]byte)))
+	require.Equal(t, "2026-01-02T03:04:05Z", string(first["created_at"].([]byte)))
+	require.EqualValues(t, 3, first["count"])
+	require.NotContains(t, first, "Secret")
+}
+
+func TestWrite_ShouldEncodeListsAsCsv(t *testing.T) {
+	recorder, err := write("text/csv", http.StatusOK, createList())
+	require.Nil(t, err)
+
+	require.Contains(t, recorder.Header().Get("Content-Type"), "text/csv")
+	require.Equal(
+		t,
+		"id,title,count,owner,created_at\n"+
+			itemId.String()+`,"first, quoted",3,"{""name"":""owner""}",2026-01-02T03:04:05Z`+"\n"+
+			itemId.String()+",second,1,,2026-01-02T03:04:05Z\n",
+		recorder.Body.String(),
+	)
+}
+
+func TestWrite_ShouldRejectNotAcceptedFormats(t *testing.T) {
+	testCases := []struct {
+		name   string
+		accept string
+		body   interface{}
+	}{
+		{"csv of a single item", "text/csv", &getItemResponseDto{Title: "item"}},
+		{"unknown format", "application/xml", createList()},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder, err := write(testCase.accept, http.StatusOK, testCase.body)
+
+			var restException exceptions.IRestException
+			require.True(t, errors.As(err, &restException))
+			require.Equal(t, http.StatusNotAcceptable, restException.GetHttpStatusCode())
+			require.False(t, recorder.Flushed)
+			require.Empty(t, recorder.Body.String())
+		})
+	}
+}
+
+func TestWrite_ShouldUseStatusSetByHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/items", nil)
+
+	response.SetStatus(ctx, http.StatusAccepted)
+	require.Nil(t, response.Write(ctx, http.StatusCreated, &getItemResponseDto{Title: "item"}))
+
+	require.Equal(t, http.StatusAccepted, recorder.Code)
+}
+
+func TestWrite_ShouldWriteFilesAsAttachments(t *testing.T) {
+	testCases := []struct {
+		name          string
+		body          interface{}
+		contentLength string
+	}{
+		{
+			"bytes",
+			&response.Bytes{ContentType: "text/plain", FileName: "отчёт.txt", Data: []byte("content")},
+			"",
+		},
+		{
+			"file",
+			&response.File{ContentType: "text/plain", FileName: "отчёт.txt", Size: 7, Reader: strings.NewReader("content")},
+			"7",
+		},
+		{
+			"stream",
+			&response.Stream{
+				ContentType: "text/plain",
+				FileName:    "отчёт.txt",
+				WriteBody: func(writer io.Writer) error {
+					_, writingError := io.WriteString(writer, "content")
+					return writingError
+				},
+			},
+			"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder, err := write("application/json", http.StatusOK, testCase.body)
+			require.Nil(t, err)
+
+			require.Equal(t, http.StatusOK, recorder.Code)
+			require.Equal(t, "text/plain", recorder.Header().Get("Content-Type"))
+			require.Equal(t, "attachment; filename*=utf-8''%D0%BE%D1%82%D1%87%D1%91%D1%82.txt", recorder.Header().Get("Content-Disposition"))
+			require.Equal(t, "content", recorder.Body.String())
+			if testCase.contentLength != "" {
+				require.Equal(t, testCase.contentLength, recorder.Header().Get("Content-Length"))
+			}
+		})
+	}
+}
+
+func TestWrite_ShouldRedirect(t *testing.T) {
+	recorder, err := write("", http.StatusOK, &response.Redirect{Location: "https://s3.example.com/exports/file.zip?signature=1"})
+	require.Nil(t, err)
+
+	require.Equal(t, http.StatusFound, recorder.Code)
+	require.Equal(t, "https://s3.example.com/exports/file.zip?signature=1", recorder.Header().Get("Location"))
+}
+
+func TestDescribe_ShouldDescribeContentOfResponse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		response reflect.Type
+		status   int
+		content  map[string]openapi.ContentFormat
+	}{
+		{
+			"list",
+			reflect.TypeFor[listItemsResponseDto](),
+			http.StatusOK,
+			map[string]openapi.ContentFormat{
+				response.JsonContentType:    openapi.StructuredContent,
+				response.MsgPackContentType: openapi.StructuredContent,
+				response.CsvContentType:     openapi.TextContent,
+			},
+		},
+		{
+			"item",
+			reflect.TypeFor[getItemResponseDto](),
+			http.StatusOK,
+			map[string]openapi.ContentFormat{
+				response.JsonContentType:    openapi.StructuredContent,
+				response.MsgPackContentType: openapi.StructuredContent,
+			},
+		},
+		{
+			"file",
+			reflect.TypeFor[response.Stream](),
+			http.StatusOK,
+			map[string]openapi.ContentFormat{response.BinaryContentType: openapi.BinaryContent},
+		},
+		{"redirect", reflect.TypeFor[response.Redirect](), http.StatusFound, nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			route := openapi.Route{Status: http.StatusOK, Response: testCase.response}
+			response.Describe(&route)
+
+			require.Equal(t, testCase.status, route.Status)
+			require.Equal(t, testCase.content, route.ResponseContent)
+		})
+	}
+}
+
+func TestWrite_ShouldNotWriteUnmodifiedResources(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	version := etag.FromVersion(createdAt)
+
+	for _, testCase := range []struct {
+		method      string
+		ifNoneMatch string
+		status      int
+	}{
+		{http.MethodGet, version, http.StatusNotModified},
+		{http.MethodGet, `"other"`, http.StatusOK},
+		{http.MethodPut, version, http.StatusOK},
+	} {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(testCase.method, "/items/1", nil)
+		ctx.Request.Header.Set(etag.IfNoneMatchHeader, testCase.ifNoneMatch)
+
+		etag.Set(ctx, createdAt)
+		require.Nil(t, response.Write(ctx, http.StatusOK, &getItemResponseDto{Title: "item"}))
+
+		require.Equal(t, testCase.status, recorder.Code)
+		require.Equal(t, version, recorder.Header().Get(etag.Header))
+		if testCase.status == http.StatusNotModified {
+			require.Empty(t, recorder.Body.String())
+		}
+	}
+}
+
+func TestWrite_ShouldTagBodyWeakly(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	serve := func(accept string, ifNoneMatch string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/items/1", nil)
+		ctx.Request.Header.Set("Accept", accept)
+		ctx.Request.Header.Set(etag.IfNoneMatchHeader, ifNoneMatch)
+
+		response.TagBody(ctx)
+		require.Nil(t, response.Write(ctx, http.StatusOK, &getItemResponseDto{Title: "item"}))
+		return recorder
+	}
+
+	first := serve("application/json", "")
+	require.Equal(t, http.StatusOK, first.Code)
+	require.JSONEq(t, `{"title":"item"}`, first.Body.String())
+	require.Contains(t, first.Header().Get("Content-Type"), "application/json")
+	require.Contains(t, first.Header().Get("Vary"), "Authorization")
+
+	tag := first.Header().Get(etag.Header)
+	require.True(t, strings.HasPrefix(tag, `W/"`))
+
+	revalidated := serve("application/json", tag)
+	require.Equal(t, http.StatusNotModified, revalidated.Code)
+	require.Empty(t, revalidated.Body.String())
+
+	// another representation of the same resource has another tag
+	msgPack := serve("application/msgpack", tag)
+	require.Equal(t, http.StatusOK, msgPack.Code)
+	require.NotEqual(t, tag, msgPack.Header().Get(etag.Header))
+}
@@ -0,0 +1,185 @@
+package router_tests
+
+import (
+	"chat_app_backend/internal/access"
+	"chat_app_backend/internal/authentication"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/logger"
+	"chat_app_backend/internal/middleware"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/validator"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type authenticatorFunc func(ctx *gin.Context) (*authentication.Identity, exceptions.ITrackableException)
+
+func (a authenticatorFunc) Authenticate(ctx *gin.Context) (*authentication.Identity, exceptions.ITrackableException) {
+	return a(ctx)
+}
+
+// authenticateAs authenticates every request as the user, a nil user makes every request anonymous.
+func authenticateAs(user *db_queries.User, impersonator *db_queries.User) authentication.IAuthenticator {
+	return authenticatorFunc(
+		func(_ *gin.Context) (*authentication.Identity, exceptions.ITrackableException) {
+			if user == nil {
+				return nil, common_exceptions.UnauthorizedException{
+					BaseRestException: exceptions.BaseRestException{
+						ITrackableException: exceptions.CreateTrackableExceptionFromStringF("no token"),
+						Message:             "",
+					},
+				}
+			}
+
+			return &authentication.Identity{User: user, Impersonator: impersonator}, nil
+		},
+	)
+}
+
+func handleUser(
+	_ *request,
+	_ service_wrapper.IServiceWrapper,
+	_ *gin.Context,
+	env *request_env.RequestEnv,
+) (*response, exceptions.ITrackableException) {
+	if env.User == nil {
+		return &response{Name: "anonymous"}, nil
+	}
+
+	return &response{Name: env.User.FullName}, nil
+}
+
+func createAuthorizedEngine(user *db_queries.User, requirements ...router.Requirement) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.Use(middleware.ErrorHandlerMiddleware(logger.CreateLogger(io.Discard)))
+
+	wrapper := stubWrapper{authenticator: authenticateAs(user, nil)}
+
+	router.CreateController(
+		engine,
+		"/users",
+		[]router.IRoute{
+			&router.AuthorizedRoute[request, response]{
+				Route: router.CreateBaseRoute(
+					wrapper,
+					"/me",
+					handleUser,
+					validator.Validator[request]{},
+					router.GET,
+				),
+				Requirements: requirements,
+			},
+			&router.AuthorizedRoute[request, response]{
+				Route: router.CreateBaseRoute(
+					wrapper,
+					"/restore",
+					handleUser,
+					validator.Validator[request]{},
+					router.GET,
+				),
+				AllowDeletedUsers: true,
+			},
+			&router.PublicRoute[request, response]{
+				Route: router.CreateBaseRoute(
+					wrapper,
+					"/public",
+					handleUser,
+					validator.Validator[request]{},
+					router.GET,
+				),
+			},
+		},
+	).ConfigureGroup()
+
+	return engine
+}
+
+func serveGet(engine *gin.Engine, path string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	return recorder
+}
+
+func TestAuthorizedRoute_ShouldRejectAnonymousRequests(t *testing.T) {
+	recorder := serveGet(createAuthorizedEngine(nil), "/users/me")
+
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	require.JSONEq(t, `{"message":"Unauthorized"}`, recorder.Body.String())
+}
+
+func TestAuthorizedRoute_ShouldCheckRequirements(t *testing.T) {
+	user := &db_queries.User{FullName: "user", Role: db_queries.RoleTypeUSER}
+	admin := &db_queries.User{FullName: "admin", Role: db_queries.RoleTypeADMIN, EmailVerified: true}
+
+	testCases := []struct {
+		name        string
+		user        *db_queries.User
+		requirement router.Requirement
+		status      int
+	}{
+		{"missing role", user, router.RequireRole(db_queries.RoleTypeADMIN), http.StatusForbidden},
+		{"role", admin, router.RequireRole(db_queries.RoleTypeADMIN), http.StatusOK},
+		{"missing permission", user, router.RequirePermission(access.ManageInterests), http.StatusForbidden},
+		{"permission", admin, router.RequirePermission(access.ManageInterests), http.StatusOK},
+		{"unverified email", user, router.RequireVerifiedEmail(), http.StatusForbidden},
+		{"verified email", admin, router.RequireVerifiedEmail(), http.StatusOK},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := serveGet(createAuthorizedEngine(testCase.user, testCase.requirement), "/users/me")
+
+			require.Equal(t, testCase.status, recorder.Code)
+		})
+	}
+}
+
+func TestAuthorizedRoute_ShouldRejectDeletedUsers(t *testing.T) {
+	deletedAt := time.Now()
+	engine := createAuthorizedEngine(&db_queries.User{FullName: "user", DeletedAt: &deletedAt})
+
+	require.Equal(t, http.StatusForbidden, serveGet(engine, "/users/me").Code)
+
+	allowed := serveGet(engine, "/users/restore")
+	require.Equal(t, http.StatusOK, allowed.Code)
+	require.JSONEq(t, `{"name":"user"}`, allowed.Body.String())
+}
+
+func TestPublicRoute_ShouldNotAuthenticate(t *testing.T) {
+	recorder := serveGet(createAuthorizedEngine(nil), "/users/public")
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.JSONEq(t, `{"name":"anonymous"}`, recorder.Body.String())
+}
+
+func TestRegisterRoute_ShouldRequireDeclaredAccess(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	controller := router.CreateController(
+		gin.New(),
+		"/users",
+		[]router.IRoute{
+			router.CreateBaseRoute(
+				stubWrapper{},
+				"/",
+				handleUser,
+				validator.Validator[request]{},
+				router.GET,
+			),
+		},
+	)
+
+	require.Panics(t, controller.ConfigureGroup)
+}
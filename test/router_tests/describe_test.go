@@ -0,0 +1,53 @@
+package router_tests
+
+import (
+	"chat_app_backend/internal/openapi"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/validator"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestController_ShouldDescribeRoutesWithTheirSecurity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	document := openapi.CreateDocument(openapi.Info{Title: "test", Version: "1"})
+	router.CreateController(
+		gin.New(),
+		"/users",
+		[]router.IRoute{
+			&router.AuthorizedRoute[request, response]{
+				Route: &router.DestructiveRoute[request, response]{
+					Route: router.CreateBaseRoute(
+						stubWrapper{},
+						"/:id",
+						handleDestructive,
+						validator.Validator[request]{},
+						router.DELETE,
+					),
+				},
+			},
+			&router.ServiceAccountRoute[request, response]{
+				Route: router.CreateBaseRoute(
+					stubWrapper{},
+					"/",
+					handle,
+					validator.Validator[request]{},
+					router.POST,
+				),
+				Scopes: []string{"messages:write"},
+			},
+		},
+	).Describe(document)
+
+	deleteOperation := document.Paths["/users/{id}"]["delete"]
+	require.Equal(t, []string{"users"}, deleteOperation.Tags)
+	require.Equal(t, []openapi.SecurityRequirement{{openapi.BearerAuth: {}}}, deleteOperation.Security)
+	require.Contains(t, deleteOperation.Responses, "200")
+
+	createOperation := document.Paths["/users/"]["post"]
+	require.Equal(t, []openapi.SecurityRequirement{{openapi.ApiKeyAuth: {"messages:write"}}}, createOperation.Security)
+	require.Contains(t, createOperation.Responses, "201")
+}
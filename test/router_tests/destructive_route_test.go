@@ -0,0 +1,76 @@
+package router_tests
+
+import (
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/logger"
+	"chat_app_backend/internal/middleware"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/validator"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func handleDestructive(
+	_ *request,
+	_ service_wrapper.IServiceWrapper,
+	_ *gin.Context,
+	env *request_env.RequestEnv,
+) (*response, exceptions.ITrackableException) {
+	return &response{Name: env.User.FullName}, nil
+}
+
+func createDestructiveEngine(impersonator *db_queries.User) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.Use(middleware.ErrorHandlerMiddleware(logger.CreateLogger(io.Discard)))
+
+	router.CreateController(
+		engine,
+		"/users",
+		[]router.IRoute{
+			&router.AuthorizedRoute[request, response]{
+				Route: &router.DestructiveRoute[request, response]{
+					Route: router.CreateBaseRoute(
+						stubWrapper{
+							authenticator: authenticateAs(&db_queries.User{FullName: "user"}, impersonator),
+						},
+						"/",
+						handleDestructive,
+						validator.Validator[request]{},
+						router.DELETE,
+					),
+				},
+			},
+		},
+	).ConfigureGroup()
+
+	return engine
+}
+
+func serveDestructive(engine *gin.Engine) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, "/users/", nil))
+	return recorder
+}
+
+func TestDestructiveRoute_ShouldRejectImpersonatedRequests(t *testing.T) {
+	recorder := serveDestructive(createDestructiveEngine(&db_queries.User{FullName: "admin"}))
+
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+func TestDestructiveRoute_ShouldAllowRegularRequests(t *testing.T) {
+	recorder := serveDestructive(createDestructiveEngine(nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.JSONEq(t, `{"name":"user"}`, recorder.Body.String())
+}
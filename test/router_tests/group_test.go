@@ -0,0 +1,182 @@
+package router_tests
+
+import (
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/logger"
+	"chat_app_backend/internal/middleware"
+	"chat_app_backend/internal/openapi"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/validator"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+const traceHeader = "X-Trace"
+
+func trace(name string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Writer.Header().Add(traceHeader, name)
+		ctx.Next()
+	}
+}
+
+func respondWith(name string) func(
+	*request,
+	service_wrapper.IServiceWrapper,
+	*gin.Context,
+	*request_env.RequestEnv,
+) (*response, exceptions.ITrackableException) {
+	return func(
+		_ *request,
+		_ service_wrapper.IServiceWrapper,
+		_ *gin.Context,
+		_ *request_env.RequestEnv,
+	) (*response, exceptions.ITrackableException) {
+		return &response{Name: name}, nil
+	}
+}
+
+func publicRoute(path string, name string, method router.HttpMethod) router.IRoute {
+	return &router.PublicRoute[request, response]{
+		Route: router.CreateBaseRoute(
+			stubWrapper{},
+			path,
+			respondWith(name),
+			validator.Validator[request]{},
+			method,
+		),
+	}
+}
+
+func createGroupedControllers(engine *gin.Engine) []router.IController {
+	return []router.IController{
+		router.CreateController(
+			engine,
+			"/users",
+			[]router.IRoute{publicRoute("/me", "v1", router.GET)},
+		),
+		router.CreateController(
+			engine,
+			"/users",
+			[]router.IRoute{publicRoute("/me", "v2", router.GET)},
+		).Version("v2"),
+		router.CreateController(
+			engine,
+			"/reports",
+			[]router.IRoute{
+				&router.MiddlewareRoute[request, response]{
+					Route:      publicRoute("/", "reports", router.GET),
+					Middleware: []gin.HandlerFunc{trace("route")},
+				},
+			},
+		).
+			Use(trace("controller")).
+			WithGroups(
+				router.Group{
+					Path:       "/daily",
+					Middleware: []gin.HandlerFunc{trace("group")},
+					Routes:     []router.IRoute{publicRoute("/", "daily", router.GET)},
+					Groups: []router.Group{
+						{
+							Path:       "/uploads",
+							Middleware: []gin.HandlerFunc{trace("nested group")},
+							Routes: []router.IRoute{
+								&router.MiddlewareRoute[request, response]{
+									Route:      publicRoute("/", "uploaded", router.POST),
+									Middleware: []gin.HandlerFunc{middleware.BodySizeLimitMiddleware(8), trace("route")},
+								},
+							},
+						},
+					},
+				},
+			),
+	}
+}
+
+func createGroupedEngine() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.Use(middleware.ErrorHandlerMiddleware(logger.CreateLogger(io.Discard)))
+
+	for _, controller := range createGroupedControllers(engine) {
+		controller.ConfigureGroup()
+	}
+
+	return engine
+}
+
+func TestController_ShouldServeVersionsSideBySide(t *testing.T) {
+	engine := createGroupedEngine()
+
+	unversioned := serveGet(engine, "/users/me")
+	require.Equal(t, http.StatusOK, unversioned.Code)
+	require.JSONEq(t, `{"name":"v1"}`, unversioned.Body.String())
+
+	versioned := serveGet(engine, "/v2/users/me")
+	require.Equal(t, http.StatusOK, versioned.Code)
+	require.JSONEq(t, `{"name":"v2"}`, versioned.Body.String())
+}
+
+func TestController_ShouldRunMiddlewareOfGroupsAndRoutesInOrder(t *testing.T) {
+	engine := createGroupedEngine()
+
+	testCases := []struct {
+		path  string
+		name  string
+		trace []string
+	}{
+		{"/reports/", "reports", []string{"controller", "route"}},
+		{"/reports/daily/", "daily", []string{"controller", "group"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.path, func(t *testing.T) {
+			recorder := serveGet(engine, testCase.path)
+
+			require.Equal(t, http.StatusOK, recorder.Code)
+			require.JSONEq(t, `{"name":"`+testCase.name+`"}`, recorder.Body.String())
+			require.Equal(t, testCase.trace, recorder.Header().Values(traceHeader))
+		})
+	}
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/reports/daily/uploads/", strings.NewReader("{}")))
+
+	require.Equal(t, http.StatusCreated, recorder.Code)
+	require.Equal(t, []string{"controller", "group", "nested group", "route"}, recorder.Header().Values(traceHeader))
+}
+
+func TestMiddlewareRoute_ShouldRejectTooLargeBodies(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	createGroupedEngine().ServeHTTP(
+		recorder,
+		httptest.NewRequest(http.MethodPost, "/reports/daily/uploads/", strings.NewReader(`{"name":"too large"}`)),
+	)
+
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Equal(t, []string{"controller", "group", "nested group"}, recorder.Header().Values(traceHeader))
+}
+
+func TestController_ShouldDescribeGroupsAndVersions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	document := openapi.CreateDocument(openapi.Info{Title: "test", Version: "1"})
+	for _, controller := range createGroupedControllers(gin.New()) {
+		controller.Describe(document)
+	}
+
+	require.Contains(t, document.Paths, "/users/me")
+	require.Contains(t, document.Paths, "/v2/users/me")
+	require.Contains(t, document.Paths, "/reports/daily/uploads/")
+	require.Equal(t, []string{"users"}, document.Paths["/v2/users/me"]["get"].Tags)
+	require.Equal(t, []string{"reports"}, document.Paths["/reports/daily/uploads/"]["post"].Tags)
+}
@@ -0,0 +1,263 @@
+package router_tests
+
+import (
+	"bytes"
+	"chat_app_backend/internal/authentication"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/idempotency"
+	"chat_app_backend/internal/logger"
+	"chat_app_backend/internal/middleware"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/validator"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore keeps the records like the redis store, without the expiration.
+type memoryStore struct {
+	mutex   sync.Mutex
+	records map[string]idempotency.Record
+}
+
+func (m *memoryStore) Reserve(_ context.Context, key string, fingerprint string) (bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.records[key]; exists {
+		return false, nil
+	}
+
+	m.records[key] = idempotency.Record{Fingerprint: fingerprint}
+	return true, nil
+}
+
+func (m *memoryStore) Get(_ context.Context, key string) (*idempotency.Record, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	record, exists := m.records[key]
+	if !exists {
+		return nil, nil
+	}
+
+	return &record, nil
+}
+
+func (m *memoryStore) Complete(_ context.Context, key string, record *idempotency.Record) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.records[key] = *record
+	return nil
+}
+
+func (m *memoryStore) Release(_ context.Context, key string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.records, key)
+	return nil
+}
+
+type createItemRequest struct {
+	Title string                `json:"title" form:"title"`
+	Icon  *multipart.FileHeader `json:"-" form:"icon"`
+}
+
+type createItemResponse struct {
+	Title  string `json:"title"`
+	Number int64  `json:"number"`
+}
+
+// itemsHandler numbers the created items, so the replayed responses are told apart from the new ones.
+type itemsHandler struct {
+	created atomic.Int64
+	// started and proceed hold the handler in flight, when they are set
+	started chan struct{}
+	proceed chan struct{}
+}
+
+func (h *itemsHandler) Handle(
+	request *createItemRequest,
+	_ service_wrapper.IServiceWrapper,
+	_ *gin.Context,
+	_ *request_env.RequestEnv,
+) (*createItemResponse, exceptions.ITrackableException) {
+	if h.started != nil {
+		h.started <- struct{}{}
+		<-h.proceed
+	}
+
+	if request.Title == "" {
+		return nil, common_exceptions.InvalidBodyException{
+			BaseRestException: exceptions.BaseRestException{
+				ITrackableException: exceptions.CreateTrackableExceptionFromStringF("empty title"),
+				Message:             "title is required",
+			},
+		}
+	}
+
+	return &createItemResponse{Title: request.Title, Number: h.created.Add(1)}, nil
+}
+
+func createIdempotentEngine(handler *itemsHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.Use(middleware.ErrorHandlerMiddleware(logger.CreateLogger(io.Discard)))
+
+	wrapper := stubWrapper{
+		authenticator: authenticatorFunc(
+			func(ctx *gin.Context) (*authentication.Identity, exceptions.ITrackableException) {
+				id := extensions.UUID{UUID: uuid.NewSHA1(uuid.Nil, []byte(ctx.GetHeader(userHeader)))}
+				return &authentication.Identity{User: &db_queries.User{ID: id}}, nil
+			},
+		),
+		idempotency: &memoryStore{records: make(map[string]idempotency.Record)},
+	}
+
+	router.CreateController(
+		engine,
+		"/items",
+		[]router.IRoute{
+			&router.AuthorizedRoute[createItemRequest, createItemResponse]{
+				Route: router.CreateBaseRoute(
+					wrapper,
+					"/",
+					handler.Handle,
+					validator.Validator[createItemRequest]{},
+					router.POST,
+				),
+			},
+		},
+	).ConfigureGroup()
+
+	return engine
+}
+
+func serveCreateItem(engine *gin.Engine, user string, key string, title string) *httptest.ResponseRecorder {
+	httpRequest := httptest.NewRequest(http.MethodPost, "/items/", strings.NewReader(fmt.Sprintf(`{"title":%q}`, title)))
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set(userHeader, user)
+	if key != "" {
+		httpRequest.Header.Set(middleware.IdempotencyKeyHeader, key)
+	}
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httpRequest)
+	return recorder
+}
+
+func TestIdempotency_ShouldReplayCompletedRequests(t *testing.T) {
+	engine := createIdempotentEngine(&itemsHandler{})
+
+	first := serveCreateItem(engine, "user", "key-1", "item")
+	require.Equal(t, http.StatusCreated, first.Code)
+	require.JSONEq(t, `{"title":"item","number":1}`, first.Body.String())
+
+	duplicate := serveCreateItem(engine, "user", "key-1", "item")
+	require.Equal(t, http.StatusCreated, duplicate.Code)
+	require.JSONEq(t, `{"title":"item","number":1}`, duplicate.Body.String())
+	require.Equal(t, "true", duplicate.Header().Get(middleware.IdempotentReplayedHeader))
+	require.Contains(t, duplicate.Header().Get("Content-Type"), "application/json")
+
+	another := serveCreateItem(engine, "user", "key-2", "item")
+	require.JSONEq(t, `{"title":"item","number":2}`, another.Body.String())
+}
+
+func TestIdempotency_ShouldScopeKeysToUsers(t *testing.T) {
+	engine := createIdempotentEngine(&itemsHandler{})
+
+	require.JSONEq(t, `{"title":"item","number":1}`, serveCreateItem(engine, "first", "key", "item").Body.String())
+	require.JSONEq(t, `{"title":"item","number":2}`, serveCreateItem(engine, "second", "key", "item").Body.String())
+}
+
+func TestIdempotency_ShouldRejectKeyReusedForAnotherRequest(t *testing.T) {
+	engine := createIdempotentEngine(&itemsHandler{})
+
+	require.Equal(t, http.StatusCreated, serveCreateItem(engine, "user", "key", "item").Code)
+	require.Equal(t, http.StatusUnprocessableEntity, serveCreateItem(engine, "user", "key", "other item").Code)
+}
+
+func TestIdempotency_ShouldRejectDuplicatesInFlight(t *testing.T) {
+	handler := &itemsHandler{started: make(chan struct{}), proceed: make(chan struct{})}
+	engine := createIdempotentEngine(handler)
+
+	firstDone := make(chan *httptest.ResponseRecorder)
+	go func() {
+		firstDone <- serveCreateItem(engine, "user", "key", "item")
+	}()
+
+	<-handler.started
+	require.Equal(t, http.StatusConflict, serveCreateItem(engine, "user", "key", "item").Code)
+
+	close(handler.proceed)
+	require.Equal(t, http.StatusCreated, (<-firstDone).Code)
+}
+
+func TestIdempotency_ShouldNotStoreFailedRequests(t *testing.T) {
+	engine := createIdempotentEngine(&itemsHandler{})
+
+	require.Equal(t, http.StatusBadRequest, serveCreateItem(engine, "user", "key", "").Code)
+	require.Equal(t, http.StatusCreated, serveCreateItem(engine, "user", "key", "item").Code)
+}
+
+func TestIdempotency_ShouldHandleRequestsWithoutKey(t *testing.T) {
+	engine := createIdempotentEngine(&itemsHandler{})
+
+	require.JSONEq(t, `{"title":"item","number":1}`, serveCreateItem(engine, "user", "", "item").Body.String())
+	require.JSONEq(t, `{"title":"item","number":2}`, serveCreateItem(engine, "user", "", "item").Body.String())
+}
+
+// serveUpload sends the title and the icon as a multipart form with a boundary of its own, like the retries
+// of the clients do.
+func serveUpload(engine *gin.Engine, key string, boundary string, title string, icon string) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	_ = writer.SetBoundary(boundary)
+	_ = writer.WriteField("title", title)
+	iconWriter, _ := writer.CreateFormFile("icon", "icon.png")
+	_, _ = iconWriter.Write([]byte(icon))
+	_ = writer.Close()
+
+	httpRequest := httptest.NewRequest(http.MethodPost, "/items/", &body)
+	httpRequest.Header.Set("Content-Type", writer.FormDataContentType())
+	httpRequest.Header.Set(userHeader, "user")
+	httpRequest.Header.Set(middleware.IdempotencyKeyHeader, key)
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httpRequest)
+	return recorder
+}
+
+func TestIdempotency_ShouldReplayUploadsWithAnotherBoundary(t *testing.T) {
+	engine := createIdempotentEngine(&itemsHandler{})
+
+	first := serveUpload(engine, "key", "first-boundary", "item", "icon")
+	require.Equal(t, http.StatusCreated, first.Code)
+	require.JSONEq(t, `{"title":"item","number":1}`, first.Body.String())
+
+	retry := serveUpload(engine, "key", "second-boundary", "item", "icon")
+	require.Equal(t, http.StatusCreated, retry.Code)
+	require.JSONEq(t, `{"title":"item","number":1}`, retry.Body.String())
+	require.Equal(t, "true", retry.Header().Get(middleware.IdempotentReplayedHeader))
+
+	require.Equal(t, http.StatusUnprocessableEntity, serveUpload(engine, "key", "third-boundary", "item", "other icon").Code)
+}
@@ -0,0 +1,152 @@
+package router_tests
+
+import (
+	"chat_app_backend/internal/authentication"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/logger"
+	"chat_app_backend/internal/middleware"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/validator"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+const userHeader = "X-Test-User"
+
+type environmentResponse struct {
+	User        string   `json:"user"`
+	RequestId   string   `json:"request_id"`
+	Locales     []string `json:"locales"`
+	ContextUser string   `json:"context_user"`
+}
+
+func handleEnvironment(
+	_ *request,
+	_ service_wrapper.IServiceWrapper,
+	ctx *gin.Context,
+	env *request_env.RequestEnv,
+) (*environmentResponse, exceptions.ITrackableException) {
+	user := env.User.FullName
+
+	// gives the concurrent requests the chance to overwrite the environment if it is shared
+	runtime.Gosched()
+
+	return &environmentResponse{
+		User:        user,
+		RequestId:   env.RequestId,
+		Locales:     env.Locales,
+		ContextUser: request_env.FromContext(ctx.Request.Context()).User.FullName,
+	}, nil
+}
+
+func createEnvironmentEngine() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.Use(middleware.RequestIdMiddleware())
+	engine.Use(middleware.ErrorHandlerMiddleware(logger.CreateLogger(io.Discard)))
+
+	router.CreateController(
+		engine,
+		"/env",
+		[]router.IRoute{
+			&router.AuthorizedRoute[request, environmentResponse]{
+				Route: router.CreateBaseRoute(
+					stubWrapper{
+						authenticator: authenticatorFunc(
+							func(ctx *gin.Context) (*authentication.Identity, exceptions.ITrackableException) {
+								return &authentication.Identity{User: &db_queries.User{FullName: ctx.GetHeader(userHeader)}}, nil
+							},
+						),
+					},
+					"/",
+					handleEnvironment,
+					validator.Validator[request]{},
+					router.GET,
+				),
+			},
+		},
+	).ConfigureGroup()
+
+	return engine
+}
+
+// serveEnvironment does not fail the test itself, as it is called from the other goroutines.
+func serveEnvironment(engine *gin.Engine, user string, requestId string) (environmentResponse, error) {
+	httpRequest := httptest.NewRequest(http.MethodGet, "/env/", nil)
+	httpRequest.Header.Set(userHeader, user)
+	httpRequest.Header.Set(middleware.RequestIdHeader, requestId)
+	httpRequest.Header.Set("Accept-Language", "de;q=0.5, en-GB")
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httpRequest)
+	if recorder.Code != http.StatusOK {
+		return environmentResponse{}, fmt.Errorf("unexpected status %d", recorder.Code)
+	}
+
+	var response environmentResponse
+	decodingError := json.NewDecoder(recorder.Body).Decode(&response)
+	return response, decodingError
+}
+
+func TestRequestEnv_ShouldBeFilledFromRequest(t *testing.T) {
+	response, err := serveEnvironment(createEnvironmentEngine(), "user", "request-1")
+	require.NoError(t, err)
+
+	require.Equal(
+		t,
+		environmentResponse{User: "user", RequestId: "request-1", Locales: []string{"en-gb", "de"}, ContextUser: "user"},
+		response,
+	)
+}
+
+// TestRequestEnv_ShouldBeIsolatedBetweenConcurrentRequests is meant to be run with the race detector,
+// which reports the environment shared between the requests even when the responses happen to be right.
+func TestRequestEnv_ShouldBeIsolatedBetweenConcurrentRequests(t *testing.T) {
+	engine := createEnvironmentEngine()
+
+	const requestsCount = 200
+
+	var waitGroup sync.WaitGroup
+	failures := make(chan string, requestsCount)
+
+	for index := 0; index < requestsCount; index++ {
+		waitGroup.Add(1)
+
+		go func(user string, requestId string) {
+			defer waitGroup.Done()
+
+			response, err := serveEnvironment(engine, user, requestId)
+			if err != nil {
+				failures <- fmt.Sprintf("%s failed: %s", user, err)
+				return
+			}
+
+			if response.User != user || response.ContextUser != user || response.RequestId != requestId {
+				failures <- fmt.Sprintf("%s received %+v", user, response)
+			}
+		}(fmt.Sprintf("user-%d", index), fmt.Sprintf("request-%d", index))
+	}
+
+	waitGroup.Wait()
+	close(failures)
+
+	collected := make([]string, 0)
+	for failure := range failures {
+		collected = append(collected, failure)
+	}
+	require.Empty(t, collected, strings.Join(collected, "\n"))
+}
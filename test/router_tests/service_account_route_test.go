@@ -0,0 +1,159 @@
+package router_tests
+
+import (
+	"chat_app_backend/internal/authentication"
+	"chat_app_backend/internal/exceptions"
+	"chat_app_backend/internal/idempotency"
+	"chat_app_backend/internal/logger"
+	"chat_app_backend/internal/middleware"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/router"
+	"chat_app_backend/internal/service_wrapper"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/internal/validator"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type stubWrapper struct {
+	service_wrapper.IServiceWrapper
+	authenticator authentication.IAuthenticator
+	idempotency   idempotency.IStore
+}
+
+func (s stubWrapper) GetAuthenticator() authentication.IAuthenticator {
+	return s.authenticator
+}
+
+func (s stubWrapper) GetIdempotencyStore() idempotency.IStore {
+	return s.idempotency
+}
+
+func (s stubWrapper) WrapRoute(handler service_wrapper.RouteHandler) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		handler(s, ctx)
+	}
+}
+
+type request struct{}
+
+type response struct {
+	Name string `json:"name"`
+}
+
+func handle(
+	_ *request,
+	_ service_wrapper.IServiceWrapper,
+	_ *gin.Context,
+	env *request_env.RequestEnv,
+) (*response, exceptions.ITrackableException) {
+	return &response{Name: env.ServiceAccount.Account.Name}, nil
+}
+
+func handlePublic(
+	_ *request,
+	_ service_wrapper.IServiceWrapper,
+	_ *gin.Context,
+	_ *request_env.RequestEnv,
+) (*response, exceptions.ITrackableException) {
+	return &response{Name: "public"}, nil
+}
+
+func createEngine(principal *request_env.ServiceAccountPrincipal) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.Use(middleware.ErrorHandlerMiddleware(logger.CreateLogger(io.Discard)))
+	engine.Use(func(ctx *gin.Context) {
+		if principal != nil {
+			ctx.Set(middleware.ServiceAccountKey, principal)
+		}
+		ctx.Next()
+	})
+
+	router.CreateController(
+		engine,
+		"/bot",
+		[]router.IRoute{
+			&router.ServiceAccountRoute[request, response]{
+				Route: router.CreateBaseRoute(
+					stubWrapper{},
+					"/",
+					handle,
+					validator.Validator[request]{},
+					router.GET,
+				),
+				Scopes: []string{"messages:write"},
+			},
+			&router.PublicRoute[request, response]{
+				Route: router.CreateBaseRoute(
+					stubWrapper{},
+					"/public",
+					handlePublic,
+					validator.Validator[request]{},
+					router.GET,
+				),
+			},
+		},
+	).ConfigureGroup()
+
+	return engine
+}
+
+func serve(engine *gin.Engine) *httptest.ResponseRecorder {
+	return serveWithApiKey(engine, "/bot/", "")
+}
+
+func serveWithApiKey(engine *gin.Engine, path string, key string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodGet, path, nil)
+	if key != "" {
+		request.Header.Set(middleware.ApiKeyHeader, key)
+	}
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestServiceAccountRoute_ShouldRequireApiKey(t *testing.T) {
+	recorder := serve(createEngine(nil))
+
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestServiceAccountRoute_ShouldRequireScopes(t *testing.T) {
+	recorder := serve(createEngine(&request_env.ServiceAccountPrincipal{
+		Account: &db_queries.ServiceAccount{Name: "bot"},
+		Scopes:  []string{"chats:read"},
+	}))
+
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+func TestServiceAccountRoute_ShouldPassPrincipalToHandler(t *testing.T) {
+	recorder := serve(createEngine(&request_env.ServiceAccountPrincipal{
+		Account: &db_queries.ServiceAccount{Name: "bot"},
+		Scopes:  []string{"chats:read", "messages:write"},
+	}))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.JSONEq(t, `{"name":"bot"}`, recorder.Body.String())
+}
+
+func TestServiceAccountRoute_ShouldRejectMalformedApiKey(t *testing.T) {
+	recorder := serveWithApiKey(createEngine(nil), "/bot/", "malformed")
+
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestApiKey_ShouldBeIgnoredOutsideServiceAccountRoutes(t *testing.T) {
+	recorder := serveWithApiKey(createEngine(nil), "/bot/public", "malformed")
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.JSONEq(t, `{"name":"public"}`, recorder.Body.String())
+}
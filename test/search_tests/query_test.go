@@ -0,0 +1,32 @@
+package search_tests
+
+import (
+	"chat_app_backend/internal/search"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixQuery_ShouldMatchEveryWordByPrefix(t *testing.T) {
+	query := search.PrefixQuery("Board  Games")
+
+	require.NotNil(t, query)
+	require.Equal(t, "board:* & games:*", *query)
+}
+
+func TestPrefixQuery_ShouldDropTsqueryOperators(t *testing.T) {
+	query := search.PrefixQuery("chess | !go & (rock'n'roll):*")
+
+	require.NotNil(t, query)
+	require.Equal(t, "chess:* & go:* & rock:* & n:* & roll:*", *query)
+}
+
+func TestPrefixQuery_ShouldReturnNilWithoutWords(t *testing.T) {
+	require.Nil(t, search.PrefixQuery(""))
+	require.Nil(t, search.PrefixQuery(" &|! "))
+}
+
+func TestEscapeLike_ShouldEscapeWildcards(t *testing.T) {
+	require.Equal(t, `100\% real\_name\\x`, search.EscapeLike(`100% real_name\x`))
+	require.Equal(t, "john", search.EscapeLike("john"))
+}
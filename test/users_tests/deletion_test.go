@@ -0,0 +1,95 @@
+package users_tests
+
+import (
+	shared_audit "chat_app_backend/application/handlers/shared/audit"
+	shared_users "chat_app_backend/application/handlers/shared/users"
+	"chat_app_backend/internal/audit"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/test/fakes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func scheduledUser(deletedAt time.Time, purgeAfter time.Time) *db_queries.User {
+	return &db_queries.User{DeletedAt: &deletedAt, PurgeAfter: &purgeAfter}
+}
+
+func TestScheduleDeletion_ShouldStartGracePeriodNow(t *testing.T) {
+	purgeAfter, schedulingError := shared_users.ScheduleDeletion(&db_queries.User{}, 72*time.Hour, now)
+
+	require.Nil(t, schedulingError)
+	require.Equal(t, now.Add(72*time.Hour), purgeAfter)
+}
+
+func TestScheduleDeletion_ShouldRejectScheduledUsers(t *testing.T) {
+	_, schedulingError := shared_users.ScheduleDeletion(scheduledUser(now, now.Add(time.Hour)), 72*time.Hour, now)
+
+	require.NotNil(t, schedulingError)
+}
+
+func TestCheckRestorable_ShouldAllowRestoringDuringGracePeriod(t *testing.T) {
+	testCases := []struct {
+		name       string
+		user       *db_queries.User
+		restorable bool
+	}{
+		{"not scheduled", &db_queries.User{}, false},
+		{"during grace period", scheduledUser(now.Add(-time.Hour), now.Add(time.Hour)), true},
+		{"when grace period ends", scheduledUser(now.Add(-time.Hour), now), false},
+		{"after grace period", scheduledUser(now.Add(-2*time.Hour), now.Add(-time.Hour)), false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			restorationError := shared_users.CheckRestorable(testCase.user, now)
+
+			require.Equal(t, testCase.restorable, restorationError == nil)
+		})
+	}
+}
+
+func TestRestoreUser_ShouldRecordRestoration(t *testing.T) {
+	user := *scheduledUser(now, now.Add(time.Hour))
+	user.ID = newId()
+	restored := user
+	restored.DeletedAt = nil
+	restored.PurgeAfter = nil
+
+	db := fakes.CreateDb(map[string][]interface{}{"RestoreUser": {restored}})
+
+	restoredUser, restorationError := shared_users.RestoreUser(
+		context.Background(),
+		db_queries.New(db),
+		shared_audit.UserActor(user.ID),
+		user,
+	)
+
+	require.Nil(t, restorationError)
+	require.Equal(t, restored, restoredUser)
+	require.Equal(t, []string{"RestoreUser", "CreateAuditLogEntry"}, db.Names())
+
+	entry := db.Find("CreateAuditLogEntry").Args
+	require.Contains(t, entry, audit.ActionUserRestored)
+	require.Contains(t, entry, user.ID.String())
+}
+
+func TestRestoreUser_ShouldFailForUsersPurgedOrRestoredSinceRead(t *testing.T) {
+	user := *scheduledUser(now, now.Add(time.Hour))
+	db := fakes.CreateDb(map[string][]interface{}{})
+
+	_, restorationError := shared_users.RestoreUser(
+		context.Background(),
+		db_queries.New(db),
+		shared_audit.UserActor(user.ID),
+		user,
+	)
+
+	require.IsType(t, common_exceptions.InvalidBodyException{}, restorationError)
+	require.Nil(t, db.Find("CreateAuditLogEntry"))
+}
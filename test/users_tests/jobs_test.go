@@ -0,0 +1,158 @@
+package users_tests
+
+import (
+	"archive/zip"
+	"bytes"
+	user_jobs "chat_app_backend/application/jobs/users"
+	"chat_app_backend/application/models/users/data_export"
+	"chat_app_backend/internal/extensions"
+	"chat_app_backend/internal/s3"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/test/fakes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newId() extensions.UUID {
+	return extensions.UUID{UUID: uuid.New()}
+}
+
+func readArchive(t *testing.T, data []byte) map[string][]byte {
+	archive, openingError := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, openingError)
+
+	entries := make(map[string][]byte)
+	for _, file := range archive.File {
+		entry, entryOpeningError := file.Open()
+		require.NoError(t, entryOpeningError)
+
+		content, readingError := io.ReadAll(entry)
+		require.NoError(t, readingError)
+		entries[file.Name] = content
+	}
+
+	return entries
+}
+
+func TestExportUserDataJob_ShouldListMissingFilesInArchive(t *testing.T) {
+	user := db_queries.User{ID: newId(), FullName: "John Doe", AvatarFileName: "avatar.png"}
+	dataExport := db_queries.DataExport{ID: newId(), UserID: user.ID}
+
+	services := fakes.CreateServices(
+		map[string][]interface{}{
+			"ClaimPendingDataExports": {dataExport},
+			"GetUserById":             {user},
+			"GetUserAttachments": {
+				db_queries.Attachment{ID: newId(), Filename: "stored.png"},
+				db_queries.Attachment{ID: newId(), Filename: "lost.png"},
+			},
+		},
+		map[string][]byte{
+			fakes.StorageKey("avatar.png", s3.AvatarsBucket):     []byte("avatar"),
+			fakes.StorageKey("stored.png", s3.AttachmentsBucket): []byte("attachment"),
+		},
+	)
+
+	runError := user_jobs.ExportUserDataJob{Services: services, BatchSize: 1}.Run(context.Background())
+	require.Nil(t, runError)
+
+	completion := services.Db.Find("CompleteDataExport")
+	require.NotNil(t, completion)
+	require.Nil(t, services.Db.Find("FailDataExport"))
+
+	archiveName := *completion.Args[0].(*string)
+	entries := readArchive(t, services.Storage.Files[fakes.StorageKey(archiveName, s3.ExportsBucket)])
+
+	require.Equal(t, []byte("avatar"), entries["avatar/avatar.png"])
+	require.Equal(t, []byte("attachment"), entries["attachments/stored.png"])
+	require.NotContains(t, entries, "attachments/lost.png")
+
+	var profile data_export.ProfileDto
+	require.NoError(t, json.Unmarshal(entries["profile.json"], &profile))
+	require.Equal(t, user.FullName, profile.FullName)
+
+	var missingFiles []data_export.MissingFileDto
+	require.NoError(t, json.Unmarshal(entries["missing_files.json"], &missingFiles))
+	require.Equal(t, []data_export.MissingFileDto{{Entry: "attachments/lost.png", Filename: "lost.png"}}, missingFiles)
+}
+
+func TestPurgeUsersJob_ShouldAnonymizeMessagesBeforeRemovingUser(t *testing.T) {
+	exportName := "export.zip"
+	user := db_queries.User{ID: newId(), AvatarFileName: "avatar.png"}
+
+	services := fakes.CreateServices(
+		map[string][]interface{}{
+			"GetUsersToPurge":             {user},
+			"LockUserToPurge":             {user.ID},
+			"GetUserOwnedChatAttachments": {db_queries.Attachment{ID: newId(), Filename: "attachment.png"}},
+			"GetUserDataExports":          {db_queries.DataExport{ID: newId(), UserID: user.ID, FileName: &exportName}},
+		},
+		map[string][]byte{
+			fakes.StorageKey("avatar.png", s3.AvatarsBucket):         []byte("avatar"),
+			fakes.StorageKey("attachment.png", s3.AttachmentsBucket): []byte("attachment"),
+			fakes.StorageKey(exportName, s3.ExportsBucket):           []byte("export"),
+		},
+	)
+
+	runError := user_jobs.PurgeUsersJob{Services: services, BatchSize: 1}.Run(context.Background())
+	require.Nil(t, runError)
+
+	names := services.Db.Names()
+	anonymization := services.Db.Find("AnonymizeUserMessages")
+	require.NotNil(t, anonymization)
+	require.Equal(t, user.ID, anonymization.Args[0])
+	require.Less(t, indexOf(names, "LockUserToPurge"), indexOf(names, "AnonymizeUserMessages"))
+	require.Less(t, indexOf(names, "AnonymizeUserMessages"), indexOf(names, "RemoveUserOwnedChats"))
+	require.Less(t, indexOf(names, "RemoveUserOwnedChats"), indexOf(names, "RemoveUser"))
+	require.Less(t, indexOf(names, "RemoveUser"), indexOf(names, "CreateAuditLogEntry"))
+
+	require.Empty(t, services.Storage.Files)
+}
+
+func TestPurgeUsersJob_ShouldKeepFilesWhenPurgeFails(t *testing.T) {
+	user := db_queries.User{ID: newId(), AvatarFileName: "avatar.png"}
+
+	services := fakes.CreateServices(
+		map[string][]interface{}{"GetUsersToPurge": {user}, "LockUserToPurge": {user.ID}},
+		map[string][]byte{fakes.StorageKey("avatar.png", s3.AvatarsBucket): []byte("avatar")},
+	)
+	services.Db.Failing["RemoveUser"] = errors.New("connection lost")
+
+	runError := user_jobs.PurgeUsersJob{Services: services, BatchSize: 1}.Run(context.Background())
+	require.Nil(t, runError)
+
+	require.Empty(t, services.Storage.Deleted)
+	require.Contains(t, services.Storage.Files, fakes.StorageKey("avatar.png", s3.AvatarsBucket))
+}
+
+func TestPurgeUsersJob_ShouldSkipUsersRestoredSinceBatchWasRead(t *testing.T) {
+	user := db_queries.User{ID: newId(), AvatarFileName: "avatar.png"}
+
+	services := fakes.CreateServices(
+		map[string][]interface{}{"GetUsersToPurge": {user}},
+		map[string][]byte{fakes.StorageKey("avatar.png", s3.AvatarsBucket): []byte("avatar")},
+	)
+
+	runError := user_jobs.PurgeUsersJob{Services: services, BatchSize: 1}.Run(context.Background())
+	require.Nil(t, runError)
+
+	require.Nil(t, services.Db.Find("AnonymizeUserMessages"))
+	require.Nil(t, services.Db.Find("RemoveUser"))
+	require.Empty(t, services.Storage.Deleted)
+}
+
+func indexOf(names []string, name string) int {
+	for idx, executed := range names {
+		if executed == name {
+			return idx
+		}
+	}
+
+	return -1
+}
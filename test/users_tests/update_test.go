@@ -0,0 +1,49 @@
+package users_tests
+
+import (
+	"chat_app_backend/application/handlers/users"
+	"chat_app_backend/application/models/users/update"
+	"chat_app_backend/internal/exceptions/common_exceptions"
+	"chat_app_backend/internal/request_env"
+	"chat_app_backend/internal/s3"
+	"chat_app_backend/internal/sqlc/db_queries"
+	"chat_app_backend/test/fakes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateUser_ShouldRemoveRegeneratedAvatarWhenUpdateFails(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	user := db_queries.User{
+		ID:              newId(),
+		FullName:        "Old Name Of The User",
+		AvatarFileName:  "old.png",
+		AvatarGenerated: true,
+		Role:            db_queries.RoleTypeUSER,
+	}
+	oldAvatarKey := fakes.StorageKey(user.AvatarFileName, s3.AvatarsBucket)
+
+	// the update finds no row, as the user was changed since it was read
+	services := fakes.CreateServices(map[string][]interface{}{}, map[string][]byte{oldAvatarKey: {}})
+
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodPatch, "/users", nil)
+
+	fullName := "New Name Of The User"
+	_, updateError := users.UpdateUserHandler{}.Handle(
+		&update.UpdateUserRequestDto{ID: user.ID, FullName: &fullName},
+		services,
+		ctx,
+		&request_env.RequestEnv{User: &user},
+	)
+
+	require.IsType(t, common_exceptions.PreconditionFailedException{}, updateError)
+	require.Equal(t, []string{"UpdateUser"}, services.Db.Names())
+	require.Equal(t, map[string][]byte{oldAvatarKey: {}}, services.Storage.Files)
+	require.NotContains(t, services.Storage.Deleted, oldAvatarKey)
+}
@@ -50,3 +50,23 @@ the value in field intVal should be gte than 0 but it is not
 the value in field uint8Val should be eq than 5 but it is not`,
 	)
 }
+
+type testStructPointerRange struct {
+	intPtrVal *int32 `validator:"gt 0;lte 100"`
+}
+
+func TestValidator_Comparison_ShouldApplyEveryConditionToPointer(t *testing.T) {
+	validatorObject := validator.Validator[testStructPointerRange]{}
+
+	inRange := int32(50)
+	require.NoError(t, validatorObject.Validate(&testStructPointerRange{intPtrVal: &inRange}, context.Background(), requestEnv))
+	require.NoError(t, validatorObject.Validate(&testStructPointerRange{}, context.Background(), requestEnv))
+
+	tooBig := int32(101)
+	require.EqualError(
+		t,
+		validatorObject.Validate(&testStructPointerRange{intPtrVal: &tooBig}, context.Background(), requestEnv),
+		`validation errors occurred:
+the value in field intPtrVal should be lte than 100 but it is not`,
+	)
+}
@@ -0,0 +1,193 @@
+package webhooks_tests
+
+import (
+	"chat_app_backend/internal/webhooks"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const secret = "top-secret"
+
+func createSender() *webhooks.Sender {
+	return webhooks.CreateSenderWithClient(
+		&http.Client{Timeout: time.Second},
+		webhooks.RetryPolicy{BaseDelay: time.Second, MaxDelay: time.Minute, MaxAttempts: 5},
+	)
+}
+
+func TestSignature_ShouldVerifyOnlyUntamperedBodies(t *testing.T) {
+	now := time.Now()
+	body := []byte(`{"type":"user.registered"}`)
+	header := webhooks.Sign(secret, now, body)
+
+	require.NoError(t, webhooks.VerifySignature(secret, header, body, now, time.Minute))
+	require.Error(t, webhooks.VerifySignature(secret, header, []byte(`{}`), now, time.Minute))
+	require.Error(t, webhooks.VerifySignature("other-secret", header, body, now, time.Minute))
+	require.Error(t, webhooks.VerifySignature(secret, header, body, now.Add(time.Hour), time.Minute))
+	require.Error(t, webhooks.VerifySignature(secret, "garbage", body, now, time.Minute))
+}
+
+func TestSender_ShouldDeliverSignedPayload(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	var receivedBody []byte
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedBody, _ = io.ReadAll(r.Body)
+		received <- r
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	payload := []byte(`{"type":"message.created","data":{}}`)
+	result := createSender().Send(
+		context.Background(),
+		webhooks.Delivery{
+			ID:        "delivery-id",
+			Url:       receiver.URL,
+			Secret:    secret,
+			EventType: webhooks.EventMessageCreated,
+			Payload:   payload,
+		},
+	)
+
+	require.True(t, result.Succeeded())
+	require.Equal(t, int32(http.StatusNoContent), *result.StatusCode)
+
+	request := <-received
+	require.Equal(t, webhooks.EventMessageCreated, request.Header.Get(webhooks.EventHeader))
+	require.Equal(t, "delivery-id", request.Header.Get(webhooks.DeliveryHeader))
+	require.Equal(t, payload, receivedBody)
+	require.NoError(
+		t,
+		webhooks.VerifySignature(secret, request.Header.Get(webhooks.SignatureHeader), receivedBody, time.Now(), time.Minute),
+	)
+}
+
+func TestSender_ShouldReportFailedDeliveries(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer receiver.Close()
+
+	result := createSender().Send(
+		context.Background(),
+		webhooks.Delivery{ID: "id", Url: receiver.URL, Secret: secret, Payload: []byte(`{}`)},
+	)
+
+	require.False(t, result.Succeeded())
+	require.Equal(t, int32(http.StatusBadGateway), *result.StatusCode)
+
+	receiver.Close()
+	result = createSender().Send(
+		context.Background(),
+		webhooks.Delivery{ID: "id", Url: receiver.URL, Secret: secret, Payload: []byte(`{}`)},
+	)
+
+	require.False(t, result.Succeeded())
+	require.Nil(t, result.StatusCode)
+}
+
+func TestSender_ShouldRefuseReceiversWhichAreNotPublic(t *testing.T) {
+	var hits atomic.Int64
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	defer receiver.Close()
+
+	sender, creationError := webhooks.CreateSender(&webhooks.WebhooksConfig{
+		RequestTimeout: "1s",
+		BaseRetryDelay: "1s",
+		MaxRetryDelay:  "1m",
+		MaxAttempts:    5,
+	})
+	require.NoError(t, creationError)
+
+	result := sender.Send(
+		context.Background(),
+		webhooks.Delivery{ID: "id", Url: receiver.URL, Secret: secret, Payload: []byte(`{}`)},
+	)
+
+	require.ErrorIs(t, result.Err, webhooks.ErrForbiddenAddress)
+	require.Nil(t, result.StatusCode)
+	require.Zero(t, hits.Load())
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	testCases := []struct {
+		address string
+		public  bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"100.64.0.1", false},
+		{"224.0.0.1", false},
+		{"255.255.255.255", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:169.254.169.254", false},
+		{"64:ff9b::a00:1", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.address, func(t *testing.T) {
+			require.Equal(t, testCase.public, webhooks.IsPublicAddress(netip.MustParseAddr(testCase.address)))
+		})
+	}
+}
+
+func TestCheckUrl_ShouldRejectUrlsWhichAreNotPublic(t *testing.T) {
+	testCases := []struct {
+		url   string
+		valid bool
+	}{
+		{"https://93.184.216.34/hooks", true},
+		{"http://[2606:2800:220:1:248:1893:25c8:1946]:8080/hooks", true},
+		{"http://127.0.0.1:8080/hooks", false},
+		{"http://[::1]/hooks", false},
+		{"http://169.254.169.254/latest/meta-data", false},
+		{"http://10.0.0.5/hooks", false},
+		{"http://localhost:8080/hooks", false},
+		{"http://api.localhost./hooks", false},
+		{"ftp://93.184.216.34/hooks", false},
+		{"/hooks", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.url, func(t *testing.T) {
+			checkError := webhooks.CheckUrl(context.Background(), net.DefaultResolver, testCase.url)
+
+			require.Equal(t, testCase.valid, checkError == nil)
+		})
+	}
+}
+
+func TestRetryPolicy_ShouldBackOffExponentiallyUpToTheCap(t *testing.T) {
+	policy := webhooks.RetryPolicy{BaseDelay: time.Second, MaxDelay: 10 * time.Second, MaxAttempts: 3}
+
+	require.Equal(t, time.Second, policy.NextDelay(1))
+	require.Equal(t, 2*time.Second, policy.NextDelay(2))
+	require.Equal(t, 8*time.Second, policy.NextDelay(4))
+	require.Equal(t, 10*time.Second, policy.NextDelay(5))
+	require.Equal(t, 10*time.Second, policy.NextDelay(60))
+
+	require.False(t, policy.ShouldGiveUp(2))
+	require.True(t, policy.ShouldGiveUp(3))
+}